@@ -193,16 +193,25 @@ func (app *App) setupRepo(
 
 		var shouldInitRepo bool
 		initialBranchArg := ""
+		cloneURL := ""
 		switch app.UserConfig().NotARepository {
 		case "prompt":
-			// Offer to initialize a new repository in current directory.
+			// Offer to initialize a new repository in current directory, or to
+			// clone one into it.
+			reader := bufio.NewReader(os.Stdin)
 			fmt.Print(app.Tr.CreateRepo)
-			response, _ := bufio.NewReader(os.Stdin).ReadString('\n')
-			shouldInitRepo = (strings.Trim(response, " \r\n") == "y")
+			response, _ := reader.ReadString('\n')
+			response = strings.Trim(response, " \r\n")
+			shouldInitRepo = (response == "y")
+			if response == "c" {
+				fmt.Print(app.Tr.CloneRepoURL)
+				response, _ := reader.ReadString('\n')
+				cloneURL = strings.Trim(response, " \r\n")
+			}
 			if shouldInitRepo {
 				// Ask for the initial branch name
 				fmt.Print(app.Tr.InitialBranch)
-				response, _ := bufio.NewReader(os.Stdin).ReadString('\n')
+				response, _ := reader.ReadString('\n')
 				if trimmedResponse := strings.Trim(response, " \r\n"); len(trimmedResponse) > 0 {
 					initialBranchArg += "--initial-branch=" + trimmedResponse
 				}
@@ -231,6 +240,10 @@ func (app *App) setupRepo(
 			return false, nil
 		}
 
+		if cloneURL != "" {
+			return false, app.cloneRepo(cloneURL)
+		}
+
 		// check if we have a recent repo we can open
 		for _, repoDir := range app.Config.GetAppState().RecentRepos {
 			if isRepo, _ := isDirectoryAGitRepository(repoDir); isRepo {
@@ -266,6 +279,22 @@ func (app *App) setupRepo(
 	return false, nil
 }
 
+// Clones the repo into a new directory inside the current one, named like git
+// would name it, and changes into it. Git's output and any credential prompts
+// go straight to the terminal, since the GUI isn't running yet.
+func (app *App) cloneRepo(url string) error {
+	dir := git_commands.RepoNameFromURL(url)
+	cmd := app.OSCommand.Cmd.New(git_commands.NewGitCmd("clone").Arg("--", url, dir).ToArgv()).GetCmd()
+	cmd.Stdin = os.Stdin
+	cmd.Stdout = os.Stdout
+	cmd.Stderr = os.Stderr
+	if err := cmd.Run(); err != nil {
+		return err
+	}
+
+	return os.Chdir(dir)
+}
+
 func (app *App) Run(startArgs appTypes.StartArgs) error {
 	err := app.Gui.RunAndHandleError(startArgs)
 	return err
@@ -4,9 +4,11 @@ import (
 	"encoding/json"
 	"fmt"
 	"log"
+	"net"
 	"os"
 	"os/exec"
 	"strconv"
+	"strings"
 
 	"github.com/jesseduffield/lazygit/pkg/commands/models"
 	"github.com/jesseduffield/lazygit/pkg/common"
@@ -41,6 +43,7 @@ const (
 	DaemonKindDropMergeCommit
 	DaemonKindMoveFixupCommitDown
 	DaemonKindWriteRebaseTodo
+	DaemonKindAskpass
 )
 
 const (
@@ -64,6 +67,7 @@ func getInstruction() Instruction {
 		DaemonKindMoveTodosDown:                   deserializeInstruction[*MoveTodosDownInstruction],
 		DaemonKindInsertBreak:                     deserializeInstruction[*InsertBreakInstruction],
 		DaemonKindWriteRebaseTodo:                 deserializeInstruction[*WriteRebaseTodoInstruction],
+		DaemonKindAskpass:                         deserializeInstruction[*AskpassInstruction],
 	}
 
 	return mapping[getDaemonKind()](jsonData)
@@ -402,3 +406,74 @@ func (self *WriteRebaseTodoInstruction) run(common *common.Common) error {
 		return os.WriteFile(path, self.TodosFileContent, 0o644)
 	})
 }
+
+// AskpassInstruction is used when lazygit is invoked by git or ssh as their
+// askpass program (GIT_ASKPASS/SSH_ASKPASS). These pass the prompt as the only
+// argument and read the answer from our stdout. We forward the prompt to the
+// parent lazygit process over a local socket so that it can ask the user.
+type AskpassInstruction struct {
+	SocketPath string
+}
+
+// Sent by the askpass daemon to the parent lazygit process
+type AskpassRequest struct {
+	Prompt string
+	// The value of SSH_ASKPASS_PROMPT: "confirm" for yes/no questions that
+	// are answered by our exit status, "none" for notifications that don't
+	// need an answer (e.g. asking to touch a security key), and empty for
+	// everything else
+	PromptKind string
+}
+
+// Sent back by the parent lazygit process
+type AskpassResponse struct {
+	Answer string
+}
+
+func NewAskpassInstruction(socketPath string) Instruction {
+	return &AskpassInstruction{
+		SocketPath: socketPath,
+	}
+}
+
+func (self *AskpassInstruction) Kind() DaemonKind {
+	return DaemonKindAskpass
+}
+
+func (self *AskpassInstruction) SerializedInstructions() string {
+	return serializeInstruction(self)
+}
+
+func (self *AskpassInstruction) run(common *common.Common) error {
+	conn, err := net.Dial("unix", self.SocketPath)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	request := AskpassRequest{
+		Prompt:     strings.Join(os.Args[1:], " "),
+		PromptKind: os.Getenv("SSH_ASKPASS_PROMPT"),
+	}
+	if err := json.NewEncoder(conn).Encode(request); err != nil {
+		return err
+	}
+
+	// ssh kills the notification program once it's done, so there's nothing
+	// to wait for
+	if request.PromptKind == "none" {
+		return nil
+	}
+
+	var response AskpassResponse
+	if err := json.NewDecoder(conn).Decode(&response); err != nil {
+		return err
+	}
+
+	if request.PromptKind == "confirm" && response.Answer != "yes" {
+		os.Exit(1)
+	}
+
+	fmt.Println(response.Answer)
+	return nil
+}
@@ -125,6 +125,7 @@ func localisedTitle(tr *i18n.TranslationSet, str string) string {
 		"stash":             tr.StashTitle,
 		"suggestions":       tr.SuggestionsCheatsheetTitle,
 		"extras":            tr.ExtrasTitle,
+		"commandLogEntries": tr.CommandLogEntriesTitle,
 		"worktrees":         tr.WorktreesTitle,
 	}
 
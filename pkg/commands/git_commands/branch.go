@@ -2,7 +2,9 @@ package git_commands
 
 import (
 	"fmt"
+	"strconv"
 	"strings"
+	"time"
 
 	"github.com/jesseduffield/lazygit/pkg/commands/models"
 	"github.com/jesseduffield/lazygit/pkg/commands/oscommands"
@@ -188,6 +190,20 @@ func (self *BranchCommands) GetUpstreamDifferenceCount(branchName string) (strin
 	return self.GetCommitDifferences(branchName, branchName+"@{u}")
 }
 
+// GetUpstreamHash returns the commit that the branch's remote-tracking branch
+// points at, i.e. where the upstream branch was as of the last fetch
+func (self *BranchCommands) GetUpstreamHash(branch *models.Branch) (string, error) {
+	cmdArgs := NewGitCmd("rev-parse").
+		Arg("--verify", "--quiet", branch.FullUpstreamRefName()).
+		ToArgv()
+
+	output, err := self.cmd.New(cmdArgs).DontLog().RunWithOutput()
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimSpace(output), nil
+}
+
 // GetCommitDifferences checks how many pushables/pullables there are for the
 // current branch
 func (self *BranchCommands) GetCommitDifferences(from, to string) (string, string) {
@@ -262,6 +278,60 @@ func (self *BranchCommands) AllBranchesLogCmdObj() oscommands.ICmdObj {
 	return self.cmd.New(str.ToArgv(candidates[i])).DontLog()
 }
 
+// IsMergedIntoMainBranches tells whether all commits of the branch are
+// contained in one of the main branches. Unlike IsBranchMerged, being merged
+// into HEAD or into the branch's own upstream doesn't count.
+func (self *BranchCommands) IsMergedIntoMainBranches(branch *models.Branch, mainBranches *MainBranches) (bool, error) {
+	existingMainBranches := mainBranches.Get()
+	if len(existingMainBranches) == 0 {
+		return false, nil
+	}
+
+	cmdArgs := NewGitCmd("rev-list").
+		Arg("--max-count=1").
+		Arg(branch.FullRefName()).
+		Arg(lo.Map(existingMainBranches, func(mainBranch string, _ int) string {
+			return "^" + mainBranch
+		})...).
+		Arg("--").
+		ToArgv()
+
+	stdout, err := self.cmd.New(cmdArgs).DontLog().RunWithOutput()
+	if err != nil {
+		return false, err
+	}
+
+	return strings.TrimSpace(stdout) == "", nil
+}
+
+// GetLastCommitDates returns the committer date of the tip of each local
+// branch, keyed by branch name
+func (self *BranchCommands) GetLastCommitDates() (map[string]time.Time, error) {
+	cmdArgs := NewGitCmd("for-each-ref").
+		Arg("--format=%(refname)%00%(committerdate:unix)").
+		Arg("refs/heads").
+		ToArgv()
+
+	output, err := self.cmd.New(cmdArgs).DontLog().RunWithOutput()
+	if err != nil {
+		return nil, err
+	}
+
+	dates := map[string]time.Time{}
+	for _, line := range utils.SplitLines(output) {
+		refName, timestamp, found := strings.Cut(line, "\x00")
+		if !found {
+			continue
+		}
+		seconds, err := strconv.ParseInt(timestamp, 10, 64)
+		if err != nil {
+			continue
+		}
+		dates[strings.TrimPrefix(refName, "refs/heads/")] = time.Unix(seconds, 0)
+	}
+	return dates, nil
+}
+
 func (self *BranchCommands) IsBranchMerged(branch *models.Branch, mainBranches *MainBranches) (bool, error) {
 	branchesToCheckAgainst := []string{"HEAD"}
 	if branch.RemoteBranchStoredLocally() {
@@ -285,3 +355,20 @@ func (self *BranchCommands) IsBranchMerged(branch *models.Branch, mainBranches *
 
 	return stdout == "", nil
 }
+
+// LocalDeletePreview returns the commits that would no longer be reachable
+// after deleting the given branches. remoteBranches are the remote branches
+// (e.g. "origin/feature") that are being deleted along with them, if any.
+func (self *BranchCommands) LocalDeletePreview(branches []string, remoteBranches []string) (*models.LossPreview, error) {
+	commits, err := loadLostCommits(self.cmd, lostCommitsOpts{
+		tips:                   lo.Map(branches, func(branch string, _ int) string { return "refs/heads/" + branch }),
+		keep:                   []string{"HEAD"},
+		excludedBranches:       branches,
+		excludedRemoteBranches: remoteBranches,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &models.LossPreview{Commits: commits}, nil
+}
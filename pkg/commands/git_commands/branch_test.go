@@ -2,8 +2,10 @@ package git_commands
 
 import (
 	"testing"
+	"time"
 
 	"github.com/go-errors/errors"
+	"github.com/jesseduffield/lazygit/pkg/commands/models"
 	"github.com/jesseduffield/lazygit/pkg/commands/oscommands"
 	"github.com/jesseduffield/lazygit/pkg/config"
 	"github.com/stretchr/testify/assert"
@@ -51,6 +53,32 @@ func TestBranchGetCommitDifferences(t *testing.T) {
 	}
 }
 
+func TestBranchGetUpstreamHash(t *testing.T) {
+	runner := oscommands.NewFakeRunner(t).
+		ExpectGitArgs([]string{"rev-parse", "--verify", "--quiet", "refs/remotes/origin/feature"}, "abc123\n", nil)
+	instance := buildBranchCommands(commonDeps{runner: runner})
+
+	hash, err := instance.GetUpstreamHash(&models.Branch{Name: "feature", UpstreamRemote: "origin", UpstreamBranch: "feature"})
+	assert.NoError(t, err)
+	assert.Equal(t, "abc123", hash)
+	runner.CheckForMissingCalls()
+}
+
+func TestBranchGetLastCommitDates(t *testing.T) {
+	runner := oscommands.NewFakeRunner(t).
+		ExpectGitArgs([]string{"for-each-ref", "--format=%(refname)%00%(committerdate:unix)", "refs/heads"},
+			"refs/heads/master\x001700000000\nrefs/heads/feature/old\x001500000000\n", nil)
+	instance := buildBranchCommands(commonDeps{runner: runner})
+
+	dates, err := instance.GetLastCommitDates()
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]time.Time{
+		"master":      time.Unix(1700000000, 0),
+		"feature/old": time.Unix(1500000000, 0),
+	}, dates)
+	runner.CheckForMissingCalls()
+}
+
 func TestBranchNewBranch(t *testing.T) {
 	runner := oscommands.NewFakeRunner(t).
 		ExpectGitArgs([]string{"checkout", "-b", "test", "refs/heads/master"}, "", nil)
@@ -118,6 +146,48 @@ func TestBranchDeleteBranch(t *testing.T) {
 	}
 }
 
+func TestBranchLocalDeletePreview(t *testing.T) {
+	scenarios := []struct {
+		testName       string
+		branchNames    []string
+		remoteBranches []string
+		runner         *oscommands.FakeCmdObjRunner
+		expected       []*models.Commit
+	}{
+		{
+			testName:    "delete a branch",
+			branchNames: []string{"feature"},
+			runner: oscommands.NewFakeRunner(t).
+				ExpectGitArgs([]string{
+					"log", "--format=%H%x00%s", "refs/heads/feature", "--not", "HEAD", "--tags", "--remotes", "--exclude=feature", "--branches", "--",
+				}, "aaa\x00wip\n", nil),
+			expected: []*models.Commit{{Hash: "aaa", Name: "wip"}},
+		},
+		{
+			testName:       "delete branches along with their remote branches",
+			branchNames:    []string{"feature1", "feature2"},
+			remoteBranches: []string{"origin/feature1"},
+			runner: oscommands.NewFakeRunner(t).
+				ExpectGitArgs([]string{
+					"log", "--format=%H%x00%s", "refs/heads/feature1", "refs/heads/feature2", "--not", "HEAD", "--tags",
+					"--exclude=origin/feature1", "--remotes", "--exclude=feature1", "--exclude=feature2", "--branches", "--",
+				}, "", nil),
+			expected: []*models.Commit{},
+		},
+	}
+
+	for _, s := range scenarios {
+		t.Run(s.testName, func(t *testing.T) {
+			instance := buildBranchCommands(commonDeps{runner: s.runner})
+
+			preview, err := instance.LocalDeletePreview(s.branchNames, s.remoteBranches)
+			assert.NoError(t, err)
+			assert.Equal(t, s.expected, preview.Commits)
+			s.runner.CheckForMissingCalls()
+		})
+	}
+}
+
 func TestBranchMerge(t *testing.T) {
 	scenarios := []struct {
 		testName   string
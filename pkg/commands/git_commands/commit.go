@@ -175,6 +175,14 @@ func (self *CommitCommands) GetCommitSubject(commitHash string) (string, error)
 	return strings.TrimSpace(subject), err
 }
 
+// HeadHash returns the hash of the checked-out commit
+func (self *CommitCommands) HeadHash() (string, error) {
+	cmdArgs := NewGitCmd("rev-parse").Arg("--verify", "--quiet", "HEAD").ToArgv()
+
+	output, err := self.cmd.New(cmdArgs).DontLog().RunWithOutput()
+	return strings.TrimSpace(output), err
+}
+
 func (self *CommitCommands) GetCommitDiff(commitHash string) (string, error) {
 	cmdArgs := NewGitCmd("show").Arg("--no-color", commitHash).ToArgv()
 
@@ -268,6 +276,7 @@ func (self *CommitCommands) ShowCmdObj(hash string, filterPath string) oscommand
 		Arg(fmt.Sprintf("--unified=%d", contextSize)).
 		Arg("--stat").
 		Arg("--decorate").
+		ArgIf(self.UserConfig().Git.Log.ShowSignatureStatus, "--show-signature").
 		Arg("-p").
 		Arg(hash).
 		ArgIf(self.AppState.IgnoreWhitespaceInDiffView, "--ignore-all-space").
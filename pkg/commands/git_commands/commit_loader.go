@@ -11,6 +11,7 @@ import (
 	"strings"
 	"sync"
 
+	"github.com/jesseduffield/generics/set"
 	"github.com/jesseduffield/lazygit/pkg/commands/models"
 	"github.com/jesseduffield/lazygit/pkg/commands/oscommands"
 	"github.com/jesseduffield/lazygit/pkg/commands/types/enums"
@@ -123,7 +124,10 @@ func (self *CommitLoader) GetCommits(opts GetCommitsOptions) ([]*models.Commit,
 		return nil, logErr
 	}
 
+	shallowBoundaries := self.getShallowBoundaries()
+
 	for _, commit := range commits {
+		commit.ShallowBoundary = shallowBoundaries.Includes(commit.Hash)
 		if commit.Hash == firstPushedCommit {
 			passedFirstPushedCommit = true
 		}
@@ -162,6 +166,102 @@ func (self *CommitLoader) GetCommits(opts GetCommitsOptions) ([]*models.Commit,
 	return commits, nil
 }
 
+// GetCommitsInRange returns the commits reachable from toRef but not from
+// fromRef, newest first. If fromRef is empty, all commits reachable from toRef
+// are returned. Unlike GetCommits, no pushed/merged status is determined.
+func (self *CommitLoader) GetCommitsInRange(fromRef string, toRef string) ([]*models.Commit, error) {
+	refSpec := toRef
+	if fromRef != "" {
+		refSpec = fromRef + ".." + toRef
+	}
+
+	cmdArgs := NewGitCmd("log").
+		Arg(refSpec).
+		Arg("--oneline").
+		Arg(prettyFormat).
+		Arg("--abbrev=40").
+		Arg("--no-show-signature").
+		Arg("--").
+		ToArgv()
+
+	commits := []*models.Commit{}
+	err := self.cmd.New(cmdArgs).DontLog().RunAndProcessLines(func(line string) (bool, error) {
+		commits = append(commits, self.extractCommitFromLine(line, false))
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return commits, nil
+}
+
+// GetNumstats returns the number of lines added and removed by each of the
+// given commits, keyed by hash. Merge commits have no stats. The hashes are
+// passed on stdin because there can be too many of them for the command line.
+func (self *CommitLoader) GetNumstats(hashes []string) (map[string]*models.CommitNumstat, error) {
+	result := map[string]*models.CommitNumstat{}
+	if len(hashes) == 0 {
+		return result, nil
+	}
+
+	cmdArgs := NewGitCmd("log").
+		Arg("--no-walk=unsorted").
+		Arg("--numstat").
+		Arg("--format=%x00%H").
+		Arg("--no-show-signature").
+		Arg("--stdin").
+		Arg("--").
+		ToArgv()
+
+	cmdObj := self.cmd.New(cmdArgs).DontLog()
+	cmdObj.GetCmd().Stdin = strings.NewReader(strings.Join(hashes, "\n") + "\n")
+
+	var current *models.CommitNumstat
+	err := cmdObj.RunAndProcessLines(func(line string) (bool, error) {
+		if strings.HasPrefix(line, "\x00") {
+			current = &models.CommitNumstat{}
+			result[strings.TrimPrefix(line, "\x00")] = current
+			return false, nil
+		}
+
+		fields := strings.SplitN(line, "\t", 3)
+		if current == nil || len(fields) != 3 {
+			return false, nil
+		}
+
+		// binary files have "-" instead of line counts
+		added, _ := strconv.Atoi(fields[0])
+		removed, _ := strconv.Atoi(fields[1])
+		current.LinesAdded += added
+		current.LinesRemoved += removed
+		current.Paths = append(current.Paths, numstatPath(fields[2]))
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// For renames, numstat gives us e.g. "old => new" or "dir/{old => new}/file";
+// we want the new path
+func numstatPath(path string) string {
+	before, after, found := strings.Cut(path, " => ")
+	if !found {
+		return path
+	}
+
+	if braceStart := strings.LastIndex(before, "{"); braceStart != -1 {
+		if braceEnd := strings.Index(after, "}"); braceEnd != -1 {
+			return strings.ReplaceAll(before[:braceStart]+after[:braceEnd]+after[braceEnd+1:], "//", "/")
+		}
+	}
+
+	return after
+}
+
 func (self *CommitLoader) MergeRebasingCommits(commits []*models.Commit) ([]*models.Commit, error) {
 	// chances are we have as many commits as last time so we'll set the capacity to be the old length
 	result := make([]*models.Commit, 0, len(commits))
@@ -197,8 +297,10 @@ func (self *CommitLoader) MergeRebasingCommits(commits []*models.Commit) ([]*mod
 // then puts them into a commit object
 // example input:
 // 8ad01fe32fcc20f07bc6693f87aa4977c327f1e1|10 hours ago|Jesse Duffield| (HEAD -> master, tag: v0.15.2)|refresh commits when adding a tag
+// If the log was requested with signature info, the signature status, signer
+// and key follow the message.
 func (self *CommitLoader) extractCommitFromLine(line string, showDivergence bool) *models.Commit {
-	split := strings.SplitN(line, "\x00", 8)
+	split := strings.Split(line, "\x00")
 
 	hash := split[0]
 	unixTimestamp := split[1]
@@ -212,6 +314,15 @@ func (self *CommitLoader) extractCommitFromLine(line string, showDivergence bool
 	}
 	message := split[7]
 
+	signatureStatus := models.SignatureNone
+	signer := ""
+	signingKey := ""
+	if len(split) >= 11 {
+		signatureStatus = models.SignatureStatusFromCode(split[8])
+		signer = split[9]
+		signingKey = split[10]
+	}
+
 	tags := []string{}
 
 	if extraInfo != "" {
@@ -245,6 +356,10 @@ func (self *CommitLoader) extractCommitFromLine(line string, showDivergence bool
 		AuthorEmail:   authorEmail,
 		Parents:       parents,
 		Divergence:    divergence,
+
+		SignatureStatus: signatureStatus,
+		Signer:          signer,
+		SigningKey:      signingKey,
 	}
 }
 
@@ -361,6 +476,23 @@ func (self *CommitLoader) getRebasingCommits(rebaseMode enums.RebaseMode) []*mod
 	return commits
 }
 
+// Returns the hashes of the commits at which the history of a shallow clone
+// is cut off; empty if the repo is not shallow
+func (self *CommitLoader) getShallowBoundaries() *set.Set[string] {
+	result := set.New[string]()
+	bytesContent, err := self.readFile(filepath.Join(self.repoPaths.RepoGitDirPath(), "shallow"))
+	if err != nil {
+		return result
+	}
+
+	for _, line := range strings.Split(string(bytesContent), "\n") {
+		if hash := strings.TrimSpace(line); hash != "" {
+			result.Add(hash)
+		}
+	}
+	return result
+}
+
 func (self *CommitLoader) getConflictedCommit(todos []todo.Todo) string {
 	bytesContent, err := self.readFile(filepath.Join(self.repoPaths.WorktreeGitDirPath(), "rebase-merge/done"))
 	if err != nil {
@@ -499,6 +631,7 @@ func (self *CommitLoader) getFirstPushedCommit(refName string) (string, error) {
 // getLog gets the git log.
 func (self *CommitLoader) getLogCmd(opts GetCommitsOptions) oscommands.ICmdObj {
 	gitLogOrder := self.AppState.GitLogOrder
+	showSignatureStatus := self.UserConfig().Git.Log.ShowSignatureStatus
 
 	refSpec := opts.RefName
 	if opts.RefToShowDivergenceFrom != "" {
@@ -510,7 +643,7 @@ func (self *CommitLoader) getLogCmd(opts GetCommitsOptions) oscommands.ICmdObj {
 		ArgIf(gitLogOrder != "default", "--"+gitLogOrder).
 		ArgIf(opts.All, "--all").
 		Arg("--oneline").
-		Arg(prettyFormat).
+		Arg(lo.Ternary(showSignatureStatus, prettyFormatWithSignature, prettyFormat)).
 		Arg("--abbrev=40").
 		ArgIf(opts.FilterAuthor != "", "--author="+opts.FilterAuthor).
 		ArgIf(opts.Limit, "-300").
@@ -525,3 +658,7 @@ func (self *CommitLoader) getLogCmd(opts GetCommitsOptions) oscommands.ICmdObj {
 }
 
 const prettyFormat = `--pretty=format:%H%x00%at%x00%aN%x00%ae%x00%D%x00%p%x00%m%x00%s`
+
+// Asking for %G? makes git verify every signature, which can be slow, so we
+// only use this format when the user has opted in.
+const prettyFormatWithSignature = prettyFormat + `%x00%G?%x00%GS%x00%GK`
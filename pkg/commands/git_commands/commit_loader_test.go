@@ -1,6 +1,7 @@
 package git_commands
 
 import (
+	"io"
 	"path/filepath"
 	"strings"
 	"testing"
@@ -11,8 +12,10 @@ import (
 	"github.com/jesseduffield/lazygit/pkg/commands/types/enums"
 	"github.com/jesseduffield/lazygit/pkg/config"
 	"github.com/jesseduffield/lazygit/pkg/utils"
+	"github.com/samber/lo"
 	"github.com/stefanhaller/git-todo-parser/todo"
 	"github.com/stretchr/testify/assert"
+	"golang.org/x/exp/slices"
 )
 
 var commitsOutput = strings.Replace(`0eea75e8c631fba6b58135697835d58ba4c18dbc|1640826609|Jesse Duffield|jessedduffield@gmail.com|HEAD -> better-tests|b21997d6b4cbdf84b149|>|better typing for rebase mode
@@ -24,6 +27,12 @@ d8084cd558925eb7c9c38afeed5725c21653ab90|1640821426|Jesse Duffield|jessedduffiel
 3d4470a6c072208722e5ae9a54bcb9634959a1c5|1640748818|Jesse Duffield|jessedduffield@gmail.com||053a66a7be3da43aacdc|>|WIP
 053a66a7be3da43aacdc7aa78e1fe757b82c4dd2|1640739815|Jesse Duffield|jessedduffield@gmail.com||985fe482e806b172aea4|>|refactoring the config struct`, "|", "\x00", -1)
 
+var shallowCommitsOutput = strings.Replace(`0eea75e8c631fba6b58135697835d58ba4c18dbc|1640826609|Jesse Duffield|jessedduffield@gmail.com|HEAD -> better-tests|b21997d6b4cbdf84b149|>|better typing for rebase mode
+b21997d6b4cbdf84b149d8e6a2c4d06a8e9ec164|1640824515|Jesse Duffield|jessedduffield@gmail.com|||>|fix logging`, "|", "\x00", -1)
+
+var signedCommitsOutput = strings.Replace(`0eea75e8c631fba6b58135697835d58ba4c18dbc|1640826609|Jesse Duffield|jessedduffield@gmail.com|HEAD -> better-tests|b21997d6b4cbdf84b149|>|better typing for rebase mode|G|Jesse Duffield <jessedduffield@gmail.com>|SHA256:abcdef
+b21997d6b4cbdf84b149d8e6a2c4d06a8e9ec164|1640824515|Jesse Duffield|jessedduffield@gmail.com||e94e8fc5b6fab4cb755f|>|fix logging|N||`, "|", "\x00", -1)
+
 var singleCommitOutput = strings.Replace(`0eea75e8c631fba6b58135697835d58ba4c18dbc|1640826609|Jesse Duffield|jessedduffield@gmail.com|HEAD -> better-tests|b21997d6b4cbdf84b149|>|better typing for rebase mode`, "|", "\x00", -1)
 
 func TestGetCommits(t *testing.T) {
@@ -36,6 +45,8 @@ func TestGetCommits(t *testing.T) {
 		rebaseMode      enums.RebaseMode
 		opts            GetCommitsOptions
 		mainBranches    []string
+		showSignatures  bool
+		shallowFile     string
 	}
 
 	scenarios := []scenario{
@@ -276,6 +287,93 @@ func TestGetCommits(t *testing.T) {
 			},
 			expectedError: nil,
 		},
+		{
+			testName:       "should load signature status if enabled",
+			logOrder:       "topo-order",
+			rebaseMode:     enums.REBASE_MODE_NONE,
+			opts:           GetCommitsOptions{RefName: "HEAD", RefForPushedStatus: "mybranch", IncludeRebaseCommits: false},
+			showSignatures: true,
+			runner: oscommands.NewFakeRunner(t).
+				ExpectGitArgs([]string{"merge-base", "mybranch", "mybranch@{u}"}, "b21997d6b4cbdf84b149d8e6a2c4d06a8e9ec164", nil).
+				ExpectGitArgs([]string{"log", "HEAD", "--topo-order", "--oneline", "--pretty=format:%H%x00%at%x00%aN%x00%ae%x00%D%x00%p%x00%m%x00%s%x00%G?%x00%GS%x00%GK", "--abbrev=40", "--no-show-signature", "--"}, signedCommitsOutput, nil),
+
+			expectedCommits: []*models.Commit{
+				{
+					Hash:            "0eea75e8c631fba6b58135697835d58ba4c18dbc",
+					Name:            "better typing for rebase mode",
+					Status:          models.StatusUnpushed,
+					Action:          models.ActionNone,
+					Tags:            []string{},
+					ExtraInfo:       "(HEAD -> better-tests)",
+					AuthorName:      "Jesse Duffield",
+					AuthorEmail:     "jessedduffield@gmail.com",
+					UnixTimestamp:   1640826609,
+					SignatureStatus: models.SignatureGood,
+					Signer:          "Jesse Duffield <jessedduffield@gmail.com>",
+					SigningKey:      "SHA256:abcdef",
+					Parents: []string{
+						"b21997d6b4cbdf84b149",
+					},
+				},
+				{
+					Hash:            "b21997d6b4cbdf84b149d8e6a2c4d06a8e9ec164",
+					Name:            "fix logging",
+					Status:          models.StatusPushed,
+					Action:          models.ActionNone,
+					Tags:            []string{},
+					ExtraInfo:       "",
+					AuthorName:      "Jesse Duffield",
+					AuthorEmail:     "jessedduffield@gmail.com",
+					UnixTimestamp:   1640824515,
+					SignatureStatus: models.SignatureNone,
+					Parents: []string{
+						"e94e8fc5b6fab4cb755f",
+					},
+				},
+			},
+			expectedError: nil,
+		},
+		{
+			testName:    "should mark the boundary commits of a shallow clone",
+			logOrder:    "topo-order",
+			rebaseMode:  enums.REBASE_MODE_NONE,
+			opts:        GetCommitsOptions{RefName: "HEAD", RefForPushedStatus: "mybranch", IncludeRebaseCommits: false},
+			shallowFile: "b21997d6b4cbdf84b149d8e6a2c4d06a8e9ec164\n",
+			runner: oscommands.NewFakeRunner(t).
+				ExpectGitArgs([]string{"merge-base", "mybranch", "mybranch@{u}"}, "b21997d6b4cbdf84b149d8e6a2c4d06a8e9ec164", nil).
+				ExpectGitArgs([]string{"log", "HEAD", "--topo-order", "--oneline", "--pretty=format:%H%x00%at%x00%aN%x00%ae%x00%D%x00%p%x00%m%x00%s", "--abbrev=40", "--no-show-signature", "--"}, shallowCommitsOutput, nil),
+
+			expectedCommits: []*models.Commit{
+				{
+					Hash:          "0eea75e8c631fba6b58135697835d58ba4c18dbc",
+					Name:          "better typing for rebase mode",
+					Status:        models.StatusUnpushed,
+					Action:        models.ActionNone,
+					Tags:          []string{},
+					ExtraInfo:     "(HEAD -> better-tests)",
+					AuthorName:    "Jesse Duffield",
+					AuthorEmail:   "jessedduffield@gmail.com",
+					UnixTimestamp: 1640826609,
+					Parents: []string{
+						"b21997d6b4cbdf84b149",
+					},
+				},
+				{
+					Hash:            "b21997d6b4cbdf84b149d8e6a2c4d06a8e9ec164",
+					Name:            "fix logging",
+					Status:          models.StatusPushed,
+					Action:          models.ActionNone,
+					Tags:            []string{},
+					ExtraInfo:       "",
+					AuthorName:      "Jesse Duffield",
+					AuthorEmail:     "jessedduffield@gmail.com",
+					UnixTimestamp:   1640824515,
+					Parents:         []string{},
+					ShallowBoundary: true,
+				},
+			},
+			expectedError: nil,
+		},
 		{
 			testName:   "should not specify order if `log.order` is `default`",
 			logOrder:   "default",
@@ -307,6 +405,7 @@ func TestGetCommits(t *testing.T) {
 			common := utils.NewDummyCommon()
 			common.AppState = &config.AppState{}
 			common.AppState.GitLogOrder = scenario.logOrder
+			common.UserConfig().Git.Log.ShowSignatureStatus = scenario.showSignatures
 			cmd := oscommands.NewDummyCmdObjBuilder(scenario.runner)
 
 			builder := &CommitLoader{
@@ -315,11 +414,15 @@ func TestGetCommits(t *testing.T) {
 				getRebaseMode: func() (enums.RebaseMode, error) { return scenario.rebaseMode, nil },
 				dotGitDir:     ".git",
 				readFile: func(filename string) ([]byte, error) {
+					if filename == filepath.Join(".git", "shallow") {
+						return []byte(scenario.shallowFile), nil
+					}
 					return []byte(""), nil
 				},
 				walkFiles: func(root string, fn filepath.WalkFunc) error {
 					return nil
 				},
+				GitCommon: &GitCommon{repoPaths: MockRepoPaths("")},
 			}
 
 			common.UserConfig().Git.MainBranches = scenario.mainBranches
@@ -335,6 +438,55 @@ func TestGetCommits(t *testing.T) {
 	}
 }
 
+func TestGetCommitsInRange(t *testing.T) {
+	type scenario struct {
+		testName       string
+		fromRef        string
+		toRef          string
+		runner         *oscommands.FakeCmdObjRunner
+		expectedHashes []string
+		expectedError  error
+	}
+
+	scenarios := []scenario{
+		{
+			testName: "range between two refs",
+			fromRef:  "v1.0.0",
+			toRef:    "HEAD",
+			runner: oscommands.NewFakeRunner(t).
+				ExpectGitArgs([]string{"log", "v1.0.0..HEAD", "--oneline", "--pretty=format:%H%x00%at%x00%aN%x00%ae%x00%D%x00%p%x00%m%x00%s", "--abbrev=40", "--no-show-signature", "--"}, singleCommitOutput, nil),
+			expectedHashes: []string{"0eea75e8c631fba6b58135697835d58ba4c18dbc"},
+		},
+		{
+			testName: "no from ref",
+			fromRef:  "",
+			toRef:    "HEAD",
+			runner: oscommands.NewFakeRunner(t).
+				ExpectGitArgs([]string{"log", "HEAD", "--oneline", "--pretty=format:%H%x00%at%x00%aN%x00%ae%x00%D%x00%p%x00%m%x00%s", "--abbrev=40", "--no-show-signature", "--"}, "", nil),
+			expectedHashes: []string{},
+		},
+	}
+
+	for _, scenario := range scenarios {
+		t.Run(scenario.testName, func(t *testing.T) {
+			common := utils.NewDummyCommon()
+			builder := &CommitLoader{
+				Common: common,
+				cmd:    oscommands.NewDummyCmdObjBuilder(scenario.runner),
+			}
+
+			commits, err := builder.GetCommitsInRange(scenario.fromRef, scenario.toRef)
+
+			assert.Equal(t, scenario.expectedError, err)
+			assert.Equal(t, scenario.expectedHashes, lo.Map(commits, func(commit *models.Commit, _ int) string {
+				return commit.Hash
+			}))
+
+			scenario.runner.CheckForMissingCalls()
+		})
+	}
+}
+
 func TestCommitLoader_getConflictedCommitImpl(t *testing.T) {
 	scenarios := []struct {
 		testName        string
@@ -558,3 +710,61 @@ func TestCommitLoader_setCommitMergedStatuses(t *testing.T) {
 		})
 	}
 }
+
+func TestGetNumstats(t *testing.T) {
+	output := strings.Join([]string{
+		"\x00aaaa",
+		"",
+		"3\t1\tpkg/foo.go",
+		"-\t-\tassets/logo.png",
+		"10\t0\tREADME.md",
+		"\x00bbbb",
+		"\x00cccc",
+		"",
+		"0\t2\tpkg/{old => new}/bar.go",
+	}, "\n")
+
+	expectedArgs := []string{"git", "log", "--no-walk=unsorted", "--numstat", "--format=%x00%H", "--no-show-signature", "--stdin", "--"}
+	runner := oscommands.NewFakeRunner(t).
+		ExpectFunc("git log --stdin with hashes on stdin", func(cmdObj oscommands.ICmdObj) bool {
+			if !slices.Equal(cmdObj.Args(), expectedArgs) {
+				return false
+			}
+			stdin, _ := io.ReadAll(cmdObj.GetCmd().Stdin)
+			return string(stdin) == "aaaa\nbbbb\ncccc\n"
+		}, output, nil)
+
+	loader := &CommitLoader{
+		Common: utils.NewDummyCommon(),
+		cmd:    oscommands.NewDummyCmdObjBuilder(runner),
+	}
+
+	numstats, err := loader.GetNumstats([]string{"aaaa", "bbbb", "cccc"})
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]*models.CommitNumstat{
+		"aaaa": {LinesAdded: 13, LinesRemoved: 1, Paths: []string{"pkg/foo.go", "assets/logo.png", "README.md"}},
+		"bbbb": {},
+		"cccc": {LinesAdded: 0, LinesRemoved: 2, Paths: []string{"pkg/new/bar.go"}},
+	}, numstats)
+
+	runner.CheckForMissingCalls()
+}
+
+func TestNumstatPath(t *testing.T) {
+	scenarios := []struct {
+		path     string
+		expected string
+	}{
+		{"pkg/foo.go", "pkg/foo.go"},
+		{"old.go => new.go", "new.go"},
+		{"pkg/{old => new}/bar.go", "pkg/new/bar.go"},
+		{"pkg/{ => sub}/bar.go", "pkg/sub/bar.go"},
+		{"pkg/{sub => }/bar.go", "pkg/bar.go"},
+	}
+
+	for _, s := range scenarios {
+		t.Run(s.path, func(t *testing.T) {
+			assert.Equal(t, s.expected, numstatPath(s.path))
+		})
+	}
+}
@@ -49,6 +49,18 @@ func TestCommitResetToCommit(t *testing.T) {
 	runner.CheckForMissingCalls()
 }
 
+func TestCommitHeadHash(t *testing.T) {
+	runner := oscommands.NewFakeRunner(t).
+		ExpectGitArgs([]string{"rev-parse", "--verify", "--quiet", "HEAD"}, "78976bc\n", nil)
+
+	instance := buildCommitCommands(commonDeps{runner: runner})
+
+	hash, err := instance.HeadHash()
+	assert.NoError(t, err)
+	assert.Equal(t, "78976bc", hash)
+	runner.CheckForMissingCalls()
+}
+
 func TestCommitCommitCmdObj(t *testing.T) {
 	type scenario struct {
 		testName             string
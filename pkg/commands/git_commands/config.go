@@ -57,15 +57,70 @@ func (self *ConfigCommands) GetPager(width int) string {
 	return utils.ResolvePlaceholderString(pagerTemplate, templateValues)
 }
 
+type SigningFormat string
+
+const (
+	SigningFormatOpenPGP SigningFormat = "openpgp"
+	SigningFormatSSH     SigningFormat = "ssh"
+	SigningFormatX509    SigningFormat = "x509"
+)
+
+// GetSigningFormat returns the format git uses for signing commits and tags,
+// as configured by gpg.format
+func (self *ConfigCommands) GetSigningFormat() SigningFormat {
+	switch format := SigningFormat(strings.ToLower(self.gitConfig.Get("gpg.format"))); format {
+	case SigningFormatSSH, SigningFormatX509:
+		return format
+	default:
+		return SigningFormatOpenPGP
+	}
+}
+
+type GpgConfigKey string
+
+const (
+	CommitGpgSign GpgConfigKey = "commit.gpgsign"
+	TagGpgSign    GpgConfigKey = "tag.gpgsign"
+)
+
 // UsingGpg tells us whether the user has gpg enabled so that we can know
 // whether we need to run a subprocess to allow them to enter their password
 func (self *ConfigCommands) UsingGpg() bool {
+	return self.NeedsGpgSubprocess(CommitGpgSign)
+}
+
+// NeedsGpgSubprocess is like UsingGpg, but for the given kind of object
+// (e.g. annotated tags are signed automatically when tag.gpgsign is set)
+func (self *ConfigCommands) NeedsGpgSubprocess(key GpgConfigKey) bool {
+	if !self.gitConfig.GetBool(string(key)) {
+		return false
+	}
+
+	return self.NeedsGpgSubprocessForSigning()
+}
+
+// NeedsGpgSubprocessForSigning tells us whether we need a subprocess when we
+// explicitly ask git to sign something, regardless of the gpgsign configs
+func (self *ConfigCommands) NeedsGpgSubprocessForSigning() bool {
 	overrideGpg := self.UserConfig().Git.OverrideGpg
 	if overrideGpg {
 		return false
 	}
 
-	return self.gitConfig.GetBool("commit.gpgsign")
+	return !self.canSignNonInteractively()
+}
+
+// canSignNonInteractively tells us whether signing can happen without the user
+// having to type a passphrase. We can only know this for ssh signing: if an
+// ssh agent is running, git will ask it for the key rather than prompting.
+// For openpgp and x509 we can't tell whether the agent has the passphrase
+// cached, so we assume the worst.
+func (self *ConfigCommands) canSignNonInteractively() bool {
+	if self.GetSigningFormat() != SigningFormatSSH {
+		return false
+	}
+
+	return os.Getenv("SSH_AUTH_SOCK") != ""
 }
 
 func (self *ConfigCommands) GetCoreEditor() string {
@@ -111,3 +166,18 @@ func (self *ConfigCommands) GetCoreCommentChar() byte {
 func (self *ConfigCommands) GetRebaseUpdateRefs() bool {
 	return self.gitConfig.GetBool("rebase.updateRefs")
 }
+
+// returns the names of the remotes that are configured as promisor remotes,
+// which is what git does for remotes that a partial clone was made from
+func (self *ConfigCommands) GetPromisorRemotes() []string {
+	output := self.gitConfig.GetGeneral(`--local --get-regexp ^remote\..*\.promisor$`)
+	remotes := []string{}
+	for _, line := range strings.Split(output, "\n") {
+		key, value, found := strings.Cut(strings.TrimSpace(line), " ")
+		if !found || value != "true" {
+			continue
+		}
+		remotes = append(remotes, strings.TrimSuffix(strings.TrimPrefix(key, "remote."), ".promisor"))
+	}
+	return remotes
+}
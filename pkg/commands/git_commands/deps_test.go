@@ -140,7 +140,7 @@ func buildPatchCommands(deps commonDeps) *PatchCommands { //nolint:golint,unused
 	return NewPatchCommands(gitCommon, rebaseCommands, commitCommands, statusCommands, stashCommands, patchBuilder)
 }
 
-func buildStatusCommands(deps commonDeps) *StatusCommands { //nolint:golint,unused
+func buildStatusCommands(deps commonDeps) *StatusCommands {
 	gitCommon := buildGitCommon(deps)
 
 	return NewStatusCommands(gitCommon)
@@ -180,6 +180,12 @@ func buildBranchCommands(deps commonDeps) *BranchCommands {
 	return NewBranchCommands(gitCommon)
 }
 
+func buildRemoteCommands(deps commonDeps) *RemoteCommands {
+	gitCommon := buildGitCommon(deps)
+
+	return NewRemoteCommands(gitCommon)
+}
+
 func buildFlowCommands(deps commonDeps) *FlowCommands {
 	gitCommon := buildGitCommon(deps)
 
@@ -8,6 +8,7 @@ import (
 
 	"github.com/jesseduffield/lazygit/pkg/commands/models"
 	"github.com/jesseduffield/lazygit/pkg/commands/oscommands"
+	"golang.org/x/exp/slices"
 )
 
 type FileLoaderConfig interface {
@@ -36,8 +37,17 @@ type GetStatusFileOptions struct {
 	// This is useful for users with bare repos for dotfiles who default to hiding untracked files,
 	// but want to occasionally see them to `git add` a new file.
 	ForceShowUntracked bool
+	// If set, this is called with the files loaded so far while `git status` is
+	// still running, so that we can show the first files of a huge working tree
+	// without waiting for all of them. It's called with twice as many files each
+	// time; GetStatusFiles still returns the complete list at the end.
+	OnBatch func(files []*models.File)
 }
 
+// How many files we load before calling GetStatusFileOptions.OnBatch for the
+// first time; that's enough to fill the files panel on any screen
+const firstStatusBatchSize = 500
+
 func (self *FileLoader) GetStatusFiles(opts GetStatusFileOptions) []*models.File {
 	// check if config wants us ignoring untracked files
 	untrackedFilesSetting := self.config.GetShowUntrackedFiles()
@@ -47,24 +57,24 @@ func (self *FileLoader) GetStatusFiles(opts GetStatusFileOptions) []*models.File
 	}
 	untrackedFilesArg := fmt.Sprintf("--untracked-files=%s", untrackedFilesSetting)
 
-	statuses, err := self.gitStatus(GitStatusOptions{NoRenames: opts.NoRenames, UntrackedFilesArg: untrackedFilesArg})
-	if err != nil {
-		self.Log.Error(err)
-	}
-	files := []*models.File{}
-
 	fileDiffs := map[string]FileDiff{}
 	if self.GitCommon.Common.UserConfig().Gui.ShowNumstatInFilesView {
+		var err error
 		fileDiffs, err = self.getFileDiffs()
 		if err != nil {
 			self.Log.Error(err)
 		}
 	}
 
-	for _, status := range statuses {
+	worktreePaths := linkedWortkreePaths(self.Fs, self.repoPaths.RepoGitDirPath())
+
+	files := []*models.File{}
+	nextBatchSize := firstStatusBatchSize
+
+	err := self.gitStatus(GitStatusOptions{NoRenames: opts.NoRenames, UntrackedFilesArg: untrackedFilesArg}, func(status FileStatus) {
 		if strings.HasPrefix(status.StatusString, "warning") {
 			self.Log.Warningf("warning when calling git status: %s", status.StatusString)
-			continue
+			return
 		}
 
 		file := &models.File{
@@ -79,32 +89,40 @@ func (self *FileLoader) GetStatusFiles(opts GetStatusFileOptions) []*models.File
 		}
 
 		models.SetStatusFields(file, status.Change)
+		self.markIfWorktree(file, worktreePaths)
 		files = append(files, file)
-	}
 
-	// Go through the files to see if any of these files are actually worktrees
-	// so that we can render them correctly
-	worktreePaths := linkedWortkreePaths(self.Fs, self.repoPaths.RepoGitDirPath())
-	for _, file := range files {
-		for _, worktreePath := range worktreePaths {
-			absFilePath, err := filepath.Abs(file.Name)
-			if err != nil {
-				self.Log.Error(err)
-				continue
-			}
-			if absFilePath == worktreePath {
-				file.IsWorktree = true
-				// `git status` renders this worktree as a folder with a trailing slash but we'll represent it as a singular worktree
-				// If we include the slash, it will be rendered as a folder with a null file inside.
-				file.Name = strings.TrimSuffix(file.Name, "/")
-				break
-			}
+		if opts.OnBatch != nil && len(files) == nextBatchSize {
+			opts.OnBatch(slices.Clip(files))
+			nextBatchSize *= 2
 		}
+	})
+	if err != nil {
+		self.Log.Error(err)
 	}
 
 	return files
 }
 
+// Checks whether the file is actually a linked worktree so that we can render
+// it correctly
+func (self *FileLoader) markIfWorktree(file *models.File, worktreePaths []string) {
+	for _, worktreePath := range worktreePaths {
+		absFilePath, err := filepath.Abs(file.Name)
+		if err != nil {
+			self.Log.Error(err)
+			continue
+		}
+		if absFilePath == worktreePath {
+			file.IsWorktree = true
+			// `git status` renders this worktree as a folder with a trailing slash but we'll represent it as a singular worktree
+			// If we include the slash, it will be rendered as a folder with a null file inside.
+			file.Name = strings.TrimSuffix(file.Name, "/")
+			break
+		}
+	}
+}
+
 type FileDiff struct {
 	LinesAdded   int
 	LinesDeleted int
@@ -167,7 +185,8 @@ func (fileLoader *FileLoader) gitDiffNumStat() (string, error) {
 	).DontLog().RunWithOutput()
 }
 
-func (self *FileLoader) gitStatus(opts GitStatusOptions) ([]FileStatus, error) {
+// calls onStatus for each entry of `git status` as soon as we've read it
+func (self *FileLoader) gitStatus(opts GitStatusOptions, onStatus func(FileStatus)) error {
 	cmdArgs := NewGitCmd("status").
 		Arg(opts.UntrackedFilesArg).
 		Arg("--porcelain").
@@ -179,37 +198,42 @@ func (self *FileLoader) gitStatus(opts GitStatusOptions) ([]FileStatus, error) {
 		).
 		ToArgv()
 
-	statusLines, _, err := self.cmd.New(cmdArgs).DontLog().RunWithOutputs()
-	if err != nil {
-		return []FileStatus{}, err
+	// we want to know if git status failed half-way, rather than silently
+	// showing only some of the files
+	cmdObj := self.cmd.New(cmdArgs).SplitOnNul().ReturnExitError()
+	if !self.UserConfig().Git.LogStatusTiming {
+		cmdObj.DontLog()
 	}
 
-	splitLines := strings.Split(statusLines, "\x00")
-	response := []FileStatus{}
+	var rename *FileStatus
 
-	for i := 0; i < len(splitLines); i++ {
-		original := splitLines[i]
+	return cmdObj.RunAndProcessLines(func(entry string) (bool, error) {
+		if rename != nil {
+			// if an entry starts with 'R' then the next entry is the original file.
+			rename.PreviousName = entry
+			rename.StatusString = fmt.Sprintf("%s %s -> %s", rename.Change, rename.PreviousName, rename.Name)
+			onStatus(*rename)
+			rename = nil
+			return false, nil
+		}
 
-		if len(original) < 3 {
-			continue
+		if len(entry) < 3 {
+			return false, nil
 		}
 
 		status := FileStatus{
-			StatusString: original,
-			Change:       original[:2],
-			Name:         original[3:],
+			StatusString: entry,
+			Change:       entry[:2],
+			Name:         entry[3:],
 			PreviousName: "",
 		}
 
 		if strings.HasPrefix(status.Change, "R") {
-			// if a line starts with 'R' then the next line is the original file.
-			status.PreviousName = splitLines[i+1]
-			status.StatusString = fmt.Sprintf("%s %s -> %s", status.Change, status.PreviousName, status.Name)
-			i++
+			rename = &status
+			return false, nil
 		}
 
-		response = append(response, status)
-	}
-
-	return response, nil
+		onStatus(status)
+		return false, nil
+	})
 }
@@ -1,11 +1,14 @@
 package git_commands
 
 import (
+	"fmt"
+	"strings"
 	"testing"
 
 	"github.com/jesseduffield/lazygit/pkg/commands/models"
 	"github.com/jesseduffield/lazygit/pkg/commands/oscommands"
 	"github.com/jesseduffield/lazygit/pkg/config"
+	"github.com/samber/lo"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -219,6 +222,34 @@ func TestFileGetStatusFiles(t *testing.T) {
 	}
 }
 
+func TestFileGetStatusFilesInBatches(t *testing.T) {
+	output := strings.Join(lo.Times(1200, func(i int) string {
+		return fmt.Sprintf("?? file%04d", i)
+	}), "\x00") + "\x00"
+	runner := oscommands.NewFakeRunner(t).
+		ExpectGitArgs([]string{"status", "--untracked-files=yes", "--porcelain", "-z", "--find-renames=50%"}, output, nil)
+
+	appState := &config.AppState{}
+	appState.RenameSimilarityThreshold = 50
+
+	loader := &FileLoader{
+		GitCommon:   buildGitCommon(commonDeps{appState: appState}),
+		cmd:         oscommands.NewDummyCmdObjBuilder(runner),
+		config:      &FakeFileLoaderConfig{showUntrackedFiles: "yes"},
+		getFileType: func(string) string { return "file" },
+	}
+
+	batches := [][]*models.File{}
+	files := loader.GetStatusFiles(GetStatusFileOptions{
+		OnBatch: func(files []*models.File) { batches = append(batches, files) },
+	})
+
+	assert.Len(t, files, 1200)
+	assert.Equal(t, []int{500, 1000}, lo.Map(batches, func(batch []*models.File, _ int) int { return len(batch) }))
+	assert.Equal(t, files[:1000], batches[1])
+	runner.CheckForMissingCalls()
+}
+
 type FakeFileLoaderConfig struct {
 	showUntrackedFiles string
 }
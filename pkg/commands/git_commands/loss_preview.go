@@ -0,0 +1,71 @@
+package git_commands
+
+import (
+	"strings"
+
+	"github.com/jesseduffield/lazygit/pkg/commands/models"
+	"github.com/jesseduffield/lazygit/pkg/commands/oscommands"
+	"github.com/jesseduffield/lazygit/pkg/utils"
+	"github.com/samber/lo"
+)
+
+// These are the building blocks for previewing what a destructive operation
+// would lose; see the ...Preview methods of the individual command structs.
+
+type lostCommitsOpts struct {
+	// The commits that are going away
+	tips []string
+	// Commits that stay around, in addition to all branches, tags and remote
+	// branches
+	keep []string
+	// Branches and remote branches (e.g. "origin/feature") that are going away
+	// as part of the operation, so they don't count as keeping their commits
+	// reachable
+	excludedBranches       []string
+	excludedRemoteBranches []string
+}
+
+// Returns the commits that are reachable from the tips but from nothing else
+// that stays around
+func loadLostCommits(cmd oscommands.ICmdObjBuilder, opts lostCommitsOpts) ([]*models.Commit, error) {
+	cmdArgs := NewGitCmd("log").
+		Arg("--format=%H%x00%s").
+		Arg(opts.tips...).
+		Arg("--not").
+		Arg(opts.keep...).
+		Arg("--tags").
+		Arg(lo.Map(opts.excludedRemoteBranches, func(branch string, _ int) string { return "--exclude=" + branch })...).
+		Arg("--remotes").
+		Arg(lo.Map(opts.excludedBranches, func(branch string, _ int) string { return "--exclude=" + branch })...).
+		Arg("--branches").
+		Arg("--").
+		ToArgv()
+
+	return loadPreviewCommits(cmd, cmdArgs)
+}
+
+func loadPreviewCommits(cmd oscommands.ICmdObjBuilder, cmdArgs []string) ([]*models.Commit, error) {
+	output, err := cmd.New(cmdArgs).DontLog().RunWithOutput()
+	if err != nil {
+		return nil, err
+	}
+
+	return lo.FilterMap(utils.SplitLines(output), func(line string, _ int) (*models.Commit, bool) {
+		hash, subject, found := strings.Cut(line, "\x00")
+		return &models.Commit{Hash: hash, Name: subject}, found
+	}), nil
+}
+
+// Returns the tracked files with changes that a hard reset would discard
+func loadUncommittedChanges(cmd oscommands.ICmdObjBuilder) ([]string, error) {
+	cmdArgs := NewGitCmd("diff").
+		Arg("HEAD", "--name-status", "--no-renames", "--").
+		ToArgv()
+
+	output, err := cmd.New(cmdArgs).DontLog().RunWithOutput()
+	if err != nil {
+		return nil, err
+	}
+
+	return utils.SplitLines(output), nil
+}
@@ -2,9 +2,12 @@ package git_commands
 
 import (
 	"fmt"
+	"slices"
 	"strings"
 
+	gogit "github.com/jesseduffield/go-git/v5"
 	"github.com/jesseduffield/gocui"
+	"github.com/samber/lo"
 )
 
 type RemoteCommands struct {
@@ -58,6 +61,36 @@ func (self *RemoteCommands) DeleteRemoteBranch(task gocui.Task, remoteName strin
 	return self.cmd.New(cmdArgs).PromptOnCredentialRequest(task).Run()
 }
 
+// StaleRemoteBranches returns the remote-tracking branches of the given remote
+// whose branch no longer exists on the remote, i.e. the ones that pruning would
+// delete
+func (self *RemoteCommands) StaleRemoteBranches(task gocui.Task, remoteName string) ([]string, error) {
+	cmdArgs := NewGitCmd("remote").
+		Arg("prune", "--dry-run", remoteName).
+		ToArgv()
+
+	output, err := self.cmd.New(cmdArgs).DontLog().PromptOnCredentialRequest(task).RunWithOutput()
+	if err != nil {
+		return nil, err
+	}
+
+	staleBranches := []string{}
+	for _, line := range strings.Split(output, "\n") {
+		if _, branch, found := strings.Cut(line, "[would prune] "); found {
+			staleBranches = append(staleBranches, strings.TrimSpace(branch))
+		}
+	}
+	return staleBranches, nil
+}
+
+func (self *RemoteCommands) Prune(task gocui.Task, remoteName string) error {
+	cmdArgs := NewGitCmd("remote").
+		Arg("prune", remoteName).
+		ToArgv()
+
+	return self.cmd.New(cmdArgs).PromptOnCredentialRequest(task).Run()
+}
+
 func (self *RemoteCommands) DeleteRemoteTag(task gocui.Task, remoteName string, tagName string) error {
 	cmdArgs := NewGitCmd("push").
 		Arg(remoteName, "--delete", tagName).
@@ -87,3 +120,26 @@ func (self *RemoteCommands) GetRemoteURL(remoteName string) (string, error) {
 	url, err := self.cmd.New(cmdArgs).RunWithOutput()
 	return strings.TrimSpace(url), err
 }
+
+// Returns the URLs of all remotes of the repo at the given path, which needn't
+// be the current repo. Returns nil if the repo can't be read.
+func (self *RemoteCommands) RemoteURLsOfRepo(repoPath string) []string {
+	repo, err := gogit.PlainOpenWithOptions(
+		repoPath,
+		&gogit.PlainOpenOptions{DetectDotGit: false, EnableDotGitCommonDir: true},
+	)
+	if err != nil {
+		return nil
+	}
+
+	conf, err := repo.Config()
+	if err != nil {
+		return nil
+	}
+
+	remoteNames := lo.Keys(conf.Remotes)
+	slices.Sort(remoteNames)
+	return lo.FlatMap(remoteNames, func(name string, _ int) []string {
+		return conf.Remotes[name].URLs
+	})
+}
@@ -0,0 +1,21 @@
+package git_commands
+
+import (
+	"testing"
+
+	"github.com/jesseduffield/gocui"
+	"github.com/jesseduffield/lazygit/pkg/commands/oscommands"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRemoteStaleRemoteBranches(t *testing.T) {
+	runner := oscommands.NewFakeRunner(t).
+		ExpectGitArgs([]string{"remote", "prune", "--dry-run", "origin"},
+			"Pruning origin\nURL: ../origin\n * [would prune] origin/feature\n * [would prune] origin/fix/typo\n", nil)
+	instance := buildRemoteCommands(commonDeps{runner: runner})
+
+	staleBranches, err := instance.StaleRemoteBranches(gocui.NewFakeTask(), "origin")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"origin/feature", "origin/fix/typo"}, staleBranches)
+	runner.CheckForMissingCalls()
+}
@@ -3,6 +3,7 @@ package git_commands
 import (
 	"os"
 	"path/filepath"
+	"strconv"
 	"strings"
 
 	"github.com/jesseduffield/lazygit/pkg/commands/types/enums"
@@ -74,3 +75,98 @@ func (self *StatusCommands) BranchBeingRebased() string {
 	}
 	return ""
 }
+
+// IsShallowRepo states whether the repo is a shallow clone, i.e. whether its
+// history has been cut off at the commits listed in .git/shallow
+func (self *StatusCommands) IsShallowRepo() bool {
+	exists, _ := self.os.FileExists(filepath.Join(self.repoPaths.RepoGitDirPath(), "shallow"))
+	return exists
+}
+
+// IsPartialClone states whether any of the repo's remotes is a promisor remote,
+// i.e. whether objects may have been omitted when cloning (e.g. with --filter)
+func (self *StatusCommands) IsPartialClone() bool {
+	return len(self.config.GetPromisorRemotes()) > 0
+}
+
+// StatusPerformance describes the git settings that speed up `git status` in
+// large repos
+type StatusPerformance struct {
+	// The value of core.fsmonitor: "true" for git's built-in file system
+	// monitor daemon, or the path of a hook. Empty if not set.
+	Fsmonitor string
+	// Whether git's built-in file system monitor daemon is available, which
+	// needs git 2.36 and is only supported on some platforms
+	FsmonitorDaemonSupported bool
+	// Whether the daemon is currently watching our worktree
+	FsmonitorDaemonRunning bool
+	// The value of core.untrackedCache: "true", "false", or "keep". Empty if
+	// not set.
+	UntrackedCache string
+}
+
+func (self *StatusPerformance) FsmonitorEnabled() bool {
+	return self.Fsmonitor != "" && self.Fsmonitor != "false"
+}
+
+// FsmonitorHook returns true if core.fsmonitor points at a hook rather than
+// using the built-in daemon
+func (self *StatusPerformance) FsmonitorHook() bool {
+	return self.FsmonitorEnabled() && self.Fsmonitor != "true"
+}
+
+func (self *StatusPerformance) UntrackedCacheEnabled() bool {
+	return self.UntrackedCache == "true"
+}
+
+func (self *StatusCommands) StatusPerformance() *StatusPerformance {
+	performance := &StatusPerformance{
+		Fsmonitor:      self.getLocalConfigValue("core.fsmonitor"),
+		UntrackedCache: self.getLocalConfigValue("core.untrackedCache"),
+	}
+
+	if self.version.IsAtLeast(2, 36, 0) {
+		cmdArgs := NewGitCmd("fsmonitor--daemon").Arg("status").ToArgv()
+		output, err := self.cmd.New(cmdArgs).DontLog().RunWithOutput()
+		// this is either "fsmonitor-daemon is watching '...'" or
+		// "fsmonitor-daemon is not watching '...'"; on platforms without
+		// the daemon, git says that it's not supported instead
+		performance.FsmonitorDaemonSupported = strings.Contains(output, "fsmonitor-daemon is")
+		performance.FsmonitorDaemonRunning = performance.FsmonitorDaemonSupported && err == nil
+	}
+
+	return performance
+}
+
+// not using the cached git config here, because we want to see the values
+// change when they're set from within lazygit
+func (self *StatusCommands) getLocalConfigValue(key string) string {
+	cmdArgs := NewGitCmd("config").Arg("--get", key).ToArgv()
+	output, _ := self.cmd.New(cmdArgs).DontLog().RunWithOutput()
+	return strings.ToLower(strings.TrimSpace(output))
+}
+
+// SetFsmonitor enables or disables git's built-in file system monitor daemon
+// for the repo
+func (self *StatusCommands) SetFsmonitor(enabled bool) error {
+	cmdArgs := NewGitCmd("config").Arg("core.fsmonitor", strconv.FormatBool(enabled)).ToArgv()
+	if err := self.cmd.New(cmdArgs).Run(); err != nil {
+		return err
+	}
+
+	if !enabled {
+		// git starts the daemon by itself when it's enabled, but doesn't stop
+		// it when it's disabled. It's fine if it wasn't running.
+		stopArgs := NewGitCmd("fsmonitor--daemon").Arg("stop").ToArgv()
+		_ = self.cmd.New(stopArgs).DontLog().Run()
+	}
+
+	return nil
+}
+
+// SetUntrackedCache enables or disables caching untracked files in the index
+// for the repo
+func (self *StatusCommands) SetUntrackedCache(enabled bool) error {
+	cmdArgs := NewGitCmd("config").Arg("core.untrackedCache", strconv.FormatBool(enabled)).ToArgv()
+	return self.cmd.New(cmdArgs).Run()
+}
@@ -0,0 +1,135 @@
+package git_commands
+
+import (
+	"testing"
+
+	"github.com/go-errors/errors"
+	"github.com/jesseduffield/lazygit/pkg/commands/oscommands"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStatusPerformance(t *testing.T) {
+	scenarios := []struct {
+		testName   string
+		gitVersion *GitVersion
+		runner     *oscommands.FakeCmdObjRunner
+		expected   *StatusPerformance
+	}{
+		{
+			testName:   "nothing configured, daemon not supported on this platform",
+			gitVersion: &GitVersion{2, 39, 0, ""},
+			runner: oscommands.NewFakeRunner(t).
+				ExpectGitArgs([]string{"config", "--get", "core.fsmonitor"}, "", errors.New("exit status 1")).
+				ExpectGitArgs([]string{"config", "--get", "core.untrackedCache"}, "", errors.New("exit status 1")).
+				ExpectGitArgs([]string{"fsmonitor--daemon", "status"}, "fatal: fsmonitor--daemon not supported on this platform\n", errors.New("exit status 128")),
+			expected: &StatusPerformance{},
+		},
+		{
+			testName:   "both enabled, daemon running",
+			gitVersion: &GitVersion{2, 39, 0, ""},
+			runner: oscommands.NewFakeRunner(t).
+				ExpectGitArgs([]string{"config", "--get", "core.fsmonitor"}, "true\n", nil).
+				ExpectGitArgs([]string{"config", "--get", "core.untrackedCache"}, "True\n", nil).
+				ExpectGitArgs([]string{"fsmonitor--daemon", "status"}, "fsmonitor-daemon is watching '/repo'\n", nil),
+			expected: &StatusPerformance{
+				Fsmonitor:                "true",
+				FsmonitorDaemonSupported: true,
+				FsmonitorDaemonRunning:   true,
+				UntrackedCache:           "true",
+			},
+		},
+		{
+			testName:   "daemon supported but not running",
+			gitVersion: &GitVersion{2, 39, 0, ""},
+			runner: oscommands.NewFakeRunner(t).
+				ExpectGitArgs([]string{"config", "--get", "core.fsmonitor"}, "", errors.New("exit status 1")).
+				ExpectGitArgs([]string{"config", "--get", "core.untrackedCache"}, "keep\n", nil).
+				ExpectGitArgs([]string{"fsmonitor--daemon", "status"}, "fsmonitor-daemon is not watching '/repo'\n", errors.New("exit status 1")),
+			expected: &StatusPerformance{
+				FsmonitorDaemonSupported: true,
+				UntrackedCache:           "keep",
+			},
+		},
+		{
+			testName:   "git too old for the daemon",
+			gitVersion: &GitVersion{2, 35, 0, ""},
+			runner: oscommands.NewFakeRunner(t).
+				ExpectGitArgs([]string{"config", "--get", "core.fsmonitor"}, "/path/to/hook\n", nil).
+				ExpectGitArgs([]string{"config", "--get", "core.untrackedCache"}, "", errors.New("exit status 1")),
+			expected: &StatusPerformance{
+				Fsmonitor: "/path/to/hook",
+			},
+		},
+	}
+
+	for _, s := range scenarios {
+		t.Run(s.testName, func(t *testing.T) {
+			instance := buildStatusCommands(commonDeps{runner: s.runner, gitVersion: s.gitVersion})
+
+			assert.Equal(t, s.expected, instance.StatusPerformance())
+			s.runner.CheckForMissingCalls()
+		})
+	}
+}
+
+func TestStatusPerformanceSettings(t *testing.T) {
+	scenarios := []struct {
+		testName         string
+		performance      StatusPerformance
+		fsmonitorEnabled bool
+		fsmonitorHook    bool
+		untrackedCache   bool
+	}{
+		{
+			testName:    "nothing configured",
+			performance: StatusPerformance{},
+		},
+		{
+			testName:    "explicitly disabled",
+			performance: StatusPerformance{Fsmonitor: "false", UntrackedCache: "false"},
+		},
+		{
+			testName:         "built-in daemon",
+			performance:      StatusPerformance{Fsmonitor: "true", UntrackedCache: "true"},
+			fsmonitorEnabled: true,
+			untrackedCache:   true,
+		},
+		{
+			testName:         "hook",
+			performance:      StatusPerformance{Fsmonitor: ".git/hooks/query-watchman", UntrackedCache: "keep"},
+			fsmonitorEnabled: true,
+			fsmonitorHook:    true,
+		},
+	}
+
+	for _, s := range scenarios {
+		t.Run(s.testName, func(t *testing.T) {
+			assert.Equal(t, s.fsmonitorEnabled, s.performance.FsmonitorEnabled())
+			assert.Equal(t, s.fsmonitorHook, s.performance.FsmonitorHook())
+			assert.Equal(t, s.untrackedCache, s.performance.UntrackedCacheEnabled())
+		})
+	}
+}
+
+func TestStatusSetFsmonitor(t *testing.T) {
+	runner := oscommands.NewFakeRunner(t).
+		ExpectGitArgs([]string{"config", "core.fsmonitor", "true"}, "", nil).
+		ExpectGitArgs([]string{"config", "core.fsmonitor", "false"}, "", nil).
+		ExpectGitArgs([]string{"fsmonitor--daemon", "stop"}, "", errors.New("fsmonitor-daemon is not running"))
+	instance := buildStatusCommands(commonDeps{runner: runner})
+
+	assert.NoError(t, instance.SetFsmonitor(true))
+	assert.NoError(t, instance.SetFsmonitor(false))
+	runner.CheckForMissingCalls()
+}
+
+func TestStatusSetUntrackedCache(t *testing.T) {
+	runner := oscommands.NewFakeRunner(t).
+		ExpectGitArgs([]string{"config", "core.untrackedCache", "true"}, "", nil).
+		ExpectGitArgs([]string{"config", "core.untrackedCache", "false"}, "", nil)
+	instance := buildStatusCommands(commonDeps{runner: runner})
+
+	assert.NoError(t, instance.SetUntrackedCache(true))
+	assert.NoError(t, instance.SetUntrackedCache(false))
+	runner.CheckForMissingCalls()
+}
@@ -6,9 +6,11 @@ import (
 	"path/filepath"
 	"regexp"
 	"strings"
+	"sync"
 
 	"github.com/jesseduffield/lazygit/pkg/commands/models"
 	"github.com/jesseduffield/lazygit/pkg/commands/oscommands"
+	"github.com/jesseduffield/lazygit/pkg/utils"
 )
 
 // .gitmodules looks like this:
@@ -76,6 +78,8 @@ func (self *SubmoduleCommands) GetConfigs(parentModule *models.SubmoduleConfig)
 				}
 			} else if url, ok := firstMatch(line, `\s*url\s*=\s*(.*)\s*`); ok {
 				configs[lastConfigIdx].Url = url
+			} else if branch, ok := firstMatch(line, `\s*branch\s*=\s*(.*)\s*`); ok {
+				configs[lastConfigIdx].Branch = branch
 			}
 		}
 	}
@@ -83,6 +87,130 @@ func (self *SubmoduleCommands) GetConfigs(parentModule *models.SubmoduleConfig)
 	return configs, nil
 }
 
+// LoadStatuses populates the Status of the given submodules (which are
+// expected to include nested ones, as returned by GetConfigs)
+func (self *SubmoduleCommands) LoadStatuses(configs []*models.SubmoduleConfig) error {
+	if len(configs) == 0 {
+		return nil
+	}
+
+	checkedOut, err := self.getStatusLines(false)
+	if err != nil {
+		return err
+	}
+	recorded, err := self.getStatusLines(true)
+	if err != nil {
+		return err
+	}
+
+	wg := sync.WaitGroup{}
+	for _, config := range configs {
+		line, ok := checkedOut[config.FullPath()]
+		if !ok {
+			continue
+		}
+
+		status := &models.SubmoduleStatus{
+			RecordedHash:      recorded[config.FullPath()].hash,
+			IsInitialized:     line.prefix != '-',
+			HasMergeConflicts: line.prefix == 'U',
+		}
+		if status.IsInitialized {
+			status.CheckedOutHash = line.hash
+
+			wg.Add(1)
+			go utils.Safe(func() {
+				defer wg.Done()
+
+				status.IsDirty = self.isDirty(config)
+			})
+		}
+		config.Status = status
+	}
+	wg.Wait()
+
+	return nil
+}
+
+type submoduleStatusLine struct {
+	// one of ' ' (in sync), '+' (checked out commit differs from recorded one),
+	// '-' (not initialized) or 'U' (merge conflicts)
+	prefix byte
+	hash   string
+}
+
+// Returns the output of `git submodule status` keyed by submodule path. If
+// cached is true, the hashes are the ones recorded in the parent repo's index
+// rather than the checked-out ones.
+func (self *SubmoduleCommands) getStatusLines(cached bool) (map[string]submoduleStatusLine, error) {
+	cmdArgs := NewGitCmd("submodule").Arg("status").
+		ArgIf(cached, "--cached").
+		Arg("--recursive").
+		ToArgv()
+
+	output, err := self.cmd.New(cmdArgs).DontLog().RunWithOutput()
+	if err != nil {
+		return nil, err
+	}
+
+	return parseSubmoduleStatus(output), nil
+}
+
+// Lines look like this (the part in parens is only shown for initialized
+// submodules):
+//
+//	+4b825dc642cb6eb9a060e54bf8d69288fbee4904 path/to/sub (v1.0.0-3-g4b825dc)
+func parseSubmoduleStatus(output string) map[string]submoduleStatusLine {
+	result := map[string]submoduleStatusLine{}
+	for _, line := range strings.Split(utils.NormalizeLinefeeds(output), "\n") {
+		if len(line) < 2 {
+			continue
+		}
+
+		hash, path, found := strings.Cut(line[1:], " ")
+		if !found {
+			continue
+		}
+		if idx := strings.LastIndex(path, " ("); idx != -1 && strings.HasSuffix(path, ")") {
+			path = path[:idx]
+		}
+
+		result[path] = submoduleStatusLine{prefix: line[0], hash: hash}
+	}
+
+	return result
+}
+
+func (self *SubmoduleCommands) isDirty(submodule *models.SubmoduleConfig) bool {
+	cmdArgs := NewGitCmd("status").
+		Dir(submodule.FullPath()).
+		Arg("--porcelain", "--ignore-submodules=none").
+		ToArgv()
+
+	output, err := self.cmd.New(cmdArgs).DontLog().RunWithOutput()
+	if err != nil {
+		self.Log.Warnf("Could not get status of submodule %s: %v", submodule.FullPath(), err)
+		return false
+	}
+
+	return strings.TrimSpace(output) != ""
+}
+
+// DriftLogCmdObj shows the commits between the submodule's recorded commit
+// and the checked-out one: '>' marks commits that are only in the checked-out
+// one and '<' those that are only in the recorded one
+func (self *SubmoduleCommands) DriftLogCmdObj(submodule *models.SubmoduleConfig) oscommands.ICmdObj {
+	cmdArgs := NewGitCmd("log").
+		Dir(submodule.FullPath()).
+		Arg("--left-right", "--oneline", "--decorate").
+		Arg("--color=" + self.UserConfig().Git.Paging.ColorArg).
+		Arg(submodule.Status.RecordedHash + "..." + submodule.Status.CheckedOutHash).
+		Arg("--").
+		ToArgv()
+
+	return self.cmd.New(cmdArgs).DontLog()
+}
+
 func (self *SubmoduleCommands) Stash(submodule *models.SubmoduleConfig) error {
 	// if the path does not exist then it hasn't yet been initialized so we'll swallow the error
 	// because the intention here is to have no dirty worktree state
@@ -265,6 +393,67 @@ func (self *SubmoduleCommands) BulkDeinitCmdObj() oscommands.ICmdObj {
 	return self.cmd.New(cmdArgs)
 }
 
+type SubmoduleOperation int
+
+const (
+	SubmoduleOperationInit SubmoduleOperation = iota
+	SubmoduleOperationUpdate
+	SubmoduleOperationSync
+	SubmoduleOperationFetch
+)
+
+// Steps returns the operations that make up this one, in the order in which
+// they must be run: updating a submodule means initializing it first.
+func (self SubmoduleOperation) Steps() []SubmoduleOperation {
+	if self == SubmoduleOperationUpdate {
+		return []SubmoduleOperation{SubmoduleOperationInit, SubmoduleOperationUpdate}
+	}
+
+	return []SubmoduleOperation{self}
+}
+
+// WritesParentConfig returns true if the operation writes to the config of the
+// parent repo. Git fails if it can't lock the config file, so such operations
+// must not run concurrently.
+func (self SubmoduleOperation) WritesParentConfig() bool {
+	return self == SubmoduleOperationInit || self == SubmoduleOperationSync
+}
+
+// RunsInsideSubmodule returns true if the operation runs in the submodule's own
+// repo rather than in its parent. A submodule that isn't initialized has no
+// repo of its own, so git would run the operation on the parent instead.
+func (self SubmoduleOperation) RunsInsideSubmodule() bool {
+	return self == SubmoduleOperationFetch
+}
+
+// OperationCmdObj returns the command for running the given operation on a
+// single submodule. Commands other than fetch are run from the parent repo
+// (which for nested submodules is the parent submodule). The update command
+// expects the submodule to have been initialized already (see Steps).
+//
+// These are meant to be run in parallel, so they fail rather than prompt if
+// credentials are needed.
+func (self *SubmoduleCommands) OperationCmdObj(operation SubmoduleOperation, submodule *models.SubmoduleConfig) oscommands.ICmdObj {
+	parentDir := ""
+	if submodule.ParentModule != nil {
+		parentDir = submodule.ParentModule.FullPath()
+	}
+
+	var cmdArgs *GitCommandBuilder
+	switch operation {
+	case SubmoduleOperationInit:
+		cmdArgs = NewGitCmd("submodule").Arg("init", "--", submodule.Path).DirIf(parentDir != "", parentDir)
+	case SubmoduleOperationUpdate:
+		cmdArgs = NewGitCmd("submodule").Arg("update", "--", submodule.Path).DirIf(parentDir != "", parentDir)
+	case SubmoduleOperationSync:
+		cmdArgs = NewGitCmd("submodule").Arg("sync", "--", submodule.Path).DirIf(parentDir != "", parentDir)
+	case SubmoduleOperationFetch:
+		cmdArgs = NewGitCmd("fetch").Dir(submodule.FullPath())
+	}
+
+	return self.cmd.New(cmdArgs.ToArgv()).FailOnCredentialRequest()
+}
+
 func (self *SubmoduleCommands) ResetSubmodules(submodules []*models.SubmoduleConfig) error {
 	for _, submodule := range submodules {
 		if err := self.Stash(submodule); err != nil {
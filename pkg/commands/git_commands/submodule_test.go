@@ -0,0 +1,89 @@
+package git_commands
+
+import (
+	"testing"
+
+	"github.com/jesseduffield/lazygit/pkg/commands/models"
+	"github.com/jesseduffield/lazygit/pkg/commands/oscommands"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSubmoduleLoadStatuses(t *testing.T) {
+	parent := &models.SubmoduleConfig{Name: "parent", Path: "parent"}
+	configs := []*models.SubmoduleConfig{
+		parent,
+		{Name: "nested", Path: "nested dir", ParentModule: parent},
+		{Name: "uninitialized", Path: "uninitialized"},
+		{Name: "conflicted", Path: "conflicted"},
+	}
+
+	runner := oscommands.NewFakeRunner(t).
+		ExpectGitArgs([]string{"submodule", "status", "--recursive"},
+			`+2222222222222222222222222222222222222222 parent (v1.0.0-1-g2222222)
+ 3333333333333333333333333333333333333333 parent/nested dir (heads/main)
+-4444444444444444444444444444444444444444 uninitialized
+U0000000000000000000000000000000000000000 conflicted
+`, nil).
+		ExpectGitArgs([]string{"submodule", "status", "--cached", "--recursive"},
+			`+1111111111111111111111111111111111111111 parent (v1.0.0)
+ 3333333333333333333333333333333333333333 parent/nested dir (heads/main)
+-4444444444444444444444444444444444444444 uninitialized
+U0000000000000000000000000000000000000000 conflicted
+`, nil).
+		ExpectGitArgs([]string{"-C", "parent", "status", "--porcelain", "--ignore-submodules=none"}, " M nested dir\n", nil).
+		ExpectGitArgs([]string{"-C", "parent/nested dir", "status", "--porcelain", "--ignore-submodules=none"}, "", nil).
+		ExpectGitArgs([]string{"-C", "conflicted", "status", "--porcelain", "--ignore-submodules=none"}, "", nil)
+
+	instance := buildSubmoduleCommands(commonDeps{runner: runner})
+	assert.NoError(t, instance.LoadStatuses(configs))
+	runner.CheckForMissingCalls()
+
+	assert.Equal(t, &models.SubmoduleStatus{
+		RecordedHash:   "1111111111111111111111111111111111111111",
+		CheckedOutHash: "2222222222222222222222222222222222222222",
+		IsInitialized:  true,
+		IsDirty:        true,
+	}, configs[0].Status)
+	assert.True(t, configs[0].Status.HasDrift())
+
+	assert.Equal(t, &models.SubmoduleStatus{
+		RecordedHash:   "3333333333333333333333333333333333333333",
+		CheckedOutHash: "3333333333333333333333333333333333333333",
+		IsInitialized:  true,
+	}, configs[1].Status)
+	assert.False(t, configs[1].Status.HasDrift())
+
+	assert.Equal(t, &models.SubmoduleStatus{
+		RecordedHash: "4444444444444444444444444444444444444444",
+	}, configs[2].Status)
+	assert.False(t, configs[2].Status.HasDrift())
+
+	assert.True(t, configs[3].Status.HasMergeConflicts)
+	assert.False(t, configs[3].Status.HasDrift())
+}
+
+func TestSubmoduleOperationCmdObj(t *testing.T) {
+	parent := &models.SubmoduleConfig{Name: "parent", Path: "parent"}
+	nested := &models.SubmoduleConfig{Name: "nested", Path: "nested", ParentModule: parent}
+
+	scenarios := []struct {
+		testName  string
+		operation SubmoduleOperation
+		submodule *models.SubmoduleConfig
+		expected  []string
+	}{
+		{"init", SubmoduleOperationInit, parent, []string{"git", "submodule", "init", "--", "parent"}},
+		{"update nested", SubmoduleOperationUpdate, nested, []string{"git", "-C", "parent", "submodule", "update", "--", "nested"}},
+		{"sync nested", SubmoduleOperationSync, nested, []string{"git", "-C", "parent", "submodule", "sync", "--", "nested"}},
+		{"fetch nested", SubmoduleOperationFetch, nested, []string{"git", "-C", "parent/nested", "fetch"}},
+	}
+
+	for _, s := range scenarios {
+		t.Run(s.testName, func(t *testing.T) {
+			instance := buildSubmoduleCommands(commonDeps{})
+			cmdObj := instance.OperationCmdObj(s.operation, s.submodule)
+			assert.Equal(t, s.expected, cmdObj.Args())
+			assert.Equal(t, oscommands.FAIL, cmdObj.GetCredentialStrategy())
+		})
+	}
+}
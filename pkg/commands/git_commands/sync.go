@@ -2,10 +2,15 @@ package git_commands
 
 import (
 	"fmt"
+	"strconv"
+	"strings"
 
 	"github.com/go-errors/errors"
 	"github.com/jesseduffield/gocui"
+	"github.com/jesseduffield/lazygit/pkg/commands/models"
 	"github.com/jesseduffield/lazygit/pkg/commands/oscommands"
+	"github.com/jesseduffield/lazygit/pkg/utils"
+	"github.com/samber/lo"
 )
 
 type SyncCommands struct {
@@ -22,23 +27,42 @@ func NewSyncCommands(gitCommon *GitCommon) *SyncCommands {
 type PushOpts struct {
 	Force          bool
 	ForceWithLease bool
-	CurrentBranch  string
-	UpstreamRemote string
-	UpstreamBranch string
-	SetUpstream    bool
+	// If set, the force-with-lease only succeeds if the remote branch is still
+	// at this commit, rather than wherever our remote-tracking branch happens
+	// to point when the push runs. Requires UpstreamBranch.
+	ForceWithLeaseExpectedHash string
+	CurrentBranch              string
+	UpstreamRemote             string
+	UpstreamBranch             string
+	SetUpstream                bool
+	Atomic                     bool
+	PushOptions                []string
+	// Pushed instead of the current branch. Requires UpstreamRemote.
+	Refspecs []string
 }
 
 func (self *SyncCommands) PushCmdObj(task gocui.Task, opts PushOpts) (oscommands.ICmdObj, error) {
-	if opts.UpstreamBranch != "" && opts.UpstreamRemote == "" {
+	if (opts.UpstreamBranch != "" || len(opts.Refspecs) > 0) && opts.UpstreamRemote == "" {
 		return nil, errors.New(self.Tr.MustSpecifyOriginError)
 	}
 
+	if opts.ForceWithLeaseExpectedHash != "" && opts.UpstreamBranch == "" {
+		return nil, errors.New(self.Tr.MustSpecifyBranchForLeaseError)
+	}
+
 	cmdArgs := NewGitCmd("push").
 		ArgIf(opts.Force, "--force").
-		ArgIf(opts.ForceWithLease, "--force-with-lease").
+		ArgIf(opts.ForceWithLease && opts.ForceWithLeaseExpectedHash == "", "--force-with-lease").
+		ArgIf(opts.ForceWithLease && opts.ForceWithLeaseExpectedHash != "",
+			fmt.Sprintf("--force-with-lease=refs/heads/%s:%s", opts.UpstreamBranch, opts.ForceWithLeaseExpectedHash)).
 		ArgIf(opts.SetUpstream, "--set-upstream").
+		ArgIf(opts.Atomic, "--atomic").
+		Arg(lo.Map(opts.PushOptions, func(option string, _ int) string {
+			return "--push-option=" + option
+		})...).
 		ArgIf(opts.UpstreamRemote != "", opts.UpstreamRemote).
 		ArgIf(opts.UpstreamBranch != "", fmt.Sprintf("refs/heads/%s:%s", opts.CurrentBranch, opts.UpstreamBranch)).
+		Arg(opts.Refspecs...).
 		ToArgv()
 
 	cmdObj := self.cmd.New(cmdArgs).PromptOnCredentialRequest(task)
@@ -86,6 +110,153 @@ func (self *SyncCommands) FetchBackground() error {
 	return self.FetchBackgroundCmdObj().Run()
 }
 
+// FetchRemoteBackgroundCmdObj fetches a single remote in the background, using
+// the options configured for it
+func (self *SyncCommands) FetchRemoteBackgroundCmdObj(remoteName string) oscommands.ICmdObj {
+	cmdArgs := self.fetchRemoteCommandBuilder(remoteName, self.FetchOptsForRemote(remoteName), false).ToArgv()
+
+	cmdObj := self.cmd.New(cmdArgs)
+	cmdObj.DontLog().FailOnCredentialRequest()
+	return cmdObj
+}
+
+func (self *SyncCommands) FetchRemoteBackground(remoteName string) error {
+	return self.FetchRemoteBackgroundCmdObj(remoteName).Run()
+}
+
+// RemoteBranchHashes returns the hashes of the remote branches of the given
+// remote (or of all remotes if remoteName is empty), keyed by full ref name.
+// Comparing the results from before and after a fetch tells us what the fetch
+// changed, without needing `git fetch --porcelain`.
+func (self *SyncCommands) RemoteBranchHashes(remoteName string) (map[string]string, error) {
+	refPrefix := "refs/remotes"
+	if remoteName != "" {
+		refPrefix += "/" + remoteName
+	}
+
+	cmdArgs := NewGitCmd("for-each-ref").
+		Arg("--format=%(refname)%00%(objectname)").
+		Arg(refPrefix).
+		ToArgv()
+
+	output, err := self.cmd.New(cmdArgs).DontLog().RunWithOutput()
+	if err != nil {
+		return nil, err
+	}
+
+	hashes := map[string]string{}
+	for _, line := range utils.SplitLines(output) {
+		refName, hash, found := strings.Cut(line, "\x00")
+		if found {
+			hashes[refName] = hash
+		}
+	}
+	return hashes, nil
+}
+
+type RemoteBranchUpdate struct {
+	// The full ref name, e.g. refs/remotes/origin/main
+	RefName string
+	// True if the branch was rewritten rather than just added to
+	Forced bool
+	// The number of commits that the branch has now but didn't have before
+	NewCommits int
+}
+
+// RemoteBranchUpdates returns which of the given remote branches changed
+// between two results of RemoteBranchHashes. Branches that were created or
+// deleted in between are left out.
+func (self *SyncCommands) RemoteBranchUpdates(before map[string]string, after map[string]string, refNames []string) ([]*RemoteBranchUpdate, error) {
+	updates := []*RemoteBranchUpdate{}
+	for _, refName := range refNames {
+		oldHash, newHash := before[refName], after[refName]
+		if oldHash == "" || newHash == "" || oldHash == newHash {
+			continue
+		}
+
+		isAncestorArgs := NewGitCmd("merge-base").Arg("--is-ancestor", oldHash, newHash).ToArgv()
+		forced := self.cmd.New(isAncestorArgs).DontLog().Run() != nil
+
+		countArgs := NewGitCmd("rev-list").Arg("--count", oldHash+".."+newHash).ToArgv()
+		output, err := self.cmd.New(countArgs).DontLog().RunWithOutput()
+		if err != nil {
+			return nil, err
+		}
+		newCommits, err := strconv.Atoi(strings.TrimSpace(output))
+		if err != nil {
+			return nil, err
+		}
+
+		updates = append(updates, &RemoteBranchUpdate{RefName: refName, Forced: forced, NewCommits: newCommits})
+	}
+	return updates, nil
+}
+
+// Deepen fetches `depth` more commits of history for a shallow clone
+func (self *SyncCommands) Deepen(task gocui.Task, depth int) error {
+	cmdArgs := self.fetchCommandBuilder(false).
+		Arg(fmt.Sprintf("--deepen=%d", depth)).
+		ToArgv()
+
+	return self.cmd.New(cmdArgs).PromptOnCredentialRequest(task).Run()
+}
+
+// DeepenSince fetches the history of a shallow clone back to the given date.
+// The date can be anything git understands, e.g. "2024-01-31" or "3 weeks ago"
+func (self *SyncCommands) DeepenSince(task gocui.Task, date string) error {
+	cmdArgs := self.fetchCommandBuilder(false).
+		Arg("--shallow-since=" + date).
+		ToArgv()
+
+	return self.cmd.New(cmdArgs).PromptOnCredentialRequest(task).Run()
+}
+
+// Unshallow fetches the complete history of a shallow clone
+func (self *SyncCommands) Unshallow(task gocui.Task) error {
+	cmdArgs := self.fetchCommandBuilder(false).
+		Arg("--unshallow").
+		ToArgv()
+
+	return self.cmd.New(cmdArgs).PromptOnCredentialRequest(task).Run()
+}
+
+type CloneOpts struct {
+	URL       string
+	Directory string
+	// Zero means the full history
+	Depth int
+	// Empty means the remote's default branch
+	Branch string
+	// e.g. "blob:none" for a blobless clone; empty means no filter
+	Filter string
+}
+
+// Clone clones a repository into opts.Directory. Progress is reported in the
+// command log.
+func (self *SyncCommands) Clone(task gocui.Task, opts CloneOpts) error {
+	cmdArgs := NewGitCmd("clone").
+		Arg("--progress").
+		ArgIf(opts.Depth > 0, fmt.Sprintf("--depth=%d", opts.Depth)).
+		ArgIf(opts.Branch != "", "--branch", opts.Branch).
+		ArgIf(opts.Filter != "", "--filter="+opts.Filter).
+		Arg("--", opts.URL, opts.Directory).
+		ToArgv()
+
+	return self.cmd.New(cmdArgs).PromptOnCredentialRequest(task).Run()
+}
+
+// RepoNameFromURL returns the directory name git would clone the given URL
+// into, e.g. "lazygit" for "git@github.com:jesseduffield/lazygit.git"
+func RepoNameFromURL(url string) string {
+	name := strings.TrimRight(strings.TrimSpace(url), "/")
+	name = strings.TrimSuffix(name, "/.git")
+	name = strings.TrimSuffix(name, ".git")
+	if i := strings.LastIndexAny(name, "/:\\"); i >= 0 {
+		name = name[i+1:]
+	}
+	return name
+}
+
 type PullOptions struct {
 	RemoteName      string
 	BranchName      string
@@ -123,10 +294,143 @@ func (self *SyncCommands) FastForward(
 	return self.cmd.New(cmdArgs).PromptOnCredentialRequest(task).Run()
 }
 
-func (self *SyncCommands) FetchRemote(task gocui.Task, remoteName string) error {
-	cmdArgs := self.fetchCommandBuilder(false).
+// FetchOpts are the options for fetching a single remote
+type FetchOpts struct {
+	Prune bool
+	// Only has an effect together with Prune
+	PruneTags bool
+	// One of the FetchTags constants
+	Tags     string
+	Depth    int
+	Refspecs []string
+}
+
+const (
+	FetchTagsDefault = ""
+	FetchTagsAll     = "all"
+	FetchTagsNone    = "none"
+)
+
+// FetchOptsForRemote returns the fetch options configured for the given remote
+func (self *SyncCommands) FetchOptsForRemote(remoteName string) FetchOpts {
+	remoteConfig := self.UserConfig().Git.RemoteFetch[remoteName]
+	return FetchOpts{
+		Prune:     remoteConfig.Prune,
+		PruneTags: remoteConfig.PruneTags,
+		Tags:      remoteConfig.Tags,
+		Depth:     remoteConfig.Depth,
+		Refspecs:  remoteConfig.Refspecs,
+	}
+}
+
+func (self *SyncCommands) fetchRemoteCommandBuilder(remoteName string, opts FetchOpts, porcelain bool) *GitCommandBuilder {
+	return self.fetchCommandBuilder(false).
+		ArgIf(porcelain, "--porcelain").
+		ArgIf(opts.Prune, "--prune").
+		ArgIf(opts.Prune && opts.PruneTags, "--prune-tags").
+		ArgIf(opts.Tags == FetchTagsAll, "--tags").
+		ArgIf(opts.Tags == FetchTagsNone, "--no-tags").
+		ArgIf(opts.Depth > 0, fmt.Sprintf("--depth=%d", opts.Depth)).
 		Arg(remoteName).
-		ToArgv()
+		Arg(opts.Refspecs...)
+}
+
+// FetchRemote fetches the given remote using the options configured for it
+func (self *SyncCommands) FetchRemote(task gocui.Task, remoteName string) error {
+	cmdArgs := self.fetchRemoteCommandBuilder(remoteName, self.FetchOptsForRemote(remoteName), false).ToArgv()
 
 	return self.cmd.New(cmdArgs).PromptOnCredentialRequest(task).Run()
 }
+
+// CanReportFetchedRefs tells whether FetchRemoteWithReport is able to report
+// the refs that were fetched; this needs `git fetch --porcelain`.
+func (self *SyncCommands) CanReportFetchedRefs() bool {
+	return self.version.IsAtLeast(2, 41, 0)
+}
+
+// FetchRemoteWithReport fetches the given remote and returns the refs that
+// were created, updated or pruned by it. The result is empty if
+// CanReportFetchedRefs is false.
+func (self *SyncCommands) FetchRemoteWithReport(task gocui.Task, remoteName string, opts FetchOpts) ([]*FetchedRef, error) {
+	canReport := self.CanReportFetchedRefs()
+	cmdArgs := self.fetchRemoteCommandBuilder(remoteName, opts, canReport).ToArgv()
+
+	output, err := self.cmd.New(cmdArgs).PromptOnCredentialRequest(task).RunWithOutput()
+	if err != nil || !canReport {
+		return nil, err
+	}
+
+	return parseFetchPorcelain(output), nil
+}
+
+type FetchedRefStatus int
+
+const (
+	FetchedRefCreated FetchedRefStatus = iota
+	FetchedRefUpdated
+	FetchedRefForcedUpdate
+	FetchedRefPruned
+	FetchedRefRejected
+)
+
+type FetchedRef struct {
+	Status  FetchedRefStatus
+	OldHash string
+	NewHash string
+	// The local ref, e.g. refs/remotes/origin/main or refs/tags/v1.0
+	Ref string
+}
+
+// Each line of `git fetch --porcelain` looks like
+// <flag> <old-object-id> <new-object-id> <local-reference>
+func parseFetchPorcelain(output string) []*FetchedRef {
+	statuses := map[byte]FetchedRefStatus{
+		'*': FetchedRefCreated,
+		' ': FetchedRefUpdated,
+		't': FetchedRefUpdated,
+		'+': FetchedRefForcedUpdate,
+		'-': FetchedRefPruned,
+		'!': FetchedRefRejected,
+	}
+
+	result := []*FetchedRef{}
+	for _, line := range strings.Split(output, "\n") {
+		line = strings.TrimRight(line, "\r")
+		if len(line) < 2 {
+			continue
+		}
+
+		status, ok := statuses[line[0]]
+		if !ok {
+			// '=' means up to date
+			continue
+		}
+
+		fields := strings.Fields(line[2:])
+		if len(fields) != 3 {
+			continue
+		}
+
+		result = append(result, &FetchedRef{
+			Status:  status,
+			OldHash: fields[0],
+			NewHash: fields[1],
+			Ref:     fields[2],
+		})
+	}
+
+	return result
+}
+
+// ForcePushPreview returns the commits of remoteRef (as of the last fetch)
+// that force pushing localRef to it would overwrite
+func (self *SyncCommands) ForcePushPreview(localRef string, remoteRef string) (*models.LossPreview, error) {
+	commits, err := loadPreviewCommits(self.cmd, NewGitCmd("log").
+		Arg("--format=%H%x00%s", remoteRef, "--not", localRef, "--").
+		ToArgv())
+	if err != nil {
+		return nil, err
+	}
+
+	return &models.LossPreview{Commits: commits}, nil
+}
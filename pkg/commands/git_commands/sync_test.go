@@ -3,8 +3,11 @@ package git_commands
 import (
 	"testing"
 
+	"github.com/go-errors/errors"
 	"github.com/jesseduffield/gocui"
+	"github.com/jesseduffield/lazygit/pkg/commands/models"
 	"github.com/jesseduffield/lazygit/pkg/commands/oscommands"
+	"github.com/jesseduffield/lazygit/pkg/config"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -81,6 +84,63 @@ func TestSyncPush(t *testing.T) {
 				assert.NoError(t, err)
 			},
 		},
+		{
+			testName: "Push with force-with-lease expecting a commit",
+			opts: PushOpts{
+				ForceWithLease:             true,
+				ForceWithLeaseExpectedHash: "abc123",
+				CurrentBranch:              "feature",
+				UpstreamRemote:             "origin",
+				UpstreamBranch:             "feature",
+			},
+			test: func(cmdObj oscommands.ICmdObj, err error) {
+				assert.Equal(t, cmdObj.Args(), []string{"git", "push", "--force-with-lease=refs/heads/feature:abc123", "origin", "refs/heads/feature:feature"})
+				assert.NoError(t, err)
+			},
+		},
+		{
+			testName: "Push with push options",
+			opts: PushOpts{
+				PushOptions: []string{"merge_request.create", "merge_request.target=main"},
+			},
+			test: func(cmdObj oscommands.ICmdObj, err error) {
+				assert.Equal(t, cmdObj.Args(), []string{"git", "push", "--push-option=merge_request.create", "--push-option=merge_request.target=main"})
+				assert.NoError(t, err)
+			},
+		},
+		{
+			testName: "Push refspecs atomically",
+			opts: PushOpts{
+				UpstreamRemote: "origin",
+				Atomic:         true,
+				Refspecs:       []string{"refs/heads/one:refs/heads/one", "refs/heads/two:refs/heads/other"},
+			},
+			test: func(cmdObj oscommands.ICmdObj, err error) {
+				assert.Equal(t, cmdObj.Args(), []string{"git", "push", "--atomic", "origin", "refs/heads/one:refs/heads/one", "refs/heads/two:refs/heads/other"})
+				assert.NoError(t, err)
+			},
+		},
+		{
+			testName: "Push refspecs but no origin",
+			opts: PushOpts{
+				Refspecs: []string{"abc123:refs/heads/feature"},
+			},
+			test: func(cmdObj oscommands.ICmdObj, err error) {
+				assert.Error(t, err)
+				assert.EqualValues(t, "Must specify a remote if specifying a branch", err.Error())
+			},
+		},
+		{
+			testName: "Push with force-with-lease expecting a commit but no upstream branch",
+			opts: PushOpts{
+				ForceWithLease:             true,
+				ForceWithLeaseExpectedHash: "abc123",
+			},
+			test: func(cmdObj oscommands.ICmdObj, err error) {
+				assert.Error(t, err)
+				assert.EqualValues(t, "Must specify a remote branch if specifying the commit it is expected to be at", err.Error())
+			},
+		},
 		{
 			testName: "Push with remote branch but no origin",
 			opts: PushOpts{
@@ -179,3 +239,287 @@ func TestSyncFetchBackground(t *testing.T) {
 		})
 	}
 }
+
+func TestSyncFetchRemote(t *testing.T) {
+	type scenario struct {
+		testName     string
+		remoteConfig map[string]config.RemoteFetchConfig
+		expectedArgs []string
+	}
+
+	scenarios := []scenario{
+		{
+			testName:     "No config for the remote",
+			remoteConfig: map[string]config.RemoteFetchConfig{"upstream": {Prune: true}},
+			expectedArgs: []string{"fetch", "origin"},
+		},
+		{
+			testName: "Prune and prune tags",
+			remoteConfig: map[string]config.RemoteFetchConfig{
+				"origin": {Prune: true, PruneTags: true},
+			},
+			expectedArgs: []string{"fetch", "--prune", "--prune-tags", "origin"},
+		},
+		{
+			testName: "Prune tags without prune",
+			remoteConfig: map[string]config.RemoteFetchConfig{
+				"origin": {PruneTags: true},
+			},
+			expectedArgs: []string{"fetch", "origin"},
+		},
+		{
+			testName: "No tags, depth and refspecs",
+			remoteConfig: map[string]config.RemoteFetchConfig{
+				"origin": {Tags: "none", Depth: 10, Refspecs: []string{"main", "refs/heads/release/*:refs/remotes/origin/release/*"}},
+			},
+			expectedArgs: []string{"fetch", "--no-tags", "--depth=10", "origin", "main", "refs/heads/release/*:refs/remotes/origin/release/*"},
+		},
+		{
+			testName: "All tags",
+			remoteConfig: map[string]config.RemoteFetchConfig{
+				"origin": {Tags: "all"},
+			},
+			expectedArgs: []string{"fetch", "--tags", "origin"},
+		},
+	}
+
+	for _, s := range scenarios {
+		t.Run(s.testName, func(t *testing.T) {
+			runner := oscommands.NewFakeRunner(t).ExpectGitArgs(s.expectedArgs, "", nil)
+			instance := buildSyncCommands(commonDeps{runner: runner})
+			instance.UserConfig().Git.RemoteFetch = s.remoteConfig
+
+			assert.NoError(t, instance.FetchRemote(gocui.NewFakeTask(), "origin"))
+			runner.CheckForMissingCalls()
+		})
+	}
+}
+
+func TestSyncDeepen(t *testing.T) {
+	type scenario struct {
+		testName     string
+		gitVersion   *GitVersion
+		run          func(*SyncCommands) error
+		expectedArgs []string
+	}
+
+	scenarios := []scenario{
+		{
+			testName:     "Deepen by a number of commits",
+			gitVersion:   &GitVersion{2, 0, 0, ""},
+			run:          func(instance *SyncCommands) error { return instance.Deepen(gocui.NewFakeTask(), 50) },
+			expectedArgs: []string{"fetch", "--deepen=50"},
+		},
+		{
+			testName:     "Deepen since a date",
+			gitVersion:   &GitVersion{2, 0, 0, ""},
+			run:          func(instance *SyncCommands) error { return instance.DeepenSince(gocui.NewFakeTask(), "3 weeks ago") },
+			expectedArgs: []string{"fetch", "--shallow-since=3 weeks ago"},
+		},
+		{
+			testName:     "Unshallow",
+			gitVersion:   &GitVersion{2, 0, 0, ""},
+			run:          func(instance *SyncCommands) error { return instance.Unshallow(gocui.NewFakeTask()) },
+			expectedArgs: []string{"fetch", "--unshallow"},
+		},
+		{
+			testName:     "Unshallow with a git version that supports --no-write-fetch-head",
+			gitVersion:   &GitVersion{2, 29, 0, ""},
+			run:          func(instance *SyncCommands) error { return instance.Unshallow(gocui.NewFakeTask()) },
+			expectedArgs: []string{"fetch", "--no-write-fetch-head", "--unshallow"},
+		},
+	}
+
+	for _, s := range scenarios {
+		t.Run(s.testName, func(t *testing.T) {
+			runner := oscommands.NewFakeRunner(t).ExpectGitArgs(s.expectedArgs, "", nil)
+			instance := buildSyncCommands(commonDeps{runner: runner, gitVersion: s.gitVersion})
+
+			assert.NoError(t, s.run(instance))
+			runner.CheckForMissingCalls()
+		})
+	}
+}
+
+func TestSyncClone(t *testing.T) {
+	type scenario struct {
+		testName     string
+		opts         CloneOpts
+		expectedArgs []string
+	}
+
+	scenarios := []scenario{
+		{
+			testName:     "Plain clone",
+			opts:         CloneOpts{URL: "https://github.com/jesseduffield/lazygit.git", Directory: "/code/lazygit"},
+			expectedArgs: []string{"clone", "--progress", "--", "https://github.com/jesseduffield/lazygit.git", "/code/lazygit"},
+		},
+		{
+			testName: "Clone with depth, branch and filter",
+			opts: CloneOpts{
+				URL:       "git@github.com:jesseduffield/lazygit.git",
+				Directory: "/code/lazygit",
+				Depth:     1,
+				Branch:    "release",
+				Filter:    "blob:none",
+			},
+			expectedArgs: []string{"clone", "--progress", "--depth=1", "--branch", "release", "--filter=blob:none", "--", "git@github.com:jesseduffield/lazygit.git", "/code/lazygit"},
+		},
+	}
+
+	for _, s := range scenarios {
+		t.Run(s.testName, func(t *testing.T) {
+			runner := oscommands.NewFakeRunner(t).ExpectGitArgs(s.expectedArgs, "", nil)
+			instance := buildSyncCommands(commonDeps{runner: runner})
+
+			assert.NoError(t, instance.Clone(gocui.NewFakeTask(), s.opts))
+			runner.CheckForMissingCalls()
+		})
+	}
+}
+
+func TestRepoNameFromURL(t *testing.T) {
+	scenarios := []struct {
+		url      string
+		expected string
+	}{
+		{url: "https://github.com/jesseduffield/lazygit.git", expected: "lazygit"},
+		{url: "https://github.com/jesseduffield/lazygit", expected: "lazygit"},
+		{url: "https://github.com/jesseduffield/lazygit/", expected: "lazygit"},
+		{url: "git@github.com:jesseduffield/lazygit.git", expected: "lazygit"},
+		{url: "git@github.com:lazygit.git", expected: "lazygit"},
+		{url: "/srv/repos/lazygit/.git", expected: "lazygit"},
+		{url: "../origin", expected: "origin"},
+	}
+
+	for _, s := range scenarios {
+		t.Run(s.url, func(t *testing.T) {
+			assert.Equal(t, s.expected, RepoNameFromURL(s.url))
+		})
+	}
+}
+
+func TestSyncFetchRemoteWithReport(t *testing.T) {
+	type scenario struct {
+		testName     string
+		gitVersion   *GitVersion
+		expectedArgs []string
+		output       string
+		expected     []*FetchedRef
+	}
+
+	scenarios := []scenario{
+		{
+			testName:     "Git version without porcelain output",
+			gitVersion:   &GitVersion{2, 40, 0, ""},
+			expectedArgs: []string{"fetch", "--no-write-fetch-head", "--prune", "origin"},
+			output:       "",
+			expected:     nil,
+		},
+		{
+			testName:     "Porcelain output",
+			gitVersion:   &GitVersion{2, 41, 0, ""},
+			expectedArgs: []string{"fetch", "--no-write-fetch-head", "--porcelain", "--prune", "origin"},
+			output: "* 0000000000000000000000000000000000000000 1111111111111111111111111111111111111111 refs/remotes/origin/feature\n" +
+				"  2222222222222222222222222222222222222222 3333333333333333333333333333333333333333 refs/remotes/origin/main\n" +
+				"+ 4444444444444444444444444444444444444444 5555555555555555555555555555555555555555 refs/remotes/origin/rewritten\n" +
+				"- 6666666666666666666666666666666666666666 0000000000000000000000000000000000000000 refs/remotes/origin/gone\n" +
+				"= 7777777777777777777777777777777777777777 7777777777777777777777777777777777777777 refs/remotes/origin/unchanged\n" +
+				"t 8888888888888888888888888888888888888888 9999999999999999999999999999999999999999 refs/tags/v1.0\n",
+			expected: []*FetchedRef{
+				{Status: FetchedRefCreated, OldHash: "0000000000000000000000000000000000000000", NewHash: "1111111111111111111111111111111111111111", Ref: "refs/remotes/origin/feature"},
+				{Status: FetchedRefUpdated, OldHash: "2222222222222222222222222222222222222222", NewHash: "3333333333333333333333333333333333333333", Ref: "refs/remotes/origin/main"},
+				{Status: FetchedRefForcedUpdate, OldHash: "4444444444444444444444444444444444444444", NewHash: "5555555555555555555555555555555555555555", Ref: "refs/remotes/origin/rewritten"},
+				{Status: FetchedRefPruned, OldHash: "6666666666666666666666666666666666666666", NewHash: "0000000000000000000000000000000000000000", Ref: "refs/remotes/origin/gone"},
+				{Status: FetchedRefUpdated, OldHash: "8888888888888888888888888888888888888888", NewHash: "9999999999999999999999999999999999999999", Ref: "refs/tags/v1.0"},
+			},
+		},
+	}
+
+	for _, s := range scenarios {
+		t.Run(s.testName, func(t *testing.T) {
+			runner := oscommands.NewFakeRunner(t).ExpectGitArgs(s.expectedArgs, s.output, nil)
+			instance := buildSyncCommands(commonDeps{runner: runner, gitVersion: s.gitVersion})
+
+			fetchedRefs, err := instance.FetchRemoteWithReport(gocui.NewFakeTask(), "origin", FetchOpts{Prune: true})
+			assert.NoError(t, err)
+			assert.Equal(t, s.expected, fetchedRefs)
+			runner.CheckForMissingCalls()
+		})
+	}
+}
+
+func TestSyncForcePushPreview(t *testing.T) {
+	runner := oscommands.NewFakeRunner(t).
+		ExpectGitArgs([]string{"log", "--format=%H%x00%s", "refs/remotes/origin/main", "--not", "refs/heads/main", "--"},
+			"aaa\x00their commit\n", nil)
+
+	instance := buildSyncCommands(commonDeps{runner: runner})
+	preview, err := instance.ForcePushPreview("refs/heads/main", "refs/remotes/origin/main")
+	assert.NoError(t, err)
+	assert.Equal(t, &models.LossPreview{Commits: []*models.Commit{{Hash: "aaa", Name: "their commit"}}}, preview)
+	runner.CheckForMissingCalls()
+}
+
+func TestSyncFetchRemoteBackground(t *testing.T) {
+	instance := buildSyncCommands(commonDeps{})
+	instance.UserConfig().Git.RemoteFetch = map[string]config.RemoteFetchConfig{
+		"origin": {Prune: true},
+	}
+
+	cmdObj := instance.FetchRemoteBackgroundCmdObj("origin")
+	assert.False(t, cmdObj.ShouldLog())
+	assert.Equal(t, cmdObj.GetCredentialStrategy(), oscommands.FAIL)
+	assert.Equal(t, cmdObj.Args(), []string{"git", "fetch", "--prune", "origin"})
+}
+
+func TestSyncRemoteBranchHashes(t *testing.T) {
+	runner := oscommands.NewFakeRunner(t).
+		ExpectGitArgs([]string{"for-each-ref", "--format=%(refname)%00%(objectname)", "refs/remotes/origin"},
+			"refs/remotes/origin/HEAD\x00aaa\nrefs/remotes/origin/main\x00aaa\nrefs/remotes/origin/feature\x00bbb\n", nil)
+
+	instance := buildSyncCommands(commonDeps{runner: runner})
+	hashes, err := instance.RemoteBranchHashes("origin")
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]string{
+		"refs/remotes/origin/HEAD":    "aaa",
+		"refs/remotes/origin/main":    "aaa",
+		"refs/remotes/origin/feature": "bbb",
+	}, hashes)
+	runner.CheckForMissingCalls()
+}
+
+func TestSyncRemoteBranchUpdates(t *testing.T) {
+	runner := oscommands.NewFakeRunner(t).
+		ExpectGitArgs([]string{"merge-base", "--is-ancestor", "aaa", "ccc"}, "", nil).
+		ExpectGitArgs([]string{"rev-list", "--count", "aaa..ccc"}, "2\n", nil).
+		ExpectGitArgs([]string{"merge-base", "--is-ancestor", "bbb", "ddd"}, "", errors.New("not an ancestor")).
+		ExpectGitArgs([]string{"rev-list", "--count", "bbb..ddd"}, "1\n", nil)
+
+	instance := buildSyncCommands(commonDeps{runner: runner})
+	before := map[string]string{
+		"refs/remotes/origin/main":      "aaa",
+		"refs/remotes/origin/feature":   "bbb",
+		"refs/remotes/origin/unchanged": "eee",
+		"refs/remotes/origin/deleted":   "fff",
+	}
+	after := map[string]string{
+		"refs/remotes/origin/main":      "ccc",
+		"refs/remotes/origin/feature":   "ddd",
+		"refs/remotes/origin/unchanged": "eee",
+		"refs/remotes/origin/new":       "ggg",
+	}
+	updates, err := instance.RemoteBranchUpdates(before, after, []string{
+		"refs/remotes/origin/main",
+		"refs/remotes/origin/feature",
+		"refs/remotes/origin/unchanged",
+		"refs/remotes/origin/deleted",
+		"refs/remotes/origin/new",
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, []*RemoteBranchUpdate{
+		{RefName: "refs/remotes/origin/main", Forced: false, NewCommits: 2},
+		{RefName: "refs/remotes/origin/feature", Forced: true, NewCommits: 1},
+	}, updates)
+	runner.CheckForMissingCalls()
+}
@@ -1,6 +1,9 @@
 package git_commands
 
-import "github.com/jesseduffield/gocui"
+import (
+	"github.com/jesseduffield/gocui"
+	"github.com/jesseduffield/lazygit/pkg/commands/oscommands"
+)
 
 type TagCommands struct {
 	*GitCommon
@@ -23,13 +26,21 @@ func (self *TagCommands) CreateLightweight(tagName string, ref string, force boo
 }
 
 func (self *TagCommands) CreateAnnotated(tagName, ref, msg string, force bool) error {
+	return self.CreateAnnotatedObj(tagName, ref, msg, force, false).Run()
+}
+
+// CreateAnnotatedObj returns the command for creating an annotated tag. If
+// sign is true the tag is signed with the user's signing key; otherwise it is
+// only signed if tag.gpgsign is set.
+func (self *TagCommands) CreateAnnotatedObj(tagName, ref, msg string, force bool, sign bool) oscommands.ICmdObj {
 	cmdArgs := NewGitCmd("tag").Arg(tagName).
 		ArgIf(force, "--force").
+		ArgIf(sign, "--sign").
 		ArgIf(len(ref) > 0, ref).
 		Arg("-m", msg).
 		ToArgv()
 
-	return self.cmd.New(cmdArgs).Run()
+	return self.cmd.New(cmdArgs)
 }
 
 func (self *TagCommands) HasTag(tagName string) bool {
@@ -54,3 +65,17 @@ func (self *TagCommands) Push(task gocui.Task, remoteName string, tagName string
 
 	return self.cmd.New(cmdArgs).PromptOnCredentialRequest(task).Run()
 }
+
+// ShowCmdObj shows the annotation of the given tag followed by the commit it
+// points to
+func (self *TagCommands) ShowCmdObj(tagName string) oscommands.ICmdObj {
+	cmdArgs := NewGitCmd("show").
+		Arg("--color=" + self.UserConfig().Git.Paging.ColorArg).
+		Arg("--stat").
+		Arg("--decorate").
+		Arg("refs/tags/" + tagName).
+		Arg("--").
+		ToArgv()
+
+	return self.cmd.New(cmdArgs).DontLog()
+}
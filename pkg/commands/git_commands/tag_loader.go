@@ -1,7 +1,10 @@
 package git_commands
 
 import (
-	"regexp"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
 
 	"github.com/jesseduffield/lazygit/pkg/commands/models"
 	"github.com/jesseduffield/lazygit/pkg/commands/oscommands"
@@ -13,6 +16,11 @@ import (
 type TagLoader struct {
 	*common.Common
 	cmd oscommands.ICmdObjBuilder
+
+	signatureCacheMutex sync.Mutex
+	// the signature status of each signed tag we verified, by the hash of the
+	// tag object, which the signature is part of
+	signatureCache map[string]models.SignatureStatus
 }
 
 func NewTagLoader(
@@ -20,15 +28,37 @@ func NewTagLoader(
 	cmd oscommands.ICmdObjBuilder,
 ) *TagLoader {
 	return &TagLoader{
-		Common: common,
-		cmd:    cmd,
+		Common:         common,
+		cmd:            cmd,
+		signatureCache: map[string]models.SignatureStatus{},
 	}
 }
 
 func (self *TagLoader) GetTags() ([]*models.Tag, error) {
-	// get remote branches, sorted  by creation date (descending)
-	// see: https://git-scm.com/docs/git-tag#Documentation/git-tag.txt---sortltkeygt
-	cmdArgs := NewGitCmd("tag").Arg("--list", "-n", "--sort=-creatordate").ToArgv()
+	var sortOrder string
+	switch strings.ToLower(self.AppState.TagSortOrder) {
+	case "alphabetical":
+		sortOrder = "refname"
+	case "version":
+		sortOrder = "-version:refname"
+	default:
+		// sorted by creation date (descending)
+		// see: https://git-scm.com/docs/git-tag#Documentation/git-tag.txt---sortltkeygt
+		sortOrder = "-creatordate"
+	}
+
+	format := strings.Join(
+		lo.Map(tagFields, func(field string, _ int) string {
+			return "%(" + field + ")"
+		}),
+		"%00",
+	) + "%00%(if)%(contents:signature)%(then)signed%(end)"
+
+	cmdArgs := NewGitCmd("for-each-ref").
+		Arg(fmt.Sprintf("--sort=%s", sortOrder)).
+		Arg(fmt.Sprintf("--format=%s", format)).
+		Arg("refs/tags").
+		ToArgv()
 	tagsOutput, err := self.cmd.New(cmdArgs).DontLog().RunWithOutput()
 	if err != nil {
 		return nil, err
@@ -36,21 +66,147 @@ func (self *TagLoader) GetTags() ([]*models.Tag, error) {
 
 	split := utils.SplitLines(tagsOutput)
 
-	lineRegex := regexp.MustCompile(`^([^\s]+)(\s+)?(.*)$`)
-
-	tags := lo.Map(split, func(line string, _ int) *models.Tag {
-		matches := lineRegex.FindStringSubmatch(line)
-		tagName := matches[1]
-		message := ""
-		if len(matches) > 3 {
-			message = matches[3]
+	tags := lo.FilterMap(split, func(line string, _ int) (*models.Tag, bool) {
+		fields := strings.Split(line, "\x00")
+		if len(fields) != len(tagFields)+1 {
+			// Ignore anything that isn't a tag line, e.g. warnings
+			return nil, false
 		}
 
-		return &models.Tag{
-			Name:    tagName,
-			Message: message,
-		}
+		return obtainTag(fields), true
 	})
 
+	if self.UserConfig().Git.Log.ShowSignatureStatus {
+		self.loadSignatureStatuses(tags)
+	}
+
 	return tags, nil
 }
+
+var tagFields = []string{
+	// not refname:short, which would give us "tags/foo" if there's also a
+	// branch called "foo"
+	"refname:strip=2",
+	"objecttype",
+	"objectname",
+	"*objectname",
+	"contents:subject",
+	"taggername",
+	"taggeremail",
+	"creatordate:unix",
+}
+
+// Obtain tag information from parsed line output of GetTags()
+func obtainTag(fields []string) *models.Tag {
+	name := fields[0]
+	objectType := fields[1]
+	objectName := fields[2]
+	derefObjectName := fields[3]
+	subject := fields[4]
+	taggerName := fields[5]
+	taggerEmail := strings.Trim(fields[6], "<>")
+	timestamp, _ := strconv.ParseInt(fields[7], 10, 64)
+	isSigned := fields[8] != ""
+
+	isAnnotated := objectType == "tag"
+	commitHash := lo.Ternary(isAnnotated, derefObjectName, objectName)
+
+	return &models.Tag{
+		Name:          name,
+		Message:       subject,
+		IsAnnotated:   isAnnotated,
+		TaggerName:    taggerName,
+		TaggerEmail:   taggerEmail,
+		UnixTimestamp: timestamp,
+		IsSigned:      isAnnotated && isSigned,
+		TagHash:       lo.Ternary(isAnnotated, objectName, ""),
+		CommitHash:    commitHash,
+	}
+}
+
+// How many tags we verify at the same time
+const maxConcurrentTagVerifications = 8
+
+// Verifying a signature means spawning gpg (or ssh-keygen) once per tag, so
+// we do a few of them concurrently, and only once per tag object
+func (self *TagLoader) loadSignatureStatuses(tags []*models.Tag) {
+	semaphore := make(chan struct{}, maxConcurrentTagVerifications)
+	wg := sync.WaitGroup{}
+	for _, tag := range tags {
+		if !tag.IsSigned {
+			continue
+		}
+
+		if status, ok := self.cachedSignatureStatus(tag.TagHash); ok {
+			tag.SignatureStatus = status
+			continue
+		}
+
+		wg.Add(1)
+		go utils.Safe(func() {
+			defer wg.Done()
+			semaphore <- struct{}{}
+			defer func() { <-semaphore }()
+
+			tag.SignatureStatus = self.verifyTag(tag.Name)
+			self.cacheSignatureStatus(tag.TagHash, tag.SignatureStatus)
+		})
+	}
+	wg.Wait()
+}
+
+func (self *TagLoader) cachedSignatureStatus(tagHash string) (models.SignatureStatus, bool) {
+	self.signatureCacheMutex.Lock()
+	defer self.signatureCacheMutex.Unlock()
+
+	status, ok := self.signatureCache[tagHash]
+	return status, ok
+}
+
+func (self *TagLoader) cacheSignatureStatus(tagHash string, status models.SignatureStatus) {
+	// we might be able to check it later, e.g. once the user has imported
+	// the signer's key
+	if status == models.SignatureCannotCheck {
+		return
+	}
+
+	self.signatureCacheMutex.Lock()
+	defer self.signatureCacheMutex.Unlock()
+
+	self.signatureCache[tagHash] = status
+}
+
+func (self *TagLoader) verifyTag(tagName string) models.SignatureStatus {
+	cmdArgs := NewGitCmd("verify-tag").Arg("--raw", "--", tagName).ToArgv()
+	stdout, stderr, err := self.cmd.New(cmdArgs).DontLog().RunWithOutputs()
+
+	return signatureStatusFromVerifyOutput(stdout+stderr, err == nil)
+}
+
+// With --raw, gpg's machine-readable status lines are passed through, which
+// lets us tell the different kinds of failure apart. For ssh signatures there
+// are no status lines so we can only go by the exit code.
+func signatureStatusFromVerifyOutput(output string, succeeded bool) models.SignatureStatus {
+	hasStatus := func(status string) bool {
+		return strings.Contains(output, "[GNUPG:] "+status)
+	}
+
+	switch {
+	case hasStatus("BADSIG"):
+		return models.SignatureBad
+	case hasStatus("EXPKEYSIG"):
+		return models.SignatureExpiredKey
+	case hasStatus("EXPSIG"):
+		return models.SignatureExpired
+	case hasStatus("REVKEYSIG"):
+		return models.SignatureRevokedKey
+	case hasStatus("ERRSIG"):
+		return models.SignatureCannotCheck
+	case hasStatus("GOODSIG") && (hasStatus("TRUST_UNDEFINED") || hasStatus("TRUST_NEVER")):
+		return models.SignatureGoodUnknownValidity
+	case succeeded:
+		return models.SignatureGood
+	default:
+		return models.SignatureCannotCheck
+	}
+}
@@ -1,54 +1,117 @@
 package git_commands
 
 import (
+	"strings"
 	"testing"
 
+	"github.com/go-errors/errors"
 	"github.com/jesseduffield/lazygit/pkg/commands/models"
 	"github.com/jesseduffield/lazygit/pkg/commands/oscommands"
+	"github.com/jesseduffield/lazygit/pkg/config"
 	"github.com/jesseduffield/lazygit/pkg/utils"
 	"github.com/stretchr/testify/assert"
 )
 
-const tagsOutput = `tag1 this is my message
-tag2
-tag3 this is my other message
-`
+var tagsOutput = strings.Replace(`tag1|tag|1111111111111111111111111111111111111111|aaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa|this is my message|Jesse Duffield|<jessedduffield@gmail.com>|1640826609|
+tag2|commit|bbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbb||some commit||||
+tag3|tag|3333333333333333333333333333333333333333|cccccccccccccccccccccccccccccccccccccccc|this is my other message|Jesse Duffield|<jessedduffield@gmail.com>|1640823749|signed
+`, "|", "\x00", -1)
+
+const tagsFormat = "--format=%(refname:strip=2)%00%(objecttype)%00%(objectname)%00%(*objectname)%00%(contents:subject)%00%(taggername)%00%(taggeremail)%00%(creatordate:unix)%00%(if)%(contents:signature)%(then)signed%(end)"
 
 func TestGetTags(t *testing.T) {
 	type scenario struct {
-		testName      string
-		runner        *oscommands.FakeCmdObjRunner
-		expectedTags  []*models.Tag
-		expectedError error
+		testName       string
+		sortOrder      string
+		showSignatures bool
+		runner         *oscommands.FakeCmdObjRunner
+		expectedTags   []*models.Tag
+		expectedError  error
+	}
+
+	expectedTags := func(signatureStatus models.SignatureStatus) []*models.Tag {
+		return []*models.Tag{
+			{
+				Name:          "tag1",
+				Message:       "this is my message",
+				IsAnnotated:   true,
+				TaggerName:    "Jesse Duffield",
+				TaggerEmail:   "jessedduffield@gmail.com",
+				UnixTimestamp: 1640826609,
+				TagHash:       "1111111111111111111111111111111111111111",
+				CommitHash:    "aaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa",
+			},
+			{
+				Name:       "tag2",
+				Message:    "some commit",
+				CommitHash: "bbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbb",
+			},
+			{
+				Name:            "tag3",
+				Message:         "this is my other message",
+				IsAnnotated:     true,
+				TaggerName:      "Jesse Duffield",
+				TaggerEmail:     "jessedduffield@gmail.com",
+				UnixTimestamp:   1640823749,
+				IsSigned:        true,
+				TagHash:         "3333333333333333333333333333333333333333",
+				SignatureStatus: signatureStatus,
+				CommitHash:      "cccccccccccccccccccccccccccccccccccccccc",
+			},
+		}
 	}
 
 	scenarios := []scenario{
 		{
-			testName: "should return no tags if there are none",
+			testName:  "should return no tags if there are none",
+			sortOrder: "date",
 			runner: oscommands.NewFakeRunner(t).
-				ExpectGitArgs([]string{"tag", "--list", "-n", "--sort=-creatordate"}, "", nil),
+				ExpectGitArgs([]string{"for-each-ref", "--sort=-creatordate", tagsFormat, "refs/tags"}, "", nil),
 			expectedTags:  []*models.Tag{},
 			expectedError: nil,
 		},
 		{
-			testName: "should return tags if present",
+			testName:  "should return tags if present",
+			sortOrder: "date",
 			runner: oscommands.NewFakeRunner(t).
-				ExpectGitArgs([]string{"tag", "--list", "-n", "--sort=-creatordate"}, tagsOutput, nil),
-			expectedTags: []*models.Tag{
-				{Name: "tag1", Message: "this is my message"},
-				{Name: "tag2", Message: ""},
-				{Name: "tag3", Message: "this is my other message"},
-			},
+				ExpectGitArgs([]string{"for-each-ref", "--sort=-creatordate", tagsFormat, "refs/tags"}, tagsOutput, nil),
+			expectedTags:  expectedTags(models.SignatureNone),
+			expectedError: nil,
+		},
+		{
+			testName:  "should sort by version",
+			sortOrder: "version",
+			runner: oscommands.NewFakeRunner(t).
+				ExpectGitArgs([]string{"for-each-ref", "--sort=-version:refname", tagsFormat, "refs/tags"}, "", nil),
+			expectedTags:  []*models.Tag{},
+			expectedError: nil,
+		},
+		{
+			testName:  "should sort alphabetically",
+			sortOrder: "alphabetical",
+			runner: oscommands.NewFakeRunner(t).
+				ExpectGitArgs([]string{"for-each-ref", "--sort=refname", tagsFormat, "refs/tags"}, "", nil),
+			expectedTags:  []*models.Tag{},
+			expectedError: nil,
+		},
+		{
+			testName:       "should verify signed tags if enabled",
+			sortOrder:      "date",
+			showSignatures: true,
+			runner: oscommands.NewFakeRunner(t).
+				ExpectGitArgs([]string{"for-each-ref", "--sort=-creatordate", tagsFormat, "refs/tags"}, tagsOutput, nil).
+				ExpectGitArgs([]string{"verify-tag", "--raw", "--", "tag3"}, "[GNUPG:] BADSIG 1234 Jesse Duffield", errors.New("error")),
+			expectedTags:  expectedTags(models.SignatureBad),
 			expectedError: nil,
 		},
 	}
 
 	for _, scenario := range scenarios {
 		t.Run(scenario.testName, func(t *testing.T) {
-			loader := &TagLoader{
-				Common: utils.NewDummyCommon(),
-				cmd:    oscommands.NewDummyCmdObjBuilder(scenario.runner),
-			}
+			common := utils.NewDummyCommon()
+			common.AppState = &config.AppState{TagSortOrder: scenario.sortOrder}
+			common.UserConfig().Git.Log.ShowSignatureStatus = scenario.showSignatures
+			loader := NewTagLoader(common, oscommands.NewDummyCmdObjBuilder(scenario.runner))
 
 			tags, err := loader.GetTags()
 
@@ -59,3 +122,76 @@ func TestGetTags(t *testing.T) {
 		})
 	}
 }
+
+func TestGetTagsCachesSignatureStatuses(t *testing.T) {
+	common := utils.NewDummyCommon()
+	common.AppState = &config.AppState{}
+	common.UserConfig().Git.Log.ShowSignatureStatus = true
+
+	// tag3 is only verified the first time
+	runner := oscommands.NewFakeRunner(t).
+		ExpectGitArgs([]string{"for-each-ref", "--sort=-creatordate", tagsFormat, "refs/tags"}, tagsOutput, nil).
+		ExpectGitArgs([]string{"verify-tag", "--raw", "--", "tag3"}, "[GNUPG:] GOODSIG 1234 Jesse Duffield", nil).
+		ExpectGitArgs([]string{"for-each-ref", "--sort=-creatordate", tagsFormat, "refs/tags"}, tagsOutput, nil)
+	loader := NewTagLoader(common, oscommands.NewDummyCmdObjBuilder(runner))
+
+	for range 2 {
+		tags, err := loader.GetTags()
+		assert.NoError(t, err)
+		assert.Equal(t, models.SignatureGood, tags[2].SignatureStatus)
+	}
+
+	runner.CheckForMissingCalls()
+}
+
+func TestSignatureStatusFromVerifyOutput(t *testing.T) {
+	scenarios := []struct {
+		testName  string
+		output    string
+		succeeded bool
+		expected  models.SignatureStatus
+	}{
+		{
+			testName:  "good signature",
+			output:    "[GNUPG:] GOODSIG 1234 Jesse Duffield\n[GNUPG:] TRUST_ULTIMATE 0 pgp",
+			succeeded: true,
+			expected:  models.SignatureGood,
+		},
+		{
+			testName:  "good signature with unknown validity",
+			output:    "[GNUPG:] GOODSIG 1234 Jesse Duffield\n[GNUPG:] TRUST_UNDEFINED 0 pgp",
+			succeeded: true,
+			expected:  models.SignatureGoodUnknownValidity,
+		},
+		{
+			testName:  "missing public key",
+			output:    "[GNUPG:] ERRSIG 1234 1 8 00 1640826609 9\n[GNUPG:] NO_PUBKEY 1234",
+			succeeded: false,
+			expected:  models.SignatureCannotCheck,
+		},
+		{
+			testName:  "expired key",
+			output:    "[GNUPG:] EXPKEYSIG 1234 Jesse Duffield",
+			succeeded: false,
+			expected:  models.SignatureExpiredKey,
+		},
+		{
+			testName:  "good ssh signature",
+			output:    `Good "git" signature for jessedduffield@gmail.com with ED25519 key SHA256:abcdef`,
+			succeeded: true,
+			expected:  models.SignatureGood,
+		},
+		{
+			testName:  "failed ssh verification",
+			output:    "error: gpg.ssh.allowedSignersFile needs to be configured and exist for ssh signature verification",
+			succeeded: false,
+			expected:  models.SignatureCannotCheck,
+		},
+	}
+
+	for _, s := range scenarios {
+		t.Run(s.testName, func(t *testing.T) {
+			assert.Equal(t, s.expected, signatureStatusFromVerifyOutput(s.output, s.succeeded))
+		})
+	}
+}
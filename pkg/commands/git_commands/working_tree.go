@@ -4,10 +4,13 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"strings"
 
 	"github.com/go-errors/errors"
 	"github.com/jesseduffield/lazygit/pkg/commands/models"
 	"github.com/jesseduffield/lazygit/pkg/commands/oscommands"
+	"github.com/jesseduffield/lazygit/pkg/utils"
+	"github.com/samber/lo"
 )
 
 type WorkingTreeCommands struct {
@@ -237,6 +240,27 @@ func (self *WorkingTreeCommands) Exclude(filename string) error {
 	return self.os.AppendLineToFile(excludeFile, filename)
 }
 
+// IgnoredPaths returns the gitignored untracked paths in the given directories
+// of the worktree at worktreePath (or in all of it, if none are given),
+// relative to the worktree. Directories that are ignored as a whole have a
+// trailing slash, and their contents aren't listed. Tracked files are never
+// included, even if they match a gitignore pattern.
+func (self *WorkingTreeCommands) IgnoredPaths(worktreePath string, dirs ...string) ([]string, error) {
+	cmdArgs := NewGitCmd("ls-files").
+		Arg("-z", "--others", "--ignored", "--exclude-standard", "--directory").
+		ArgIf(len(dirs) > 0, "--").
+		Arg(dirs...).
+		Dir(worktreePath).
+		ToArgv()
+
+	output, err := self.cmd.New(cmdArgs).DontLog().RunWithOutput()
+	if err != nil {
+		return nil, err
+	}
+
+	return lo.Compact(strings.Split(output, "\x00")), nil
+}
+
 // WorktreeFileDiff returns the diff of a file
 func (self *WorkingTreeCommands) WorktreeFileDiff(file *models.File, plain bool, cached bool) string {
 	// for now we assume an error means the file was deleted
@@ -386,3 +410,57 @@ func (self *WorkingTreeCommands) ResetMixed(ref string) error {
 
 	return self.cmd.New(cmdArgs).Run()
 }
+
+// ResetHardPreview returns what `git reset --hard <ref>` would lose: the
+// uncommitted changes, and the commits that would no longer be reachable
+func (self *WorkingTreeCommands) ResetHardPreview(ref string) (*models.LossPreview, error) {
+	changedFiles, err := loadUncommittedChanges(self.cmd)
+	if err != nil {
+		return nil, err
+	}
+
+	preview := &models.LossPreview{ChangedFiles: changedFiles}
+	if ref == "HEAD" {
+		return preview, nil
+	}
+
+	// the checked-out branch is what's being reset, so it doesn't keep its
+	// commits reachable
+	excludedBranches := []string{}
+	branchName, err := self.cmd.New(
+		NewGitCmd("symbolic-ref").Arg("--quiet", "--short", "HEAD").ToArgv(),
+	).DontLog().RunWithOutput()
+	if err == nil {
+		excludedBranches = append(excludedBranches, strings.TrimSpace(branchName))
+	}
+
+	preview.Commits, err = loadLostCommits(self.cmd, lostCommitsOpts{
+		tips:             []string{"HEAD"},
+		keep:             []string{ref},
+		excludedBranches: excludedBranches,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return preview, nil
+}
+
+// ResetAndCleanPreview returns what ResetAndClean would lose
+func (self *WorkingTreeCommands) ResetAndCleanPreview() (*models.LossPreview, error) {
+	preview, err := self.ResetHardPreview("HEAD")
+	if err != nil {
+		return nil, err
+	}
+
+	output, err := self.cmd.New(NewGitCmd("clean").Arg("-d", "--dry-run").ToArgv()).DontLog().RunWithOutput()
+	if err != nil {
+		return nil, err
+	}
+
+	preview.UntrackedFiles = lo.FilterMap(utils.SplitLines(output), func(line string, _ int) (string, bool) {
+		return strings.CutPrefix(line, "Would remove ")
+	})
+
+	return preview, nil
+}
@@ -538,6 +538,42 @@ func TestWorkingTreeRemoveUntrackedFiles(t *testing.T) {
 	}
 }
 
+func TestWorkingTreeIgnoredPaths(t *testing.T) {
+	scenarios := []struct {
+		testName string
+		dirs     []string
+		runner   *oscommands.FakeCmdObjRunner
+		expected []string
+	}{
+		{
+			testName: "whole worktree",
+			dirs:     nil,
+			runner: oscommands.NewFakeRunner(t).
+				ExpectGitArgs([]string{"-C", "/repo", "ls-files", "-z", "--others", "--ignored", "--exclude-standard", "--directory"},
+					"build/\x00node_modules/\x00debug.log\x00", nil),
+			expected: []string{"build/", "node_modules/", "debug.log"},
+		},
+		{
+			testName: "some directories",
+			dirs:     []string{"src", "docs/new"},
+			runner: oscommands.NewFakeRunner(t).
+				ExpectGitArgs([]string{"-C", "/repo", "ls-files", "-z", "--others", "--ignored", "--exclude-standard", "--directory", "--", "src", "docs/new"},
+					"", nil),
+			expected: []string{},
+		},
+	}
+
+	for _, s := range scenarios {
+		t.Run(s.testName, func(t *testing.T) {
+			instance := buildWorkingTreeCommands(commonDeps{runner: s.runner})
+			paths, err := instance.IgnoredPaths("/repo", s.dirs...)
+			assert.NoError(t, err)
+			assert.Equal(t, s.expected, paths)
+			s.runner.CheckForMissingCalls()
+		})
+	}
+}
+
 func TestWorkingTreeResetHard(t *testing.T) {
 	type scenario struct {
 		testName string
@@ -565,3 +601,73 @@ func TestWorkingTreeResetHard(t *testing.T) {
 		})
 	}
 }
+
+func TestWorkingTreeResetHardPreview(t *testing.T) {
+	scenarios := []struct {
+		testName        string
+		ref             string
+		runner          *oscommands.FakeCmdObjRunner
+		expectedPreview *models.LossPreview
+	}{
+		{
+			testName: "reset to HEAD only loses uncommitted changes",
+			ref:      "HEAD",
+			runner: oscommands.NewFakeRunner(t).
+				ExpectGitArgs([]string{"diff", "HEAD", "--name-status", "--no-renames", "--"}, "M\tfile1\nA\tfile2\n", nil),
+			expectedPreview: &models.LossPreview{ChangedFiles: []string{"M\tfile1", "A\tfile2"}},
+		},
+		{
+			testName: "reset to another ref on a branch",
+			ref:      "origin/main",
+			runner: oscommands.NewFakeRunner(t).
+				ExpectGitArgs([]string{"diff", "HEAD", "--name-status", "--no-renames", "--"}, "", nil).
+				ExpectGitArgs([]string{"symbolic-ref", "--quiet", "--short", "HEAD"}, "main\n", nil).
+				ExpectGitArgs([]string{
+					"log", "--format=%H%x00%s", "HEAD", "--not", "origin/main", "--tags", "--remotes", "--exclude=main", "--branches", "--",
+				}, "aaa\x00second commit\nbbb\x00first commit\n", nil),
+			expectedPreview: &models.LossPreview{
+				ChangedFiles: []string{},
+				Commits: []*models.Commit{
+					{Hash: "aaa", Name: "second commit"},
+					{Hash: "bbb", Name: "first commit"},
+				},
+			},
+		},
+		{
+			testName: "reset to another ref with a detached head",
+			ref:      "abc123",
+			runner: oscommands.NewFakeRunner(t).
+				ExpectGitArgs([]string{"diff", "HEAD", "--name-status", "--no-renames", "--"}, "", nil).
+				ExpectGitArgs([]string{"symbolic-ref", "--quiet", "--short", "HEAD"}, "", errors.New("not a symbolic ref")).
+				ExpectGitArgs([]string{
+					"log", "--format=%H%x00%s", "HEAD", "--not", "abc123", "--tags", "--remotes", "--branches", "--",
+				}, "", nil),
+			expectedPreview: &models.LossPreview{ChangedFiles: []string{}, Commits: []*models.Commit{}},
+		},
+	}
+
+	for _, s := range scenarios {
+		t.Run(s.testName, func(t *testing.T) {
+			instance := buildWorkingTreeCommands(commonDeps{runner: s.runner})
+			preview, err := instance.ResetHardPreview(s.ref)
+			assert.NoError(t, err)
+			assert.Equal(t, s.expectedPreview, preview)
+			s.runner.CheckForMissingCalls()
+		})
+	}
+}
+
+func TestWorkingTreeResetAndCleanPreview(t *testing.T) {
+	runner := oscommands.NewFakeRunner(t).
+		ExpectGitArgs([]string{"diff", "HEAD", "--name-status", "--no-renames", "--"}, "D\tfile1\n", nil).
+		ExpectGitArgs([]string{"clean", "-d", "--dry-run"}, "Would remove dir/\nWould remove file2\n", nil)
+
+	instance := buildWorkingTreeCommands(commonDeps{runner: runner})
+	preview, err := instance.ResetAndCleanPreview()
+	assert.NoError(t, err)
+	assert.Equal(t, &models.LossPreview{
+		ChangedFiles:   []string{"D\tfile1"},
+		UntrackedFiles: []string{"dir/", "file2"},
+	}, preview)
+	runner.CheckForMissingCalls()
+}
@@ -54,6 +54,43 @@ func (self *WorktreeCommands) Detach(worktreePath string) error {
 	return self.cmd.New(cmdArgs).Run()
 }
 
+func (self *WorktreeCommands) Lock(worktreePath string, reason string) error {
+	cmdArgs := NewGitCmd("worktree").Arg("lock").
+		ArgIf(reason != "", "--reason", reason).
+		Arg(worktreePath).
+		ToArgv()
+
+	return self.cmd.New(cmdArgs).Run()
+}
+
+func (self *WorktreeCommands) Unlock(worktreePath string) error {
+	cmdArgs := NewGitCmd("worktree").Arg("unlock", worktreePath).ToArgv()
+
+	return self.cmd.New(cmdArgs).Run()
+}
+
+func (self *WorktreeCommands) Move(worktreePath string, newPath string) error {
+	cmdArgs := NewGitCmd("worktree").Arg("move", worktreePath, newPath).ToArgv()
+
+	return self.cmd.New(cmdArgs).Run()
+}
+
+// Prune removes the administrative files of worktrees whose directory no
+// longer exists
+func (self *WorktreeCommands) Prune() error {
+	cmdArgs := NewGitCmd("worktree").Arg("prune").ToArgv()
+
+	return self.cmd.New(cmdArgs).Run()
+}
+
+// Repair fixes the links between the main worktree and the given linked
+// worktrees, e.g. after they were moved without using `git worktree move`
+func (self *WorktreeCommands) Repair(worktreePaths ...string) error {
+	cmdArgs := NewGitCmd("worktree").Arg("repair").Arg(worktreePaths...).ToArgv()
+
+	return self.cmd.New(cmdArgs).Run()
+}
+
 func WorktreeForBranch(branch *models.Branch, worktrees []*models.Worktree) (*models.Worktree, bool) {
 	for _, worktree := range worktrees {
 		if worktree.Branch == branch.Name {
@@ -3,11 +3,13 @@ package git_commands
 import (
 	iofs "io/fs"
 	"path/filepath"
+	"strconv"
 	"strings"
 	"sync"
 
 	"github.com/go-errors/errors"
 	"github.com/jesseduffield/lazygit/pkg/commands/models"
+	"github.com/jesseduffield/lazygit/pkg/commands/types/enums"
 	"github.com/jesseduffield/lazygit/pkg/utils"
 	"github.com/samber/lo"
 	"github.com/spf13/afero"
@@ -21,7 +23,9 @@ func NewWorktreeLoader(gitCommon *GitCommon) *WorktreeLoader {
 	return &WorktreeLoader{GitCommon: gitCommon}
 }
 
-func (self *WorktreeLoader) GetWorktrees() ([]*models.Worktree, error) {
+// GetWorktrees returns all worktrees of the repo, the current one first. If
+// loadStatus is true, each worktree's Status is populated too.
+func (self *WorktreeLoader) GetWorktrees(loadStatus bool) ([]*models.Worktree, error) {
 	currentRepoPath := self.repoPaths.RepoPath()
 	worktreePath := self.repoPaths.WorktreePath()
 
@@ -70,6 +74,9 @@ func (self *WorktreeLoader) GetWorktrees() ([]*models.Worktree, error) {
 		} else if strings.HasPrefix(splitLine, "branch ") {
 			branch := strings.SplitN(splitLine, " ", 2)[1]
 			current.Branch = strings.TrimPrefix(branch, "refs/heads/")
+		} else if splitLine == "locked" || strings.HasPrefix(splitLine, "locked ") {
+			current.IsLocked = true
+			current.LockReason = strings.TrimPrefix(strings.TrimPrefix(splitLine, "locked"), " ")
 		}
 	}
 
@@ -138,9 +145,99 @@ func (self *WorktreeLoader) GetWorktrees() ([]*models.Worktree, error) {
 		}
 	}
 
+	if loadStatus {
+		self.loadStatuses(worktrees)
+	}
+
 	return worktrees, nil
 }
 
+// Computing the status involves running git status in each worktree, which can
+// take a while for large ones, so we do it in parallel. The current worktree is
+// skipped because the caller already knows its files and branch.
+func (self *WorktreeLoader) loadStatuses(worktrees []*models.Worktree) {
+	wg := sync.WaitGroup{}
+	for _, worktree := range worktrees {
+		if worktree.IsCurrent || worktree.IsPathMissing || worktree.GitDir == "" {
+			continue
+		}
+
+		wg.Add(1)
+		go utils.Safe(func() {
+			defer wg.Done()
+
+			worktree.Status = self.getStatus(worktree)
+		})
+	}
+	wg.Wait()
+}
+
+func (self *WorktreeLoader) getStatus(worktree *models.Worktree) *models.WorktreeStatus {
+	status := &models.WorktreeStatus{
+		WorkingTreeState: self.workingTreeState(worktree),
+	}
+
+	cmdArgs := NewGitCmd("status").
+		Arg("--porcelain", "-z", "--no-renames").
+		Dir(worktree.Path).
+		ToArgv()
+	output, err := self.cmd.New(cmdArgs).DontLog().RunWithOutput()
+	if err != nil {
+		self.Log.Warnf("Could not get status for worktree %s: %v", worktree.Path, err)
+	} else {
+		status.DirtyFileCount = len(lo.Compact(strings.Split(output, "\x00")))
+	}
+
+	if worktree.Branch != "" {
+		status.Ahead, status.Behind, status.HasUpstream = self.getAheadBehind(worktree)
+	}
+
+	return status
+}
+
+// Refs are shared between worktrees, so we don't need to run this in the
+// worktree's directory
+func (self *WorktreeLoader) getAheadBehind(worktree *models.Worktree) (int, int, bool) {
+	// <branch>@{u} only accepts a short branch name
+	cmdArgs := NewGitCmd("rev-list").
+		Arg("--left-right", "--count", worktree.Branch+"@{u}...refs/heads/"+worktree.Branch).
+		ToArgv()
+	output, err := self.cmd.New(cmdArgs).DontLog().RunWithOutput()
+	if err != nil {
+		// most likely the branch has no upstream
+		return 0, 0, false
+	}
+
+	behindStr, aheadStr, found := strings.Cut(strings.TrimSpace(output), "\t")
+	if !found {
+		return 0, 0, false
+	}
+	behind, err := strconv.Atoi(behindStr)
+	if err != nil {
+		return 0, 0, false
+	}
+	ahead, err := strconv.Atoi(aheadStr)
+	if err != nil {
+		return 0, 0, false
+	}
+
+	return ahead, behind, true
+}
+
+func (self *WorktreeLoader) workingTreeState(worktree *models.Worktree) enums.RebaseMode {
+	for _, dir := range []string{"rebase-merge", "rebase-apply"} {
+		if exists, _ := afero.Exists(self.Fs, filepath.Join(worktree.GitDir, dir)); exists {
+			return enums.REBASE_MODE_REBASING
+		}
+	}
+
+	if exists, _ := afero.Exists(self.Fs, filepath.Join(worktree.GitDir, "MERGE_HEAD")); exists {
+		return enums.REBASE_MODE_MERGING
+	}
+
+	return enums.REBASE_MODE_NONE
+}
+
 func (self *WorktreeLoader) pathExists(path string) bool {
 	if _, err := self.Fs.Stat(path); err != nil {
 		if errors.Is(err, iofs.ErrNotExist) {
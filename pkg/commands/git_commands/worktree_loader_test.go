@@ -6,6 +6,7 @@ import (
 	"github.com/go-errors/errors"
 	"github.com/jesseduffield/lazygit/pkg/commands/models"
 	"github.com/jesseduffield/lazygit/pkg/commands/oscommands"
+	"github.com/jesseduffield/lazygit/pkg/commands/types/enums"
 	"github.com/spf13/afero"
 	"github.com/stretchr/testify/assert"
 )
@@ -14,6 +15,7 @@ func TestGetWorktrees(t *testing.T) {
 	type scenario struct {
 		testName          string
 		repoPaths         *RepoPaths
+		loadStatus        bool
 		before            func(runner *oscommands.FakeCmdObjRunner, fs afero.Fs, getRevParseArgs argFn)
 		expectedWorktrees []*models.Worktree
 		expectedErr       string
@@ -100,6 +102,70 @@ branch refs/heads/mybranch-worktree
 			},
 			expectedErr: "",
 		},
+		{
+			testName: "Multiple worktrees with status",
+			repoPaths: &RepoPaths{
+				repoPath:     "/path/to/repo",
+				worktreePath: "/path/to/repo",
+			},
+			loadStatus: true,
+			before: func(runner *oscommands.FakeCmdObjRunner, fs afero.Fs, getRevParseArgs argFn) {
+				runner.ExpectGitArgs([]string{"worktree", "list", "--porcelain"},
+					`worktree /path/to/repo
+HEAD d85cc9d281fa6ae1665c68365fc70e75e82a042d
+branch refs/heads/mybranch
+
+worktree /path/to/repo-worktree
+HEAD 775955775e79b8f5b4c4b56f82fbf657e2d5e4de
+branch refs/heads/mybranch-worktree
+`,
+					nil)
+				gitArgsMainWorktree := append(append([]string{"-C", "/path/to/repo"}, getRevParseArgs()...), "--absolute-git-dir")
+				runner.ExpectGitArgs(gitArgsMainWorktree, "/path/to/repo/.git", nil)
+				gitArgsLinkedWorktree := append(append([]string{"-C", "/path/to/repo-worktree"}, getRevParseArgs()...), "--absolute-git-dir")
+				runner.ExpectGitArgs(gitArgsLinkedWorktree, "/path/to/repo/.git/worktrees/repo-worktree", nil)
+
+				// no status is loaded for the current worktree
+				runner.ExpectGitArgs([]string{"-C", "/path/to/repo-worktree", "status", "--porcelain", "-z", "--no-renames"},
+					" M file1\x00?? file2\x00UU file3\x00", nil)
+				runner.ExpectGitArgs([]string{"rev-list", "--left-right", "--count", "mybranch-worktree@{u}...refs/heads/mybranch-worktree"},
+					"1\t2\n", nil)
+
+				_ = fs.MkdirAll("/path/to/repo/.git", 0o755)
+				_ = fs.MkdirAll("/path/to/repo-worktree", 0o755)
+				_ = fs.MkdirAll("/path/to/repo/.git/worktrees/repo-worktree", 0o755)
+				_ = fs.MkdirAll("/path/to/repo/.git/worktrees/repo-worktree/rebase-merge", 0o755)
+				_ = afero.WriteFile(fs, "/path/to/repo-worktree/.git", []byte("gitdir: /path/to/repo/.git/worktrees/repo-worktree"), 0o755)
+			},
+			expectedWorktrees: []*models.Worktree{
+				{
+					IsMain:        true,
+					IsCurrent:     true,
+					Path:          "/path/to/repo",
+					IsPathMissing: false,
+					GitDir:        "/path/to/repo/.git",
+					Branch:        "mybranch",
+					Name:          "repo",
+				},
+				{
+					IsMain:        false,
+					IsCurrent:     false,
+					Path:          "/path/to/repo-worktree",
+					IsPathMissing: false,
+					GitDir:        "/path/to/repo/.git/worktrees/repo-worktree",
+					Branch:        "mybranch-worktree",
+					Name:          "repo-worktree",
+					Status: &models.WorktreeStatus{
+						DirtyFileCount:   3,
+						HasUpstream:      true,
+						Ahead:            2,
+						Behind:           1,
+						WorkingTreeState: enums.REBASE_MODE_REBASING,
+					},
+				},
+			},
+			expectedErr: "",
+		},
 		{
 			testName: "Worktree missing path",
 			repoPaths: &RepoPaths{
@@ -129,6 +195,48 @@ branch refs/heads/missingbranch
 			},
 			expectedErr: "",
 		},
+		{
+			testName: "Locked worktrees",
+			repoPaths: &RepoPaths{
+				repoPath:     "/path/to/repo",
+				worktreePath: "/path/to/repo",
+			},
+			before: func(runner *oscommands.FakeCmdObjRunner, fs afero.Fs, getRevParseArgs argFn) {
+				runner.ExpectGitArgs([]string{"worktree", "list", "--porcelain"},
+					`worktree /path/to/usb/worktree1
+HEAD 775955775e79b8f5b4c4b56f82fbf657e2d5e4de
+branch refs/heads/branch1
+locked on a usb stick
+prunable gitdir file points to non-existent location
+
+worktree /path/to/usb/worktree2
+HEAD 775955775e79b8f5b4c4b56f82fbf657e2d5e4de
+branch refs/heads/branch2
+locked
+`,
+					nil)
+
+				_ = fs.MkdirAll("/path/to/repo/.git", 0o755)
+			},
+			expectedWorktrees: []*models.Worktree{
+				{
+					Path:          "/path/to/usb/worktree1",
+					IsPathMissing: true,
+					IsLocked:      true,
+					LockReason:    "on a usb stick",
+					Branch:        "branch1",
+					Name:          "worktree1",
+				},
+				{
+					Path:          "/path/to/usb/worktree2",
+					IsPathMissing: true,
+					IsLocked:      true,
+					Branch:        "branch2",
+					Name:          "worktree2",
+				},
+			},
+			expectedErr: "",
+		},
 		{
 			testName: "In linked worktree",
 			repoPaths: &RepoPaths{
@@ -203,7 +311,7 @@ branch refs/heads/mybranch-worktree
 				GitCommon: buildGitCommon(commonDeps{runner: runner, fs: fs, repoPaths: s.repoPaths, gitVersion: version}),
 			}
 
-			worktrees, err := loader.GetWorktrees()
+			worktrees, err := loader.GetWorktrees(s.loadStatus)
 			if s.expectedErr != "" {
 				assert.EqualError(t, errors.New(s.expectedErr), err.Error())
 			} else {
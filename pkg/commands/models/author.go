@@ -11,3 +11,17 @@ type Author struct {
 func (self *Author) Combined() string {
 	return fmt.Sprintf("%s <%s>", self.Name, self.Email)
 }
+
+// Contribution statistics of an author over a set of commits
+type AuthorStats struct {
+	Author
+	Commits      int
+	LinesAdded   int
+	LinesRemoved int
+	// Unix timestamps of the author's oldest and newest commit
+	FirstCommitTimestamp int64
+	LastCommitTimestamp  int64
+	// Top-level directories touched by the author, most touched first. Files
+	// in the root of the repo are counted as "/".
+	Directories []string
+}
@@ -30,6 +30,42 @@ const (
 	ActionConflict = todo.Comment + 1
 )
 
+type SignatureStatus int
+
+// Mirrors the possible values of git's %G? placeholder. SignatureNone is
+// also used when we didn't ask git for the signature status at all.
+const (
+	SignatureNone SignatureStatus = iota
+	SignatureGood
+	SignatureGoodUnknownValidity
+	SignatureExpired
+	SignatureExpiredKey
+	SignatureRevokedKey
+	SignatureCannotCheck
+	SignatureBad
+)
+
+func SignatureStatusFromCode(code string) SignatureStatus {
+	switch code {
+	case "G":
+		return SignatureGood
+	case "U":
+		return SignatureGoodUnknownValidity
+	case "X":
+		return SignatureExpired
+	case "Y":
+		return SignatureExpiredKey
+	case "R":
+		return SignatureRevokedKey
+	case "E":
+		return SignatureCannotCheck
+	case "B":
+		return SignatureBad
+	default:
+		return SignatureNone
+	}
+}
+
 type Divergence int
 
 // For a divergence log (left/right comparison of two refs) this is set to
@@ -54,8 +90,17 @@ type Commit struct {
 	UnixTimestamp int64
 	Divergence    Divergence // set to DivergenceNone unless we are showing the divergence view
 
+	// Only populated when git.log.showSignatureStatus is enabled
+	SignatureStatus SignatureStatus
+	Signer          string // something like 'Jesse Duffield <jessedduffield@gmail.com>'
+	SigningKey      string
+
 	// Hashes of parent commits (will be multiple if it's a merge commit)
 	Parents []string
+
+	// True if this commit is listed in .git/shallow, i.e. the history of a
+	// shallow clone is cut off here. Git reports no parents for such commits.
+	ShallowBoundary bool
 }
 
 func (c *Commit) ShortHash() string {
@@ -103,6 +148,17 @@ func (c *Commit) IsTODO() bool {
 	return c.Action != ActionNone
 }
 
+func (c *Commit) IsSigned() bool {
+	return c.SignatureStatus != SignatureNone
+}
+
 func IsHeadCommit(commits []*Commit, index int) bool {
 	return !commits[index].IsTODO() && (index == 0 || commits[index-1].IsTODO())
 }
+
+// The lines added and removed by a commit, as reported by `git log --numstat`
+type CommitNumstat struct {
+	LinesAdded   int
+	LinesRemoved int
+	Paths        []string
+}
@@ -0,0 +1,19 @@
+package models
+
+// LossPreview describes what an operation that throws things away (e.g. a hard
+// reset) would lose, so that we can show it before doing it
+type LossPreview struct {
+	// Commits that would no longer be reachable from any branch, tag or remote
+	// branch afterwards. For a force push: the commits on the remote that would
+	// be overwritten.
+	Commits []*Commit
+	// Tracked files whose uncommitted changes would be discarded, as
+	// name-status lines (e.g. "M\tfile.txt")
+	ChangedFiles []string
+	// Untracked files and directories that would be deleted
+	UntrackedFiles []string
+}
+
+func (self *LossPreview) IsEmpty() bool {
+	return len(self.Commits) == 0 && len(self.ChangedFiles) == 0 && len(self.UntrackedFiles) == 0
+}
@@ -6,8 +6,32 @@ type SubmoduleConfig struct {
 	Name string
 	Path string
 	Url  string
+	// The branch configured to be tracked by `git submodule update --remote`,
+	// if any
+	Branch string
 
 	ParentModule *SubmoduleConfig // nil if top-level
+
+	// nil if the status couldn't be determined
+	Status *SubmoduleStatus
+}
+
+type SubmoduleStatus struct {
+	// The commit recorded for the submodule in the parent repo's index
+	RecordedHash string
+	// The commit that is checked out in the submodule. Empty if the submodule
+	// is not initialized.
+	CheckedOutHash    string
+	IsInitialized     bool
+	HasMergeConflicts bool
+	// True if the submodule has uncommitted changes or untracked files
+	IsDirty bool
+}
+
+// True if the checked-out commit differs from the recorded one, i.e. the
+// submodule shows up as modified in the parent repo
+func (s *SubmoduleStatus) HasDrift() bool {
+	return s.IsInitialized && !s.HasMergeConflicts && s.CheckedOutHash != s.RecordedHash
 }
 
 func (r *SubmoduleConfig) FullName() string {
@@ -6,6 +6,20 @@ type Tag struct {
 	// this is either the first line of the message of an annotated tag, or the
 	// first line of a commit message for a lightweight tag
 	Message string
+
+	// The following are only set for annotated tags
+	IsAnnotated   bool
+	TaggerName    string
+	TaggerEmail   string
+	UnixTimestamp int64
+	IsSigned      bool
+	// The hash of the tag object itself
+	TagHash string
+	// Only populated for signed tags when git.log.showSignatureStatus is enabled
+	SignatureStatus SignatureStatus
+
+	// The hash of the commit that the tag points to
+	CommitHash string
 }
 
 func (t *Tag) FullRefName() string {
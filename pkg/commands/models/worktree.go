@@ -1,5 +1,7 @@
 package models
 
+import "github.com/jesseduffield/lazygit/pkg/commands/types/enums"
+
 // A git worktree
 type Worktree struct {
 	// if false, this is a linked worktree
@@ -10,6 +12,10 @@ type Worktree struct {
 	Path string
 	// if true, the path is not found
 	IsPathMissing bool
+	// if true, the worktree is protected from being pruned, moved or removed
+	IsLocked bool
+	// optional reason given when locking the worktree
+	LockReason string
 	// path of the git directory for this worktree. The equivalent of the .git directory
 	// in the main worktree. For linked worktrees this would be <repo_path>/.git/worktrees/<name>
 	GitDir string
@@ -22,6 +28,26 @@ type Worktree struct {
 	// based on the path, but uniquified. Not the same name that git uses in the worktrees/ folder (no good reason for this,
 	// I just prefer my naming convention better)
 	Name string
+	// Only populated if the status was requested when loading worktrees, and
+	// the worktree's path exists
+	Status *WorktreeStatus
+}
+
+// Summary of the state of a worktree's working tree and branch
+type WorktreeStatus struct {
+	// Number of files with staged, unstaged or untracked changes
+	DirtyFileCount int
+	// If false, the worktree is not on a branch or its branch has no upstream,
+	// and Ahead/Behind are meaningless
+	HasUpstream bool
+	Ahead       int
+	Behind      int
+	// Either REBASE_MODE_NONE, REBASE_MODE_REBASING or REBASE_MODE_MERGING
+	WorkingTreeState enums.RebaseMode
+}
+
+func (s *WorktreeStatus) IsDirty() bool {
+	return s.DirtyFileCount > 0
 }
 
 func (w *Worktree) RefName() string {
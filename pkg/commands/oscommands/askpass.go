@@ -0,0 +1,186 @@
+package oscommands
+
+import (
+	"encoding/json"
+	"net"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/jesseduffield/gocui"
+	"github.com/jesseduffield/lazygit/pkg/app/daemon"
+	"github.com/jesseduffield/lazygit/pkg/utils"
+	"github.com/sirupsen/logrus"
+)
+
+// askpassServer answers the prompts that the askpass daemon (see
+// pkg/app/daemon) forwards to us while a command is running. It lives for the
+// duration of a single command.
+type askpassServer struct {
+	log      *logrus.Entry
+	dir      string
+	listener net.Listener
+}
+
+// The env vars that make git and ssh use lazygit as their askpass program
+var askpassEnvVarNames = []string{"GIT_ASKPASS", "SSH_ASKPASS", "SSH_ASKPASS_REQUIRE", daemon.DaemonKindEnvKey}
+
+// Returns false if the user has configured an askpass program of their own,
+// either in the environment of the command or in git's config, in which case we
+// leave it alone
+func canUseAskpass(cmdObj ICmdObj) bool {
+	return !askpassConfiguredInEnv(cmdObj.GetEnvVars()) && !askpassConfiguredInGit(cmdObj.GetCmd())
+}
+
+// Returns true if the env vars configure an askpass program (or another
+// daemon)
+func askpassConfiguredInEnv(env []string) bool {
+	return slices.ContainsFunc(env, func(envVar string) bool {
+		name, _, _ := strings.Cut(envVar, "=")
+		return slices.Contains(askpassEnvVarNames, name)
+	})
+}
+
+// Returns true if core.askPass is set for the repo the command runs in. Our
+// GIT_ASKPASS would take precedence over it.
+func askpassConfiguredInGit(cmd *exec.Cmd) bool {
+	args := []string{"config", "--get", "core.askPass"}
+	// git commands that run in a different repo (e.g. a submodule) use -C
+	if len(cmd.Args) > 2 && cmd.Args[1] == "-C" {
+		args = append([]string{"-C", cmd.Args[2]}, args...)
+	}
+
+	configCmd := exec.Command("git", args...)
+	configCmd.Dir = cmd.Dir
+	configCmd.Env = cmd.Env
+	output, err := configCmd.Output()
+	return err == nil && strings.TrimSpace(string(output)) != ""
+}
+
+// ssh asks yes/no questions (e.g. whether to trust a host key) through the
+// askpass program as well
+var yesNoQuestionRegex = regexp.MustCompile(`\(yes/no[^)]*\)\?\s*$`)
+
+func askpassCredentialType(request daemon.AskpassRequest, getCredentialType func(prompt string) CredentialType) CredentialType {
+	switch request.PromptKind {
+	case "none":
+		return Notification
+	case "confirm":
+		return Confirmation
+	}
+
+	if yesNoQuestionRegex.MatchString(request.Prompt) {
+		return Confirmation
+	}
+
+	return getCredentialType(request.Prompt)
+}
+
+func startAskpassServer(
+	log *logrus.Entry,
+	getCredentialType func(prompt string) CredentialType,
+	promptUserForCredential func(CredentialRequest) <-chan string,
+	task gocui.Task,
+) (*askpassServer, error) {
+	dir, err := os.MkdirTemp("", "lazygit-askpass-")
+	if err != nil {
+		return nil, err
+	}
+
+	listener, err := net.Listen("unix", filepath.Join(dir, "socket"))
+	if err != nil {
+		_ = os.RemoveAll(dir)
+		return nil, err
+	}
+
+	server := &askpassServer{log: log, dir: dir, listener: listener}
+
+	go utils.Safe(func() {
+		for {
+			conn, err := listener.Accept()
+			if err != nil {
+				// the listener has been closed
+				return
+			}
+
+			server.handle(conn, getCredentialType, promptUserForCredential, task)
+		}
+	})
+
+	return server, nil
+}
+
+func (self *askpassServer) handle(
+	conn net.Conn,
+	getCredentialType func(prompt string) CredentialType,
+	promptUserForCredential func(CredentialRequest) <-chan string,
+	task gocui.Task,
+) {
+	defer conn.Close()
+
+	var request daemon.AskpassRequest
+	if err := json.NewDecoder(conn).Decode(&request); err != nil {
+		self.log.Error(err)
+		return
+	}
+
+	credentialType := askpassCredentialType(request, getCredentialType)
+	host := ""
+	// only actual credentials are worth caching
+	if credentialType != Confirmation && credentialType != Notification {
+		host = credentialHostFromPrompt(request.Prompt)
+	}
+
+	responseChan := promptUserForCredential(CredentialRequest{
+		Type:   credentialType,
+		Prompt: request.Prompt,
+		Host:   host,
+	})
+	if credentialType == Notification {
+		// the daemon doesn't wait for a response
+		<-responseChan
+		return
+	}
+
+	if task != nil {
+		task.Pause()
+	}
+	answer := <-responseChan
+	if task != nil {
+		task.Continue()
+	}
+
+	response := daemon.AskpassResponse{Answer: strings.TrimSuffix(answer, "\n")}
+	if err := json.NewEncoder(conn).Encode(response); err != nil {
+		self.log.Error(err)
+	}
+}
+
+func (self *askpassServer) envVars() []string {
+	ex, err := os.Executable()
+	if err != nil {
+		ex = os.Args[0]
+	}
+
+	// Unlike GIT_EDITOR, the askpass programs are not run through a shell, so
+	// the path mustn't be quoted.
+	return append(
+		daemon.ToEnvVars(daemon.NewAskpassInstruction(self.listener.Addr().String())),
+		"GIT_ASKPASS="+ex,
+		"SSH_ASKPASS="+ex,
+		// by default ssh only uses the askpass program when it has no terminal
+		"SSH_ASKPASS_REQUIRE=force",
+	)
+}
+
+func (self *askpassServer) close() {
+	if err := self.listener.Close(); err != nil {
+		self.log.Error(err)
+	}
+	if err := os.RemoveAll(self.dir); err != nil {
+		self.log.Error(err)
+	}
+}
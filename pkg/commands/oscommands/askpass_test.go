@@ -0,0 +1,123 @@
+package oscommands
+
+import (
+	"encoding/json"
+	"net"
+	"os/exec"
+	"testing"
+
+	"github.com/jesseduffield/lazygit/pkg/app/daemon"
+	"github.com/jesseduffield/lazygit/pkg/utils"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAskpassConfiguredInEnv(t *testing.T) {
+	assert.False(t, askpassConfiguredInEnv(nil))
+	assert.False(t, askpassConfiguredInEnv([]string{"GIT_OPTIONAL_LOCKS=0"}))
+	assert.True(t, askpassConfiguredInEnv([]string{"GIT_ASKPASS=/usr/bin/ksshaskpass"}))
+	assert.True(t, askpassConfiguredInEnv([]string{"SSH_ASKPASS=/usr/bin/ksshaskpass"}))
+}
+
+func TestAskpassConfiguredInGit(t *testing.T) {
+	dir := t.TempDir()
+	runGit := func(args ...string) {
+		cmd := exec.Command("git", args...)
+		cmd.Dir = dir
+		assert.NoError(t, cmd.Run())
+	}
+	runGit("init", "-q")
+
+	cmd := exec.Command("git", "push")
+	cmd.Dir = dir
+	assert.False(t, askpassConfiguredInGit(cmd))
+
+	runGit("config", "core.askPass", "/usr/bin/ksshaskpass")
+	assert.True(t, askpassConfiguredInGit(cmd))
+	assert.True(t, askpassConfiguredInGit(exec.Command("git", "-C", dir, "push")))
+}
+
+func TestAskpassCredentialType(t *testing.T) {
+	getCredentialType := func(prompt string) CredentialType { return Password }
+
+	scenarios := []struct {
+		request  daemon.AskpassRequest
+		expected CredentialType
+	}{
+		{
+			request:  daemon.AskpassRequest{Prompt: "Password for 'https://github.com': "},
+			expected: Password,
+		},
+		{
+			request:  daemon.AskpassRequest{Prompt: "Are you sure you want to continue connecting (yes/no/[fingerprint])? "},
+			expected: Confirmation,
+		},
+		{
+			request:  daemon.AskpassRequest{Prompt: "Allow use of key /home/bill/.ssh/id_ed25519?", PromptKind: "confirm"},
+			expected: Confirmation,
+		},
+		{
+			request:  daemon.AskpassRequest{Prompt: "Confirm user presence for key ED25519-SK", PromptKind: "none"},
+			expected: Notification,
+		},
+	}
+
+	for _, s := range scenarios {
+		assert.Equal(t, s.expected, askpassCredentialType(s.request, getCredentialType), s.request.Prompt)
+	}
+}
+
+func TestAskpassServer(t *testing.T) {
+	var requests []CredentialRequest
+	promptUserForCredential := func(request CredentialRequest) <-chan string {
+		requests = append(requests, request)
+		ch := make(chan string, 1)
+		ch <- "secret\n"
+		return ch
+	}
+	getCredentialType := func(prompt string) CredentialType { return Password }
+
+	server, err := startAskpassServer(utils.NewDummyLog(), getCredentialType, promptUserForCredential, nil)
+	assert.NoError(t, err)
+	defer server.close()
+
+	conn, err := net.Dial("unix", server.listener.Addr().String())
+	assert.NoError(t, err)
+	defer conn.Close()
+
+	assert.NoError(t, json.NewEncoder(conn).Encode(daemon.AskpassRequest{Prompt: "Password for 'https://github.com':"}))
+
+	var response daemon.AskpassResponse
+	assert.NoError(t, json.NewDecoder(conn).Decode(&response))
+
+	assert.Equal(t, "secret", response.Answer)
+	assert.Equal(t, []CredentialRequest{{Type: Password, Prompt: "Password for 'https://github.com':", Host: "github.com"}}, requests)
+}
+
+func TestAskpassServerConfirmation(t *testing.T) {
+	var requests []CredentialRequest
+	promptUserForCredential := func(request CredentialRequest) <-chan string {
+		requests = append(requests, request)
+		ch := make(chan string, 1)
+		ch <- "yes\n"
+		return ch
+	}
+	getCredentialType := func(prompt string) CredentialType { return Password }
+
+	server, err := startAskpassServer(utils.NewDummyLog(), getCredentialType, promptUserForCredential, nil)
+	assert.NoError(t, err)
+	defer server.close()
+
+	conn, err := net.Dial("unix", server.listener.Addr().String())
+	assert.NoError(t, err)
+	defer conn.Close()
+
+	prompt := "The authenticity of host 'github.com' can't be established.\nAre you sure you want to continue connecting (yes/no/[fingerprint])? "
+	assert.NoError(t, json.NewEncoder(conn).Encode(daemon.AskpassRequest{Prompt: prompt}))
+
+	var response daemon.AskpassResponse
+	assert.NoError(t, json.NewDecoder(conn).Decode(&response))
+
+	assert.Equal(t, "yes", response.Answer)
+	// not cached, so no host
+	assert.Equal(t, []CredentialRequest{{Type: Confirmation, Prompt: prompt}}, requests)
+}
@@ -1,6 +1,7 @@
 package oscommands
 
 import (
+	"io"
 	"os/exec"
 	"strings"
 
@@ -60,6 +61,28 @@ type ICmdObj interface {
 	// returns true if IgnoreEmptyError() was called
 	ShouldIgnoreEmptyError() bool
 
+	// if you call this before RunAndProcessLines, the output will be split on NUL
+	// characters rather than newlines, for commands that we run with -z
+	SplitOnNul() ICmdObj
+	// returns true if SplitOnNul() was called
+	ShouldSplitOnNul() bool
+
+	// by default, RunAndProcessLines doesn't care whether the command
+	// succeeded, because the callback has seen the output anyway. If you call
+	// this, it returns an error (with the command's stderr) if the command
+	// failed, unless the callback asked to kill it.
+	ReturnExitError() ICmdObj
+	// returns true if ReturnExitError() was called
+	ShouldReturnExitError() bool
+
+	// if you call this, the output of the command is also written to the given
+	// writer as it comes in. Stdout and stderr are written from different
+	// goroutines, so the writer must be safe for concurrent use. Only supported
+	// for commands that stream their output or that have a credential strategy.
+	WithOutputWriter(writer io.Writer) ICmdObj
+	// returns the writer passed to WithOutputWriter(), or nil
+	OutputWriter() io.Writer
+
 	PromptOnCredentialRequest(task gocui.Task) ICmdObj
 	FailOnCredentialRequest() ICmdObj
 
@@ -86,6 +109,15 @@ type CmdObj struct {
 	// see IgnoreEmptyError()
 	ignoreEmptyError bool
 
+	// see SplitOnNul()
+	splitOnNul bool
+
+	// see ReturnExitError()
+	returnExitError bool
+
+	// see WithOutputWriter()
+	outputWriter io.Writer
+
 	// if set to true, it means we might be asked to enter a username/password by this command.
 	credentialStrategy CredentialStrategy
 	task               gocui.Task
@@ -172,6 +204,36 @@ func (self *CmdObj) IgnoreEmptyError() ICmdObj {
 	return self
 }
 
+func (self *CmdObj) SplitOnNul() ICmdObj {
+	self.splitOnNul = true
+
+	return self
+}
+
+func (self *CmdObj) ShouldSplitOnNul() bool {
+	return self.splitOnNul
+}
+
+func (self *CmdObj) ReturnExitError() ICmdObj {
+	self.returnExitError = true
+
+	return self
+}
+
+func (self *CmdObj) ShouldReturnExitError() bool {
+	return self.returnExitError
+}
+
+func (self *CmdObj) WithOutputWriter(writer io.Writer) ICmdObj {
+	self.outputWriter = writer
+
+	return self
+}
+
+func (self *CmdObj) OutputWriter() io.Writer {
+	return self.outputWriter
+}
+
 func (self *CmdObj) Mutex() *deadlock.Mutex {
 	return self.mutex
 }
@@ -4,12 +4,18 @@ import (
 	"bufio"
 	"bytes"
 	"io"
+	"os/exec"
 	"regexp"
+	"slices"
+	"strconv"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/go-errors/errors"
 	"github.com/jesseduffield/gocui"
+	"github.com/jesseduffield/lazygit/pkg/app/daemon"
+	"github.com/jesseduffield/lazygit/pkg/common"
 	"github.com/jesseduffield/lazygit/pkg/utils"
 	"github.com/sirupsen/logrus"
 )
@@ -22,8 +28,9 @@ type ICmdObjRunner interface {
 }
 
 type cmdObjRunner struct {
-	log   *logrus.Entry
-	guiIO *guiIO
+	log    *logrus.Entry
+	guiIO  *guiIO
+	common *common.Common
 }
 
 var _ ICmdObjRunner = &cmdObjRunner{}
@@ -35,11 +42,13 @@ func (self *cmdObjRunner) Run(cmdObj ICmdObj) error {
 	}
 
 	if cmdObj.GetCredentialStrategy() != NONE {
-		return self.runWithCredentialHandling(cmdObj)
+		_, err := self.runWithCredentialHandling(cmdObj, false)
+		return err
 	}
 
 	if cmdObj.ShouldStreamOutput() {
-		return self.runAndStream(cmdObj)
+		_, err := self.runAndStream(cmdObj, false)
+		return err
 	}
 
 	_, err := self.RunWithOutputAux(cmdObj)
@@ -53,19 +62,11 @@ func (self *cmdObjRunner) RunWithOutput(cmdObj ICmdObj) (string, error) {
 	}
 
 	if cmdObj.GetCredentialStrategy() != NONE {
-		err := self.runWithCredentialHandling(cmdObj)
-		// for now we're not capturing output, just because it would take a little more
-		// effort and there's currently no use case for it. Some commands call RunWithOutput
-		// but ignore the output, hence why we've got this check here.
-		return "", err
+		return self.runWithCredentialHandling(cmdObj, true)
 	}
 
 	if cmdObj.ShouldStreamOutput() {
-		err := self.runAndStream(cmdObj)
-		// for now we're not capturing output, just because it would take a little more
-		// effort and there's currently no use case for it. Some commands call RunWithOutput
-		// but ignore the output, hence why we've got this check here.
-		return "", err
+		return self.runAndStream(cmdObj, true)
 	}
 
 	return self.RunWithOutputAux(cmdObj)
@@ -78,7 +79,7 @@ func (self *cmdObjRunner) RunWithOutputs(cmdObj ICmdObj) (string, string, error)
 	}
 
 	if cmdObj.GetCredentialStrategy() != NONE {
-		err := self.runWithCredentialHandling(cmdObj)
+		_, err := self.runWithCredentialHandling(cmdObj, false)
 		// for now we're not capturing output, just because it would take a little more
 		// effort and there's currently no use case for it. Some commands call RunWithOutputs
 		// but ignore the output, hence why we've got this check here.
@@ -86,7 +87,7 @@ func (self *cmdObjRunner) RunWithOutputs(cmdObj ICmdObj) (string, string, error)
 	}
 
 	if cmdObj.ShouldStreamOutput() {
-		err := self.runAndStream(cmdObj)
+		_, err := self.runAndStream(cmdObj, false)
 		// for now we're not capturing output, just because it would take a little more
 		// effort and there's currently no use case for it. Some commands call RunWithOutputs
 		// but ignore the output, hence why we've got this check here.
@@ -99,26 +100,33 @@ func (self *cmdObjRunner) RunWithOutputs(cmdObj ICmdObj) (string, string, error)
 func (self *cmdObjRunner) RunWithOutputAux(cmdObj ICmdObj) (string, error) {
 	self.log.WithField("command", cmdObj.ToString()).Debug("RunCommand")
 
+	logID := 0
 	if cmdObj.ShouldLog() {
-		self.logCmdObj(cmdObj)
+		logID = self.logCmdObj(cmdObj)
 	}
 
 	t := time.Now()
-	output, err := sanitisedCommandOutput(cmdObj.GetCmd().CombinedOutput())
+	rawOutput, rawErr := cmdObj.GetCmd().CombinedOutput()
+	output, err := sanitisedCommandOutput(rawOutput, rawErr)
 	if err != nil {
 		self.log.WithField("command", cmdObj.ToString()).Error(output)
 	}
 
 	self.log.Infof("%s (%s)", cmdObj.ToString(), time.Since(t))
 
+	if cmdObj.ShouldLog() {
+		self.logCmdObjResult(NewCommandResult(cmdObj, logID, output, rawErr, time.Since(t)))
+	}
+
 	return output, err
 }
 
 func (self *cmdObjRunner) RunWithOutputsAux(cmdObj ICmdObj) (string, string, error) {
 	self.log.WithField("command", cmdObj.ToString()).Debug("RunCommand")
 
+	logID := 0
 	if cmdObj.ShouldLog() {
-		self.logCmdObj(cmdObj)
+		logID = self.logCmdObj(cmdObj)
 	}
 
 	t := time.Now()
@@ -126,12 +134,16 @@ func (self *cmdObjRunner) RunWithOutputsAux(cmdObj ICmdObj) (string, string, err
 	cmd := cmdObj.GetCmd()
 	cmd.Stdout = &outBuffer
 	cmd.Stderr = &errBuffer
-	err := cmd.Run()
+	rawErr := cmd.Run()
 
 	self.log.Infof("%s (%s)", cmdObj.ToString(), time.Since(t))
 
+	if cmdObj.ShouldLog() {
+		self.logCmdObjResult(NewCommandResult(cmdObj, logID, outBuffer.String()+errBuffer.String(), rawErr, time.Since(t)))
+	}
+
 	stdout := outBuffer.String()
-	stderr, err := sanitisedCommandOutput(errBuffer.Bytes(), err)
+	stderr, err := sanitisedCommandOutput(errBuffer.Bytes(), rawErr)
 	if err != nil {
 		self.log.WithField("command", cmdObj.ToString()).Error(stderr)
 	}
@@ -149,8 +161,9 @@ func (self *cmdObjRunner) RunAndProcessLines(cmdObj ICmdObj, onLine func(line st
 		return errors.New("cannot call RunAndProcessLines with credential strategy. If you're seeing this then a contributor to Lazygit has accidentally called this method! Please raise an issue")
 	}
 
+	logID := 0
 	if cmdObj.ShouldLog() {
-		self.logCmdObj(cmdObj)
+		logID = self.logCmdObj(cmdObj)
 	}
 	t := time.Now()
 
@@ -159,13 +172,22 @@ func (self *cmdObjRunner) RunAndProcessLines(cmdObj ICmdObj, onLine func(line st
 	if err != nil {
 		return err
 	}
+	var stderr bytes.Buffer
+	if cmdObj.ShouldReturnExitError() {
+		cmd.Stderr = &stderr
+	}
 
 	scanner := bufio.NewScanner(stdoutPipe)
-	scanner.Split(utils.ScanLinesAndTruncateWhenLongerThanBuffer(bufio.MaxScanTokenSize))
+	if cmdObj.ShouldSplitOnNul() {
+		scanner.Split(utils.ScanNul)
+	} else {
+		scanner.Split(utils.ScanLinesAndTruncateWhenLongerThanBuffer(bufio.MaxScanTokenSize))
+	}
 	if err := cmd.Start(); err != nil {
 		return err
 	}
 
+	killed := false
 	for scanner.Scan() {
 		line := scanner.Text()
 		stop, err := onLine(line)
@@ -174,6 +196,7 @@ func (self *cmdObjRunner) RunAndProcessLines(cmdObj ICmdObj, onLine func(line st
 		}
 		if stop {
 			_ = Kill(cmd)
+			killed = true
 			break
 		}
 	}
@@ -183,15 +206,91 @@ func (self *cmdObjRunner) RunAndProcessLines(cmdObj ICmdObj, onLine func(line st
 		return scanner.Err()
 	}
 
-	_ = cmd.Wait()
+	waitErr := cmd.Wait()
 
 	self.log.Infof("%s (%s)", cmdObj.ToString(), time.Since(t))
 
+	if cmdObj.ShouldLog() {
+		// the output has been consumed by onLine, so we don't keep it
+		self.logCmdObjResult(NewCommandResult(cmdObj, logID, "", waitErr, time.Since(t)))
+	}
+
+	if cmdObj.ShouldReturnExitError() && !killed {
+		_, err := sanitisedCommandOutput(stderr.Bytes(), waitErr)
+		return err
+	}
+
 	return nil
 }
 
-func (self *cmdObjRunner) logCmdObj(cmdObj ICmdObj) {
-	self.guiIO.logCommandFn(cmdObj.ToString(), true)
+func (self *cmdObjRunner) logCmdObj(cmdObj ICmdObj) int {
+	return self.guiIO.logCommandFn(cmdObj.ToString(), true)
+}
+
+func (self *cmdObjRunner) logCmdObjResult(result CommandResult) {
+	self.guiIO.logCommandResultFn(result)
+}
+
+// CommandResult is what we know about a logged command once it has finished
+type CommandResult struct {
+	// The ID that logCommandFn returned for the command
+	LogID int
+	// The command as it was logged before running it
+	Command  string
+	Args     []string
+	ExitCode int
+	Duration time.Duration
+	// stdout and stderr of the command
+	Output string
+	// True if lazygit drove the command itself through its daemon (see
+	// pkg/app/daemon), e.g. by editing the todo list of an interactive rebase,
+	// so running the same command by hand won't do the same
+	UsedDaemon bool
+}
+
+func NewCommandResult(cmdObj ICmdObj, logID int, output string, err error, duration time.Duration) CommandResult {
+	return CommandResult{
+		LogID:    logID,
+		Command:  cmdObj.ToString(),
+		Args:     cmdObj.Args(),
+		ExitCode: exitCodeFromError(err),
+		Duration: duration,
+		Output:   output,
+
+		UsedDaemon: usesDaemon(cmdObj.GetEnvVars()),
+	}
+}
+
+// Returns true if the env vars make git run lazygit as its sequence editor or
+// as another daemon. Askpass doesn't count: it only answers credential prompts,
+// which the user can answer themselves when running the command by hand. Nor
+// does a no-op sequence editor like ':'.
+func usesDaemon(env []string) bool {
+	return slices.ContainsFunc(env, func(envVar string) bool {
+		name, value, _ := strings.Cut(envVar, "=")
+		switch name {
+		case daemon.DaemonKindEnvKey:
+			return value != strconv.Itoa(int(daemon.DaemonKindAskpass))
+		case "GIT_SEQUENCE_EDITOR":
+			return value == GetLazygitPath()
+		}
+		return false
+	})
+}
+
+// Returns -1 if the command failed without exiting, e.g. because it couldn't
+// be started
+func exitCodeFromError(err error) int {
+	if err == nil {
+		return 0
+	}
+
+	var exitErr *exec.ExitError
+	if errors.As(err, &exitErr) {
+		return exitErr.ExitCode()
+	}
+
+	return -1
 }
 
 func sanitisedCommandOutput(output []byte, err error) (string, error) {
@@ -210,39 +309,99 @@ func sanitisedCommandOutput(output []byte, err error) (string, error) {
 type cmdHandler struct {
 	stdoutPipe io.Reader
 	stdinPipe  io.Writer
+	// called once the command has exited, so that reading from stdoutPipe
+	// reaches the end
+	stdoutDone func()
 	close      func() error
 }
 
-func (self *cmdObjRunner) runAndStream(cmdObj ICmdObj) error {
-	return self.runAndStreamAux(cmdObj, func(handler *cmdHandler, cmdWriter io.Writer) {
+// Closes done once the reader has reached the end (or failed)
+type doneNotifyingReader struct {
+	reader io.Reader
+	done   chan struct{}
+	once   sync.Once
+}
+
+func (self *doneNotifyingReader) Read(p []byte) (int, error) {
+	n, err := self.reader.Read(p)
+	if err != nil {
+		self.once.Do(func() { close(self.done) })
+	}
+	return n, err
+}
+
+// A buffer that can be read while another goroutine is still writing to it
+type syncBuffer struct {
+	mutex  sync.Mutex
+	buffer bytes.Buffer
+}
+
+func (self *syncBuffer) Write(p []byte) (int, error) {
+	self.mutex.Lock()
+	defer self.mutex.Unlock()
+
+	return self.buffer.Write(p)
+}
+
+func (self *syncBuffer) String() string {
+	self.mutex.Lock()
+	defer self.mutex.Unlock()
+
+	return self.buffer.String()
+}
+
+func (self *cmdObjRunner) runAndStream(cmdObj ICmdObj, captureStdout bool) (string, error) {
+	return self.runAndStreamAux(cmdObj, captureStdout, func(handler *cmdHandler, cmdWriter io.Writer) {
 		go func() {
 			_, _ = io.Copy(cmdWriter, handler.stdoutPipe)
 		}()
 	})
 }
 
+// When the command's output is read from a PTY, reading only reaches the end
+// once every process that has the PTY open has exited, which for a process
+// that the command left running in the background may be never. So once the
+// command has exited we only wait this long for the rest of its output.
+const stdoutReadTimeout = time.Second
+
+// Returns the command's stdout if captureStdout is true. Otherwise we only wait
+// for all of it to be read if the command has an output writer, so the result
+// might be incomplete. Note that when the command runs in a PTY, its line
+// endings are \r\n.
 func (self *cmdObjRunner) runAndStreamAux(
 	cmdObj ICmdObj,
+	captureStdout bool,
 	onRun func(*cmdHandler, io.Writer),
-) error {
+) (string, error) {
 	cmdWriter := self.guiIO.newCmdWriterFn()
+	if outputWriter := cmdObj.OutputWriter(); outputWriter != nil {
+		cmdWriter = io.MultiWriter(cmdWriter, outputWriter)
+	}
 
+	logID := 0
 	if cmdObj.ShouldLog() {
-		self.logCmdObj(cmdObj)
+		logID = self.logCmdObj(cmdObj)
 	}
 	self.log.WithField("command", cmdObj.ToString()).Debug("RunCommand")
 	cmd := cmdObj.GetCmd()
 
 	var stderr bytes.Buffer
 	cmd.Stderr = io.MultiWriter(cmdWriter, &stderr)
+	// A background process also keeps the pipe that Wait copies stderr from
+	// open, so we bound that wait in the same way
+	cmd.WaitDelay = stdoutReadTimeout
 
 	handler, err := self.getCmdHandler(cmd)
 	if err != nil {
-		return err
+		return "", err
 	}
 
-	var stdout bytes.Buffer
-	handler.stdoutPipe = io.TeeReader(handler.stdoutPipe, &stdout)
+	var stdout syncBuffer
+	stdoutRead := make(chan struct{})
+	handler.stdoutPipe = &doneNotifyingReader{
+		reader: io.TeeReader(handler.stdoutPipe, &stdout),
+		done:   stdoutRead,
+	}
 
 	defer func() {
 		if closeErr := handler.close(); closeErr != nil {
@@ -255,26 +414,43 @@ func (self *cmdObjRunner) runAndStreamAux(
 	onRun(handler, cmdWriter)
 
 	err = cmd.Wait()
+	if errors.Is(err, exec.ErrWaitDelay) {
+		// the command itself succeeded
+		err = nil
+	}
 
 	self.log.Infof("%s (%s)", cmdObj.ToString(), time.Since(t))
 
+	handler.stdoutDone()
+	if captureStdout || cmdObj.OutputWriter() != nil {
+		select {
+		case <-stdoutRead:
+		case <-time.After(stdoutReadTimeout):
+			self.log.Warnf("%s: gave up waiting for the end of its output", cmdObj.ToString())
+		}
+	}
+	stdoutStr := stdout.String()
+
+	if cmdObj.ShouldLog() {
+		self.logCmdObjResult(NewCommandResult(cmdObj, logID, stdoutStr+stderr.String(), err, time.Since(t)))
+	}
+
 	if err != nil {
 		errStr := stderr.String()
 		if errStr != "" {
-			return errors.New(errStr)
+			return stdoutStr, errors.New(errStr)
 		}
 
 		if cmdObj.ShouldIgnoreEmptyError() {
-			return nil
+			return stdoutStr, nil
 		}
-		stdoutStr := stdout.String()
 		if stdoutStr != "" {
-			return errors.New(stdoutStr)
+			return stdoutStr, errors.New(stdoutStr)
 		}
-		return errors.New("Command exited with non-zero exit code, but no output")
+		return stdoutStr, errors.New("Command exited with non-zero exit code, but no output")
 	}
 
-	return nil
+	return stdoutStr, nil
 }
 
 type CredentialType int
@@ -285,11 +461,30 @@ const (
 	Passphrase
 	PIN
 	Token
+	// A yes/no question, e.g. whether to trust an ssh host key. Answered with
+	// "yes" or "no".
+	Confirmation
+	// Something the user needs to know about but needn't answer, e.g. that
+	// they need to touch their security key
+	Notification
 )
 
+// CredentialRequest is what we ask the user for when a command needs credentials
+type CredentialRequest struct {
+	Type CredentialType
+	// The exact prompt of git or ssh, e.g. "Password for 'https://github.com': ".
+	// Only known when the prompt was forwarded to us by the askpass daemon;
+	// empty when we detected the prompt in the command's output.
+	Prompt string
+	// What the credential is for, e.g. "bill@github.com" (or just "github.com"
+	// if the prompt doesn't name the user) or the path of an ssh key, if we
+	// could tell from the prompt. Used for caching credentials.
+	Host string
+}
+
 // Whenever we're asked for a password we just enter a newline, which will
 // eventually cause the command to fail.
-var failPromptFn = func(CredentialType) <-chan string {
+var failPromptFn = func(CredentialRequest) <-chan string {
 	ch := make(chan string)
 	go func() {
 		ch <- "\n"
@@ -297,16 +492,42 @@ var failPromptFn = func(CredentialType) <-chan string {
 	return ch
 }
 
-func (self *cmdObjRunner) runWithCredentialHandling(cmdObj ICmdObj) error {
+func (self *cmdObjRunner) runWithCredentialHandling(cmdObj ICmdObj, captureStdout bool) (string, error) {
 	promptFn, err := self.getCredentialPromptFn(cmdObj)
 	if err != nil {
-		return err
+		return "", err
+	}
+
+	var credentialCacheSession *credentialCacheSession
+	if cmdObj.GetCredentialStrategy() == PROMPT && self.common.UserConfig().Git.CacheCredentials {
+		credentialCacheSession = self.guiIO.credentialCache.newSession(promptFn)
+		promptFn = credentialCacheSession.prompt
+	}
+
+	// Only needed when we actually prompt; when failing on credential requests
+	// we rely on git giving up after we've sent an empty line to its prompt.
+	if cmdObj.GetCredentialStrategy() == PROMPT &&
+		self.common.UserConfig().Git.UseAskpass &&
+		canUseAskpass(cmdObj) {
+		server, err := startAskpassServer(self.log, self.getCredentialTypeForPrompt, promptFn, cmdObj.GetTask())
+		if err != nil {
+			// not fatal: we can still detect the prompts in the command's output
+			self.log.Error(err)
+		} else {
+			defer server.close()
+			cmdObj.AddEnvVars(server.envVars()...)
+		}
 	}
 
-	return self.runAndDetectCredentialRequest(cmdObj, promptFn)
+	output, err := self.runAndDetectCredentialRequest(cmdObj, promptFn, captureStdout)
+	if credentialCacheSession != nil {
+		credentialCacheSession.finish(err)
+	}
+
+	return output, err
 }
 
-func (self *cmdObjRunner) getCredentialPromptFn(cmdObj ICmdObj) (func(CredentialType) <-chan string, error) {
+func (self *cmdObjRunner) getCredentialPromptFn(cmdObj ICmdObj) (func(CredentialRequest) <-chan string, error) {
 	switch cmdObj.GetCredentialStrategy() {
 	case PROMPT:
 		return self.guiIO.promptForCredentialFn, nil
@@ -323,12 +544,13 @@ func (self *cmdObjRunner) getCredentialPromptFn(cmdObj ICmdObj) (func(Credential
 // The promptUserForCredential argument will be "username", "password" or "passphrase" and expects the user's password/passphrase or username back
 func (self *cmdObjRunner) runAndDetectCredentialRequest(
 	cmdObj ICmdObj,
-	promptUserForCredential func(CredentialType) <-chan string,
-) error {
+	promptUserForCredential func(CredentialRequest) <-chan string,
+	captureStdout bool,
+) (string, error) {
 	// setting the output to english so we can parse it for a username/password request
 	cmdObj.AddEnvVars("LANG=en_US.UTF-8", "LC_ALL=en_US.UTF-8")
 
-	return self.runAndStreamAux(cmdObj, func(handler *cmdHandler, cmdWriter io.Writer) {
+	return self.runAndStreamAux(cmdObj, captureStdout, func(handler *cmdHandler, cmdWriter io.Writer) {
 		tr := io.TeeReader(handler.stdoutPipe, cmdWriter)
 
 		go utils.Safe(func() {
@@ -340,7 +562,7 @@ func (self *cmdObjRunner) runAndDetectCredentialRequest(
 func (self *cmdObjRunner) processOutput(
 	reader io.Reader,
 	writer io.Writer,
-	promptUserForCredential func(CredentialType) <-chan string,
+	promptUserForCredential func(CredentialRequest) <-chan string,
 	task gocui.Task,
 ) {
 	checkForCredentialRequest := self.getCheckForCredentialRequestFunc()
@@ -349,9 +571,9 @@ func (self *cmdObjRunner) processOutput(
 	scanner.Split(bufio.ScanBytes)
 	for scanner.Scan() {
 		newBytes := scanner.Bytes()
-		askFor, ok := checkForCredentialRequest(newBytes)
+		request, ok := checkForCredentialRequest(newBytes)
 		if ok {
-			responseChan := promptUserForCredential(askFor)
+			responseChan := promptUserForCredential(request)
 			if task != nil {
 				task.Pause()
 			}
@@ -367,38 +589,77 @@ func (self *cmdObjRunner) processOutput(
 	}
 }
 
-// having a function that returns a function because we need to maintain some state inbetween calls hence the closure
-func (self *cmdObjRunner) getCheckForCredentialRequestFunc() func([]byte) (CredentialType, bool) {
-	var ttyText strings.Builder
-	prompts := map[string]CredentialType{
-		`Password:`:                              Password,
-		`.+'s password:`:                         Password,
-		`Password\s*for\s*'.+':`:                 Password,
-		`Username\s*for\s*'.+':`:                 Username,
-		`Enter\s*passphrase\s*for\s*key\s*'.+':`: Passphrase,
-		`Enter\s*PIN\s*for\s*.+\s*key\s*.+:`:     PIN,
-		`.*2FA Token.*`:                          Token,
+type credentialPrompt struct {
+	pattern *regexp.Regexp
+	askFor  CredentialType
+}
+
+var defaultCredentialPrompts = []credentialPrompt{
+	{regexp.MustCompile(`Password:`), Password},
+	{regexp.MustCompile(`.+'s password:`), Password},
+	{regexp.MustCompile(`Password\s*for\s*'.+':`), Password},
+	{regexp.MustCompile(`Username\s*for\s*'.+':`), Username},
+	{regexp.MustCompile(`Enter\s*passphrase\s*for\s*key\s*'.+':`), Passphrase},
+	{regexp.MustCompile(`Enter\s*PIN\s*for\s*.+\s*key\s*.+:`), PIN},
+	{regexp.MustCompile(`.*2FA Token.*`), Token},
+}
+
+var credentialTypesByName = map[string]CredentialType{
+	"username":   Username,
+	"password":   Password,
+	"passphrase": Passphrase,
+	"pin":        PIN,
+	"token":      Token,
+}
+
+// The prompts configured by the user come first so that they can override
+// the default ones
+func (self *cmdObjRunner) getCredentialPrompts() []credentialPrompt {
+	prompts := []credentialPrompt{}
+	for _, prompt := range self.common.UserConfig().Git.CredentialPrompts {
+		pattern, err := regexp.Compile(prompt.Pattern)
+		if err != nil {
+			// the config is validated when loading it, so this shouldn't happen
+			self.log.Error(err)
+			continue
+		}
+		prompts = append(prompts, credentialPrompt{pattern, credentialTypesByName[prompt.Type]})
 	}
 
-	compiledPrompts := map[*regexp.Regexp]CredentialType{}
-	for pattern, askFor := range prompts {
-		compiledPattern := regexp.MustCompile(pattern)
-		compiledPrompts[compiledPattern] = askFor
+	return append(prompts, defaultCredentialPrompts...)
+}
+
+// Used for the prompts forwarded by the askpass daemon. If a prompt doesn't
+// match any of the patterns we assume it's asking for something secret.
+func (self *cmdObjRunner) getCredentialTypeForPrompt(prompt string) CredentialType {
+	for _, credentialPrompt := range self.getCredentialPrompts() {
+		if credentialPrompt.pattern.MatchString(prompt) {
+			return credentialPrompt.askFor
+		}
 	}
 
+	return Password
+}
+
+// having a function that returns a function because we need to maintain some state inbetween calls hence the closure
+func (self *cmdObjRunner) getCheckForCredentialRequestFunc() func([]byte) (CredentialRequest, bool) {
+	var ttyText strings.Builder
+	prompts := self.getCredentialPrompts()
+
 	newlineRegex := regexp.MustCompile("\n")
 
 	// this function takes each word of output from the command and builds up a string to see if we're being asked for a password
-	return func(newBytes []byte) (CredentialType, bool) {
+	return func(newBytes []byte) (CredentialRequest, bool) {
 		_, err := ttyText.Write(newBytes)
 		if err != nil {
 			self.log.Error(err)
 		}
 
-		for pattern, askFor := range compiledPrompts {
-			if match := pattern.Match([]byte(ttyText.String())); match {
+		for _, prompt := range prompts {
+			if match := prompt.pattern.Match([]byte(ttyText.String())); match {
+				request := CredentialRequest{Type: prompt.askFor, Host: credentialHostFromPrompt(ttyText.String())}
 				ttyText.Reset()
-				return askFor, true
+				return request, true
 			}
 		}
 
@@ -407,6 +668,6 @@ func (self *cmdObjRunner) getCheckForCredentialRequestFunc() func([]byte) (Crede
 			ttyText.Reset()
 			ttyText.Write(newText)
 		}
-		return 0, false
+		return CredentialRequest{}, false
 	}
 }
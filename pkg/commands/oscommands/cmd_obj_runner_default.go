@@ -20,6 +20,8 @@ func (self *cmdObjRunner) getCmdHandler(cmd *exec.Cmd) (*cmdHandler, error) {
 	return &cmdHandler{
 		stdoutPipe: ptmx,
 		stdinPipe:  ptmx,
+		// reading from the PTY ends by itself once the command has exited
+		stdoutDone: func() {},
 		close:      ptmx.Close,
 	}, nil
 }
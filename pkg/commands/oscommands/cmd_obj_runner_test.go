@@ -1,27 +1,32 @@
 package oscommands
 
 import (
+	"strconv"
 	"strings"
 	"testing"
 
 	"github.com/jesseduffield/gocui"
+	"github.com/jesseduffield/lazygit/pkg/app/daemon"
+	"github.com/jesseduffield/lazygit/pkg/config"
 	"github.com/jesseduffield/lazygit/pkg/utils"
+	"github.com/stretchr/testify/assert"
 )
 
 func getRunner() *cmdObjRunner {
 	log := utils.NewDummyLog()
 	return &cmdObjRunner{
-		log:   log,
-		guiIO: NewNullGuiIO(log),
+		log:    log,
+		guiIO:  NewNullGuiIO(log),
+		common: utils.NewDummyCommon(),
 	}
 }
 
-func toChanFn(f func(ct CredentialType) string) func(CredentialType) <-chan string {
-	return func(ct CredentialType) <-chan string {
+func toChanFn(f func(ct CredentialType) string) func(CredentialRequest) <-chan string {
+	return func(request CredentialRequest) <-chan string {
 		ch := make(chan string)
 
 		go func() {
-			ch <- f(ct)
+			ch <- f(request.Type)
 		}()
 
 		return ch
@@ -49,6 +54,7 @@ func TestProcessOutput(t *testing.T) {
 	scenarios := []struct {
 		name                    string
 		promptUserForCredential func(CredentialType) string
+		credentialPrompts       []config.CredentialPromptConfig
 		output                  string
 		expectedToWrite         string
 	}{
@@ -106,6 +112,25 @@ func TestProcessOutput(t *testing.T) {
 			output:                  "Password:\nUsername for 'Alice':\n",
 			expectedToWrite:         "passwordusername",
 		},
+		{
+			name:                    "configured prompts",
+			promptUserForCredential: defaultPromptUserForCredential,
+			credentialPrompts: []config.CredentialPromptConfig{
+				{Pattern: `Benutzername für '.+':`, Type: "username"},
+				{Pattern: `Passwort für '.+':`, Type: "password"},
+			},
+			output:          "Benutzername für 'github':\nPasswort für 'github':\n",
+			expectedToWrite: "usernamepassword",
+		},
+		{
+			name:                    "configured prompt takes precedence over the default ones",
+			promptUserForCredential: defaultPromptUserForCredential,
+			credentialPrompts: []config.CredentialPromptConfig{
+				{Pattern: `Password:`, Type: "token"},
+			},
+			output:          "Password:",
+			expectedToWrite: "token",
+		},
 		{
 			name:                    "user submits empty credential",
 			promptUserForCredential: func(ct CredentialType) string { return "" },
@@ -117,6 +142,7 @@ func TestProcessOutput(t *testing.T) {
 	for _, scenario := range scenarios {
 		t.Run(scenario.name, func(t *testing.T) {
 			runner := getRunner()
+			runner.common.UserConfig().Git.CredentialPrompts = scenario.credentialPrompts
 			reader := strings.NewReader(scenario.output)
 			writer := &strings.Builder{}
 
@@ -129,3 +155,38 @@ func TestProcessOutput(t *testing.T) {
 		})
 	}
 }
+
+func TestGetCredentialTypeForPrompt(t *testing.T) {
+	scenarios := []struct {
+		prompt       string
+		expectedType CredentialType
+	}{
+		{prompt: "Username for 'https://github.com': ", expectedType: Username},
+		{prompt: "Password for 'https://bill@github.com': ", expectedType: Password},
+		{prompt: "Enter passphrase for key '/home/bill/.ssh/id_ed25519': ", expectedType: Passphrase},
+		{prompt: "Benutzername für 'https://github.com': ", expectedType: Username},
+		{prompt: "Geheimnis für 'https://github.com': ", expectedType: Password},
+	}
+
+	runner := getRunner()
+	runner.common.UserConfig().Git.CredentialPrompts = []config.CredentialPromptConfig{
+		{Pattern: `Benutzername für '.+':`, Type: "username"},
+	}
+
+	for _, scenario := range scenarios {
+		t.Run(scenario.prompt, func(t *testing.T) {
+			if askFor := runner.getCredentialTypeForPrompt(scenario.prompt); askFor != scenario.expectedType {
+				t.Errorf("expected credential type %d but got %d", scenario.expectedType, askFor)
+			}
+		})
+	}
+}
+
+func TestUsesDaemon(t *testing.T) {
+	assert.False(t, usesDaemon(nil))
+	assert.False(t, usesDaemon([]string{"GIT_OPTIONAL_LOCKS=0"}))
+	assert.True(t, usesDaemon([]string{"LAZYGIT_DAEMON_KIND=3", "GIT_SEQUENCE_EDITOR=/usr/bin/lazygit"}))
+	assert.True(t, usesDaemon([]string{"GIT_SEQUENCE_EDITOR=" + GetLazygitPath()}))
+	assert.False(t, usesDaemon([]string{"GIT_SEQUENCE_EDITOR=:"}))
+	assert.False(t, usesDaemon([]string{"LAZYGIT_DAEMON_KIND=" + strconv.Itoa(int(daemon.DaemonKindAskpass)), "GIT_ASKPASS=/usr/bin/lazygit"}))
+}
@@ -46,6 +46,7 @@ func (self *cmdObjRunner) getCmdHandler(cmd *exec.Cmd) (*cmdHandler, error) {
 	return &cmdHandler{
 		stdoutPipe: stdoutReader,
 		stdinPipe:  buf,
+		stdoutDone: func() { _ = stdoutWriter.Close() },
 		close:      func() error { return nil },
 	}, nil
 }
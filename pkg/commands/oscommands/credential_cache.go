@@ -0,0 +1,186 @@
+package oscommands
+
+import (
+	"net/url"
+	"regexp"
+
+	"github.com/sasha-s/go-deadlock"
+)
+
+// credentialCache remembers the credentials that the user entered, so that we
+// can answer the same prompt again without asking. It only lives in memory for
+// the duration of the session; we never write it to disk.
+type credentialCache struct {
+	mutex   deadlock.Mutex
+	entries map[credentialCacheKey]string
+}
+
+// The host includes the user if the prompt names one (e.g. "bill@github.com"),
+// so that different accounts on the same host don't share credentials
+type credentialCacheKey struct {
+	host           string
+	credentialType CredentialType
+}
+
+func newCredentialCache() *credentialCache {
+	return &credentialCache{entries: map[credentialCacheKey]string{}}
+}
+
+func (self *credentialCache) get(key credentialCacheKey) (string, bool) {
+	self.mutex.Lock()
+	defer self.mutex.Unlock()
+
+	answer, ok := self.entries[key]
+	return answer, ok
+}
+
+func (self *credentialCache) set(key credentialCacheKey, answer string) {
+	self.mutex.Lock()
+	defer self.mutex.Unlock()
+
+	self.entries[key] = answer
+}
+
+func (self *credentialCache) forget(key credentialCacheKey) {
+	self.mutex.Lock()
+	defer self.mutex.Unlock()
+
+	delete(self.entries, key)
+}
+
+func (self *credentialCache) clear() {
+	self.mutex.Lock()
+	defer self.mutex.Unlock()
+
+	self.entries = map[credentialCacheKey]string{}
+}
+
+func (self *credentialCache) len() int {
+	self.mutex.Lock()
+	defer self.mutex.Unlock()
+
+	return len(self.entries)
+}
+
+// credentialCacheSession answers the credential prompts of a single command,
+// using the cache where possible and asking the user otherwise. The answers
+// the user enters only make it into the cache once the command has succeeded,
+// so that we never cache credentials that turned out to be wrong.
+type credentialCacheSession struct {
+	cache                   *credentialCache
+	promptUserForCredential func(CredentialRequest) <-chan string
+
+	mutex deadlock.Mutex
+	// the keys we've answered from the cache during this command
+	answeredFromCache map[credentialCacheKey]bool
+	// the answers the user entered during this command
+	entered map[credentialCacheKey]string
+}
+
+func (self *credentialCache) newSession(promptUserForCredential func(CredentialRequest) <-chan string) *credentialCacheSession {
+	return &credentialCacheSession{
+		cache:                   self,
+		promptUserForCredential: promptUserForCredential,
+		answeredFromCache:       map[credentialCacheKey]bool{},
+		entered:                 map[credentialCacheKey]string{},
+	}
+}
+
+func (self *credentialCacheSession) prompt(request CredentialRequest) <-chan string {
+	if request.Host == "" {
+		// we can't tell what the credential is for, so we can't cache it
+		return self.promptUserForCredential(request)
+	}
+
+	key := credentialCacheKey{host: request.Host, credentialType: request.Type}
+
+	self.mutex.Lock()
+	alreadyAnswered := self.answeredFromCache[key]
+	self.mutex.Unlock()
+
+	if alreadyAnswered {
+		// We're being asked again for something we've answered from the cache,
+		// which means the cached credential was rejected (e.g. ssh asking for
+		// the password again).
+		self.cache.forget(key)
+	} else if answer, ok := self.cache.get(key); ok {
+		self.mutex.Lock()
+		self.answeredFromCache[key] = true
+		self.mutex.Unlock()
+
+		ch := make(chan string, 1)
+		ch <- answer
+		return ch
+	}
+
+	ch := make(chan string, 1)
+	userResponseChan := self.promptUserForCredential(request)
+	go func() {
+		answer := <-userResponseChan
+		// an empty answer means the user cancelled the prompt
+		if answer != "\n" {
+			self.mutex.Lock()
+			self.entered[key] = answer
+			self.mutex.Unlock()
+		}
+		ch <- answer
+	}()
+
+	return ch
+}
+
+// Called once the command has finished, with the error it returned
+func (self *credentialCacheSession) finish(err error) {
+	self.mutex.Lock()
+	defer self.mutex.Unlock()
+
+	if err != nil {
+		// If authentication failed we don't know which of the credentials was
+		// wrong, so we forget all the ones involved; next time we'll ask the user.
+		if isAuthenticationFailure(err) {
+			for key := range self.answeredFromCache {
+				self.cache.forget(key)
+			}
+		}
+		return
+	}
+
+	for key, answer := range self.entered {
+		self.cache.set(key, answer)
+	}
+}
+
+var authenticationFailureRegex = regexp.MustCompile(
+	`(?i)authentication failed|permission denied|invalid (username|credentials)|incorrect username|could not read (username|password)|access denied|returned error: 40[13]`,
+)
+
+func isAuthenticationFailure(err error) bool {
+	return authenticationFailureRegex.MatchString(err.Error())
+}
+
+var (
+	quotedPromptSubjectRegex = regexp.MustCompile(`'([^']+)'`)
+	sshPasswordPromptRegex   = regexp.MustCompile(`(\S+)'s password:`)
+)
+
+// Returns what a credential prompt is asking for a credential for, e.g.
+// "bill@github.com" for "Password for 'https://bill@github.com': ",
+// "github.com" for "Username for 'https://github.com': ", or the path of the
+// key for an ssh passphrase prompt. Returns an empty string if we can't tell.
+func credentialHostFromPrompt(prompt string) string {
+	if match := sshPasswordPromptRegex.FindStringSubmatch(prompt); match != nil {
+		return match[1]
+	}
+
+	if match := quotedPromptSubjectRegex.FindStringSubmatch(prompt); match != nil {
+		if u, err := url.Parse(match[1]); err == nil && u.Host != "" {
+			if u.User != nil && u.User.Username() != "" {
+				return u.User.Username() + "@" + u.Host
+			}
+			return u.Host
+		}
+		return match[1]
+	}
+
+	return ""
+}
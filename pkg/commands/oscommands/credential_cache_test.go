@@ -0,0 +1,167 @@
+package oscommands
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCredentialHostFromPrompt(t *testing.T) {
+	scenarios := []struct {
+		prompt   string
+		expected string
+	}{
+		{prompt: "Username for 'https://github.com': ", expected: "github.com"},
+		{prompt: "Password for 'https://bill@github.com': ", expected: "bill@github.com"},
+		{prompt: "Password for 'https://gitlab.example.com:8443': ", expected: "gitlab.example.com:8443"},
+		{prompt: "Enter passphrase for key '/home/bill/.ssh/id_ed25519': ", expected: "/home/bill/.ssh/id_ed25519"},
+		{prompt: "bill@example.com's password: ", expected: "bill@example.com"},
+		{prompt: "Password for 'github': ", expected: "github"},
+		{prompt: "Password:", expected: ""},
+	}
+
+	for _, scenario := range scenarios {
+		t.Run(scenario.prompt, func(t *testing.T) {
+			assert.Equal(t, scenario.expected, credentialHostFromPrompt(scenario.prompt))
+		})
+	}
+}
+
+func TestCredentialCacheSession(t *testing.T) {
+	githubPassword := CredentialRequest{Type: Password, Host: "github.com"}
+	githubUsername := CredentialRequest{Type: Username, Host: "github.com"}
+
+	type step struct {
+		request        CredentialRequest
+		expectedAnswer string
+		expectPrompt   bool
+	}
+
+	scenarios := []struct {
+		name          string
+		cached        map[credentialCacheKey]string
+		steps         []step
+		err           error
+		expectedCache map[credentialCacheKey]string
+	}{
+		{
+			name: "answers from the user are cached when the command succeeds",
+			steps: []step{
+				{request: githubUsername, expectedAnswer: "user input\n", expectPrompt: true},
+				{request: githubPassword, expectedAnswer: "user input\n", expectPrompt: true},
+			},
+			expectedCache: map[credentialCacheKey]string{
+				{host: "github.com", credentialType: Username}: "user input\n",
+				{host: "github.com", credentialType: Password}: "user input\n",
+			},
+		},
+		{
+			name: "answers from the user are not cached when the command fails",
+			steps: []step{
+				{request: githubPassword, expectedAnswer: "user input\n", expectPrompt: true},
+			},
+			err:           errors.New("remote: Invalid username or password."),
+			expectedCache: map[credentialCacheKey]string{},
+		},
+		{
+			name: "prompts without a host are not cached",
+			steps: []step{
+				{request: CredentialRequest{Type: Password}, expectedAnswer: "user input\n", expectPrompt: true},
+			},
+			expectedCache: map[credentialCacheKey]string{},
+		},
+		{
+			name: "cached answers are used",
+			cached: map[credentialCacheKey]string{
+				{host: "github.com", credentialType: Password}: "secret\n",
+			},
+			steps: []step{
+				{request: githubPassword, expectedAnswer: "secret\n", expectPrompt: false},
+				{request: CredentialRequest{Type: Password, Host: "gitlab.com"}, expectedAnswer: "user input\n", expectPrompt: true},
+			},
+			expectedCache: map[credentialCacheKey]string{
+				{host: "github.com", credentialType: Password}: "secret\n",
+				{host: "gitlab.com", credentialType: Password}: "user input\n",
+			},
+		},
+		{
+			name: "different users on the same host don't share credentials",
+			cached: map[credentialCacheKey]string{
+				{host: "alice@github.com", credentialType: Password}: "alice's secret\n",
+			},
+			steps: []step{
+				{request: CredentialRequest{Type: Password, Host: "alice@github.com"}, expectedAnswer: "alice's secret\n", expectPrompt: false},
+				{request: CredentialRequest{Type: Password, Host: "bob@github.com"}, expectedAnswer: "user input\n", expectPrompt: true},
+			},
+			expectedCache: map[credentialCacheKey]string{
+				{host: "alice@github.com", credentialType: Password}: "alice's secret\n",
+				{host: "bob@github.com", credentialType: Password}:   "user input\n",
+			},
+		},
+		{
+			name: "cached answers are forgotten after an authentication failure",
+			cached: map[credentialCacheKey]string{
+				{host: "github.com", credentialType: Password}: "secret\n",
+			},
+			steps: []step{
+				{request: githubPassword, expectedAnswer: "secret\n", expectPrompt: false},
+			},
+			err:           errors.New("fatal: Authentication failed for 'https://github.com/'"),
+			expectedCache: map[credentialCacheKey]string{},
+		},
+		{
+			name: "cached answers are kept after other failures",
+			cached: map[credentialCacheKey]string{
+				{host: "github.com", credentialType: Password}: "secret\n",
+			},
+			steps: []step{
+				{request: githubPassword, expectedAnswer: "secret\n", expectPrompt: false},
+			},
+			err: errors.New("! [rejected] master -> master (fetch first)"),
+			expectedCache: map[credentialCacheKey]string{
+				{host: "github.com", credentialType: Password}: "secret\n",
+			},
+		},
+		{
+			name: "the user is asked when the same prompt comes again",
+			cached: map[credentialCacheKey]string{
+				{host: "github.com", credentialType: Password}: "secret\n",
+			},
+			steps: []step{
+				{request: githubPassword, expectedAnswer: "secret\n", expectPrompt: false},
+				{request: githubPassword, expectedAnswer: "user input\n", expectPrompt: true},
+			},
+			expectedCache: map[credentialCacheKey]string{
+				{host: "github.com", credentialType: Password}: "user input\n",
+			},
+		},
+	}
+
+	for _, scenario := range scenarios {
+		t.Run(scenario.name, func(t *testing.T) {
+			cache := newCredentialCache()
+			for key, answer := range scenario.cached {
+				cache.set(key, answer)
+			}
+
+			prompted := false
+			session := cache.newSession(func(CredentialRequest) <-chan string {
+				prompted = true
+				ch := make(chan string, 1)
+				ch <- "user input\n"
+				return ch
+			})
+
+			for _, step := range scenario.steps {
+				prompted = false
+				assert.Equal(t, step.expectedAnswer, <-session.prompt(step.request))
+				assert.Equal(t, step.expectPrompt, prompted)
+			}
+
+			session.finish(scenario.err)
+
+			assert.Equal(t, scenario.expectedCache, cache.entries)
+		})
+	}
+}
@@ -8,6 +8,7 @@ import (
 	"testing"
 
 	"github.com/go-errors/errors"
+	"github.com/jesseduffield/lazygit/pkg/utils"
 	"github.com/samber/lo"
 	"golang.org/x/exp/slices"
 )
@@ -90,7 +91,11 @@ func (self *FakeCmdObjRunner) RunAndProcessLines(cmdObj ICmdObj, onLine func(lin
 	}
 
 	scanner := bufio.NewScanner(strings.NewReader(output))
-	scanner.Split(bufio.ScanLines)
+	if cmdObj.ShouldSplitOnNul() {
+		scanner.Split(utils.ScanNul)
+	} else {
+		scanner.Split(bufio.ScanLines)
+	}
 	for scanner.Scan() {
 		line := scanner.Text()
 		stop, err := onLine(line)
@@ -18,28 +18,38 @@ type guiIO struct {
 	// The isCommandLineCommand arg is there so that we can style the log differently
 	// depending on whether we're directly outputting a command we're about to run that
 	// will be run on the command line, or if we're using something from Go's standard lib.
-	logCommandFn func(str string, isCommandLineCommand bool)
+	// Returns an ID for the logged command, which we pass back in its CommandResult.
+	logCommandFn func(str string, isCommandLineCommand bool) int
+	// this is called once a command that we've logged with logCommandFn has
+	// finished, so that the GUI can show how it went.
+	logCommandResultFn func(result CommandResult)
 	// this is for us to directly write the output of a command. We will do this for
 	// certain commands like 'git push'. The GUI will write this to a command output panel.
 	// We need a new cmd writer per command, hence it being a function.
 	newCmdWriterFn func() io.Writer
 	// this allows us to request info from the user like username/password, in the event
 	// that a command requests it.
-	// the 'credential' arg is something like 'username' or 'password'
-	promptForCredentialFn func(credential CredentialType) <-chan string
+	// the request says whether we're asked for e.g. a username or a password
+	promptForCredentialFn func(request CredentialRequest) <-chan string
+	// the credentials the user has entered this session, if git.cacheCredentials
+	// is enabled
+	credentialCache *credentialCache
 }
 
 func NewGuiIO(
 	log *logrus.Entry,
-	logCommandFn func(string, bool),
+	logCommandFn func(string, bool) int,
+	logCommandResultFn func(CommandResult),
 	newCmdWriterFn func() io.Writer,
-	promptForCredentialFn func(CredentialType) <-chan string,
+	promptForCredentialFn func(CredentialRequest) <-chan string,
 ) *guiIO {
 	return &guiIO{
 		log:                   log,
 		logCommandFn:          logCommandFn,
+		logCommandResultFn:    logCommandResultFn,
 		newCmdWriterFn:        newCmdWriterFn,
 		promptForCredentialFn: promptForCredentialFn,
+		credentialCache:       newCredentialCache(),
 	}
 }
 
@@ -48,8 +58,10 @@ func NewGuiIO(
 func NewNullGuiIO(log *logrus.Entry) *guiIO {
 	return &guiIO{
 		log:                   log,
-		logCommandFn:          func(string, bool) {},
+		logCommandFn:          func(string, bool) int { return 0 },
+		logCommandResultFn:    func(CommandResult) {},
 		newCmdWriterFn:        func() io.Writer { return io.Discard },
 		promptForCredentialFn: failPromptFn,
+		credentialCache:       newCredentialCache(),
 	}
 }
@@ -56,7 +56,7 @@ func NewOSCommand(common *common.Common, config config.AppConfigurer, platform *
 		tempDir:      config.GetTempDir(),
 	}
 
-	runner := &cmdObjRunner{log: common.Log, guiIO: guiIO}
+	runner := &cmdObjRunner{log: common.Log, guiIO: guiIO, common: common}
 	c.Cmd = &CmdObjBuilder{runner: runner, platform: platform}
 
 	return c
@@ -68,6 +68,17 @@ func (c *OSCommand) LogCommand(cmdStr string, commandLine bool) {
 	c.guiIO.logCommandFn(cmdStr, commandLine)
 }
 
+// CachedCredentialCount returns how many credentials are cached for this
+// session (see git.cacheCredentials)
+func (c *OSCommand) CachedCredentialCount() int {
+	return c.guiIO.credentialCache.len()
+}
+
+// ClearCredentialCache forgets all the credentials cached for this session
+func (c *OSCommand) ClearCredentialCache() {
+	c.guiIO.credentialCache.clear()
+}
+
 // FileType tells us if the file is a file, directory or other
 func FileType(path string) string {
 	fileInfo, err := os.Stat(path)
@@ -4,7 +4,10 @@
 package oscommands
 
 import (
+	"strings"
+	"sync"
 	"testing"
+	"time"
 
 	"github.com/go-errors/errors"
 	"github.com/stretchr/testify/assert"
@@ -38,6 +41,90 @@ func TestOSCommandRunWithOutput(t *testing.T) {
 	}
 }
 
+// stdout and stderr are written from different goroutines
+type syncWriter struct {
+	mutex   sync.Mutex
+	builder strings.Builder
+}
+
+func (self *syncWriter) Write(p []byte) (int, error) {
+	self.mutex.Lock()
+	defer self.mutex.Unlock()
+	return self.builder.Write(p)
+}
+
+func (self *syncWriter) String() string {
+	self.mutex.Lock()
+	defer self.mutex.Unlock()
+	return self.builder.String()
+}
+
+func TestOSCommandRunWithOutputWriter(t *testing.T) {
+	for _, strategy := range []CredentialStrategy{NONE, FAIL} {
+		c := NewDummyOSCommand()
+		writer := &syncWriter{}
+		cmdObj := c.Cmd.New([]string{"sh", "-c", "echo out; echo err >&2"}).StreamOutput().WithOutputWriter(writer)
+		if strategy == FAIL {
+			cmdObj.FailOnCredentialRequest()
+		}
+
+		assert.NoError(t, cmdObj.Run())
+		assert.Contains(t, writer.String(), "out")
+		assert.Contains(t, writer.String(), "err")
+	}
+}
+
+// A process left running in the background keeps the command's PTY open, so
+// we never see the end of its output
+func TestOSCommandRunWithBackgroundProcess(t *testing.T) {
+	for _, strategy := range []CredentialStrategy{NONE, FAIL} {
+		c := NewDummyOSCommand()
+		newCmdObj := func() ICmdObj {
+			cmdObj := c.Cmd.New([]string{"sh", "-c", "echo out; (trap '' HUP; sleep 10) &"}).StreamOutput()
+			if strategy == FAIL {
+				cmdObj.FailOnCredentialRequest()
+			}
+			return cmdObj
+		}
+
+		start := time.Now()
+		assert.NoError(t, newCmdObj().Run())
+		assert.Less(t, time.Since(start), 5*time.Second)
+
+		start = time.Now()
+		output, err := newCmdObj().RunWithOutput()
+		assert.NoError(t, err)
+		assert.Equal(t, "out", strings.TrimSpace(output))
+		assert.Less(t, time.Since(start), 5*time.Second)
+	}
+}
+
+func TestOSCommandRunAndProcessLinesExitError(t *testing.T) {
+	c := NewDummyOSCommand()
+	script := "echo one; echo two; echo 'fatal: oops' >&2; exit 1"
+
+	lines := []string{}
+	onLine := func(line string) (bool, error) {
+		lines = append(lines, line)
+		return false, nil
+	}
+
+	// by default the exit code is ignored
+	assert.NoError(t, c.Cmd.New([]string{"sh", "-c", script}).RunAndProcessLines(onLine))
+	assert.Equal(t, []string{"one", "two"}, lines)
+
+	lines = []string{}
+	err := c.Cmd.New([]string{"sh", "-c", script}).ReturnExitError().RunAndProcessLines(onLine)
+	assert.EqualError(t, err, "fatal: oops\n")
+	assert.Equal(t, []string{"one", "two"}, lines)
+
+	// stopping early isn't a failure
+	err = c.Cmd.New([]string{"sh", "-c", "echo one; sleep 5; exit 1"}).ReturnExitError().RunAndProcessLines(func(string) (bool, error) {
+		return true, nil
+	})
+	assert.NoError(t, err)
+}
+
 func TestOSCommandOpenFileDarwin(t *testing.T) {
 	type scenario struct {
 		filename string
@@ -1,6 +1,8 @@
 package config
 
 import (
+	"crypto/sha256"
+	"encoding/hex"
 	"fmt"
 	"log"
 	"os"
@@ -440,6 +442,13 @@ func stateFilePath(filename string) (string, error) {
 	return xdg.StateFile(filepath.Join("lazygit", filename))
 }
 
+// CommandLogFilePath returns the path of the file in which we keep the command
+// log of the given repo
+func CommandLogFilePath(repoPath string) (string, error) {
+	hash := sha256.Sum256([]byte(repoPath))
+	return stateFilePath(filepath.Join("command_log", hex.EncodeToString(hash[:8])+".json"))
+}
+
 // SaveAppState marshalls the AppState struct and writes it to the disk
 func (c *AppConfig) SaveAppState() error {
 	marshalledAppState, err := yaml.Marshal(c.appState)
@@ -529,6 +538,7 @@ type AppState struct {
 	RenameSimilarityThreshold  int
 	LocalBranchSortOrder       string
 	RemoteBranchSortOrder      string
+	TagSortOrder               string
 
 	// One of: 'date-order' | 'author-date-order' | 'topo-order' | 'default'
 	// 'topo-order' makes it easier to read the git log graph, but commits may not
@@ -550,6 +560,7 @@ func getDefaultAppState() *AppState {
 		RenameSimilarityThreshold: 50,
 		LocalBranchSortOrder:      "recency",
 		RemoteBranchSortOrder:     "alphabetical",
+		TagSortOrder:              "date",
 		GitLogOrder:               "", // should be "topo-order" eventually
 		GitLogShowGraph:           "", // should be "always" eventually
 	}
@@ -28,7 +28,7 @@ type UserConfig struct {
 	// See https://github.com/jesseduffield/lazygit/blob/master/docs/Config.md#custom-pull-request-urls
 	Services map[string]string `yaml:"services"`
 	// What to do when opening Lazygit outside of a git repo.
-	// - 'prompt': (default) ask whether to initialize a new repo or open in the most recent repo
+	// - 'prompt': (default) ask whether to initialize a new repo, clone one, or open in the most recent repo
 	// - 'create': initialize a new repo
 	// - 'skip': open most recent repo
 	// - 'quit': exit Lazygit
@@ -43,9 +43,25 @@ type RefresherConfig struct {
 	// File/submodule refresh interval in seconds.
 	// Auto-refresh can be disabled via option 'git.autoRefresh'.
 	RefreshInterval int `yaml:"refreshInterval" jsonschema:"minimum=0"`
+	// If true, watch the repo for changes and refresh only what they affect,
+	// rather than refreshing files every refreshInterval seconds.
+	// This is only supported on Linux; elsewhere, or if the repo has more
+	// directories than the system lets us watch, we fall back to refreshing
+	// every refreshInterval seconds.
+	WatchFiles bool `yaml:"watchFiles"`
 	// Re-fetch interval in seconds.
 	// Auto-fetch can be disabled via option 'git.autoFetch'.
+	// When 'git.fetchAll' is true, each remote is fetched separately, and the
+	// fetches are spread out over the interval.
 	FetchInterval int `yaml:"fetchInterval" jsonschema:"minimum=0"`
+	// When a background fetch fails (e.g. because there's no network or the
+	// remote needs credentials), the interval for that remote is doubled after
+	// each consecutive failure, up to this many seconds.
+	FetchMaxBackoff int `yaml:"fetchMaxBackoff" jsonschema:"minimum=0"`
+	// If true, show a toast when a background fetch brings in new commits for
+	// the upstream of the checked-out branch, or finds that a remote branch
+	// tracked by one of your local branches was force-pushed.
+	NotifyOnFetch bool `yaml:"notifyOnFetch"`
 }
 
 type GuiConfig struct {
@@ -151,6 +167,11 @@ type GuiConfig struct {
 	// Whether to show the divergence from the base branch in the branches view.
 	// One of: 'none' | 'onlyArrow'  | 'arrowAndNumber'
 	ShowDivergenceFromBaseBranch string `yaml:"showDivergenceFromBaseBranch" jsonschema:"enum=none,enum=onlyArrow,enum=arrowAndNumber"`
+	// If true, show the number of changed files, the divergence from upstream
+	// and any in-progress rebase or merge for each worktree in the worktrees view.
+	// This runs a git status in every other worktree on each refresh, which can
+	// be slow if you have many large worktrees.
+	ShowWorktreeStatus bool `yaml:"showWorktreeStatus"`
 	// Height of the command log view
 	CommandLogSize int `yaml:"commandLogSize" jsonschema:"minimum=0"`
 	// Whether to split the main window when viewing file changes.
@@ -243,8 +264,17 @@ type GitConfig struct {
 	AutoFetch bool `yaml:"autoFetch"`
 	// If true, periodically refresh files and submodules
 	AutoRefresh bool `yaml:"autoRefresh"`
+	// If true, show the `git status` calls that refresh the files panel in the
+	// command log, along with how long they took. Useful for checking how much
+	// core.fsmonitor and core.untrackedCache (see the status panel) speed up a
+	// large repo.
+	LogStatusTiming bool `yaml:"logStatusTiming"`
 	// If true, pass the --all arg to git fetch
 	FetchAll bool `yaml:"fetchAll"`
+	// Maximum number of submodules to process at the same time when running
+	// bulk submodule operations in parallel. Steps that write to the config of
+	// the parent repo (init and sync) always run one at a time.
+	SubmoduleJobs int `yaml:"submoduleJobs" jsonschema:"minimum=1"`
 	// If true, lazygit will automatically stage files that used to have merge
 	// conflicts but no longer do; and it will also ask you if you want to
 	// continue a merge or rebase if you've resolved all conflicts. If false, it
@@ -261,6 +291,39 @@ type GitConfig struct {
 	OverrideGpg bool `yaml:"overrideGpg"`
 	// If true, do not allow force pushes
 	DisableForcePushing bool `yaml:"disableForcePushing"`
+	// Branches that lazygit protects from force pushing, resetting, rebasing,
+	// committing directly and deleting. Usually set in a repo-specific config
+	// file.
+	// See https://github.com/jesseduffield/lazygit/blob/master/docs/Config.md#protected-branches
+	ProtectedBranches ProtectedBranchesConfig `yaml:"protectedBranches"`
+	// Local branches whose last commit is older than this many days are
+	// offered for deletion by the stale branch cleanup. 0 means that branches
+	// are never considered stale because of their age.
+	StaleBranchAgeDays int `yaml:"staleBranchAgeDays" jsonschema:"minimum=0"`
+	// Push options (passed with `git push --push-option`) to offer in the push
+	// options menu, keyed by remote name. E.g. `origin: [merge_request.create]`
+	// makes GitLab create a merge request when pushing to origin.
+	PushOptions map[string][]string `yaml:"pushOptions"`
+	// Options to use when fetching a single remote, keyed by remote name.
+	// They also serve as the starting point for the fetch options menu in
+	// the remotes panel.
+	RemoteFetch map[string]RemoteFetchConfig `yaml:"remoteFetch"`
+	// If true, git and ssh ask lazygit for credentials directly (by setting
+	// GIT_ASKPASS and SSH_ASKPASS to lazygit), so credential prompts are
+	// recognised even when they are localised or come from a custom credential
+	// helper. Has no effect if you have set GIT_ASKPASS, SSH_ASKPASS or
+	// core.askPass yourself. Prompts that don't go through askpass are still
+	// detected in the output of the command.
+	UseAskpass bool `yaml:"useAskpass"`
+	// Additional patterns for detecting credential prompts in the output of
+	// commands, on top of the built-in ones for git's and ssh's English prompts.
+	CredentialPrompts []CredentialPromptConfig `yaml:"credentialPrompts"`
+	// If true, the credentials you enter when a command asks for them are
+	// remembered (in memory only, never on disk) for the rest of the session,
+	// keyed by host, and are used to answer the same prompt again. A cached
+	// credential is forgotten as soon as authentication with it fails. Useful
+	// if you don't have a git credential helper set up.
+	CacheCredentials bool `yaml:"cacheCredentials"`
 	// See https://github.com/jesseduffield/lazygit/blob/master/docs/Config.md#predefined-commit-message-prefix
 	CommitPrefix []CommitPrefixConfig `yaml:"commitPrefix"`
 	// See https://github.com/jesseduffield/lazygit/blob/master/docs/Config.md#predefined-commit-message-prefix
@@ -334,6 +397,57 @@ type LogConfig struct {
 	ShowGraph string `yaml:"showGraph" jsonschema:"deprecated,enum=always,enum=never,enum=when-maximised"`
 	// displays the whole git graph by default in the commits view (equivalent to passing the `--all` argument to `git log`)
 	ShowWholeGraph bool `yaml:"showWholeGraph"`
+	// If true, show whether each commit's signature is good, bad or unverified in the commits panel,
+	// and show the signature details in the main view. This requires git to verify every signature,
+	// which may be slow for large repos.
+	ShowSignatureStatus bool `yaml:"showSignatureStatus"`
+}
+
+type RemoteFetchConfig struct {
+	// If true, pass --prune to delete remote-tracking branches that no longer
+	// exist on the remote
+	Prune bool `yaml:"prune"`
+	// If true, pass --prune-tags to also delete local tags that no longer exist
+	// on the remote. Only has an effect together with 'prune'.
+	PruneTags bool `yaml:"pruneTags"`
+	// One of: '' (git's default of fetching the tags that point into the fetched
+	// history) | 'all' (--tags) | 'none' (--no-tags)
+	Tags string `yaml:"tags" jsonschema:"enum=,enum=all,enum=none"`
+	// If greater than 0, only fetch this many commits of history (--depth)
+	Depth int `yaml:"depth" jsonschema:"minimum=0"`
+	// Refspecs to fetch instead of the ones configured for the remote
+	Refspecs []string `yaml:"refspecs"`
+}
+
+type ProtectedBranchesConfig struct {
+	// Glob patterns matching the names of the protected branches, e.g.
+	// 'main' or 'release/*'. For remote branches the name without the remote
+	// is matched.
+	Patterns []string `yaml:"patterns"`
+	// What to do when force pushing to a protected branch. One of 'block',
+	// 'confirm' (ask you to type the name of the branch) or 'allow'.
+	ForcePush string `yaml:"forcePush" jsonschema:"enum=block,enum=confirm,enum=allow"`
+	// What to do when resetting a protected branch that is checked out. One
+	// of 'block', 'confirm' or 'allow'.
+	Reset string `yaml:"reset" jsonschema:"enum=block,enum=confirm,enum=allow"`
+	// What to do when rebasing a protected branch, or otherwise rewriting
+	// its commits (e.g. squashing, rewording or amending). One of 'block',
+	// 'confirm' or 'allow'.
+	Rebase string `yaml:"rebase" jsonschema:"enum=block,enum=confirm,enum=allow"`
+	// What to do when committing directly to a protected branch (including
+	// reverting and cherry-picking). One of 'block', 'confirm' or 'allow'.
+	Commit string `yaml:"commit" jsonschema:"enum=block,enum=confirm,enum=allow"`
+	// What to do when deleting a protected branch, locally or on a remote.
+	// One of 'block', 'confirm' or 'allow'.
+	Delete string `yaml:"delete" jsonschema:"enum=block,enum=confirm,enum=allow"`
+}
+
+type CredentialPromptConfig struct {
+	// Regular expression matching the prompt, e.g. "Benutzername für '.+':"
+	Pattern string `yaml:"pattern"`
+	// The kind of credential that is asked for, which determines whether the
+	// input is masked
+	Type string `yaml:"type" jsonschema:"enum=username,enum=password,enum=passphrase,enum=pin,enum=token"`
 }
 
 type CommitPrefixConfig struct {
@@ -363,6 +477,7 @@ type KeybindingConfig struct {
 	Main           KeybindingMainConfig           `yaml:"main"`
 	Submodules     KeybindingSubmodulesConfig     `yaml:"submodules"`
 	CommitMessage  KeybindingCommitMessageConfig  `yaml:"commitMessage"`
+	CommandLog     KeybindingCommandLogConfig     `yaml:"commandLog"`
 }
 
 // damn looks like we have some inconsistencies here with -alt and -alt1
@@ -415,6 +530,7 @@ type KeybindingUniversalConfig struct {
 	CreateRebaseOptionsMenu           string   `yaml:"createRebaseOptionsMenu"`
 	Push                              string   `yaml:"pushFiles"` // 'Files' appended for legacy reasons
 	Pull                              string   `yaml:"pullFiles"` // 'Files' appended for legacy reasons
+	ViewPushOptions                   string   `yaml:"viewPushOptions"`
 	Refresh                           string   `yaml:"refresh"`
 	CreatePatchOptionsMenu            string   `yaml:"createPatchOptionsMenu"`
 	NextTab                           string   `yaml:"nextTab"`
@@ -436,12 +552,15 @@ type KeybindingUniversalConfig struct {
 	IncreaseRenameSimilarityThreshold string   `yaml:"increaseRenameSimilarityThreshold"`
 	DecreaseRenameSimilarityThreshold string   `yaml:"decreaseRenameSimilarityThreshold"`
 	OpenDiffTool                      string   `yaml:"openDiffTool"`
+	ClearCredentialCache              string   `yaml:"clearCredentialCache"`
 }
 
 type KeybindingStatusConfig struct {
 	CheckForUpdate      string `yaml:"checkForUpdate"`
 	RecentRepos         string `yaml:"recentRepos"`
 	AllBranchesLogGraph string `yaml:"allBranchesLogGraph"`
+	CloneRepository     string `yaml:"cloneRepository"`
+	PerformanceSettings string `yaml:"performanceSettings"`
 }
 
 type KeybindingFilesConfig struct {
@@ -478,10 +597,14 @@ type KeybindingBranchesConfig struct {
 	ViewGitFlowOptions     string `yaml:"viewGitFlowOptions"`
 	FastForward            string `yaml:"fastForward"`
 	CreateTag              string `yaml:"createTag"`
+	CreateSignedTag        string `yaml:"createSignedTag"`
+	CreateReleaseTag       string `yaml:"createReleaseTag"`
 	PushTag                string `yaml:"pushTag"`
 	SetUpstream            string `yaml:"setUpstream"`
 	FetchRemote            string `yaml:"fetchRemote"`
 	SortOrder              string `yaml:"sortOrder"`
+	CleanUpStaleBranches   string `yaml:"cleanUpStaleBranches"`
+	ViewFetchOptions       string `yaml:"viewFetchOptions"`
 }
 
 type KeybindingWorktreesConfig struct {
@@ -513,6 +636,7 @@ type KeybindingCommitsConfig struct {
 	OpenInBrowser                  string `yaml:"openInBrowser"`
 	ViewBisectOptions              string `yaml:"viewBisectOptions"`
 	StartInteractiveRebase         string `yaml:"startInteractiveRebase"`
+	GenerateChangelog              string `yaml:"generateChangelog"`
 }
 
 type KeybindingAmendAttributeConfig struct {
@@ -547,6 +671,11 @@ type KeybindingCommitMessageConfig struct {
 	CommitMenu string `yaml:"commitMenu"`
 }
 
+type KeybindingCommandLogConfig struct {
+	RerunCommand string `yaml:"rerunCommand"`
+	ExportScript string `yaml:"exportScript"`
+}
+
 // OSConfig contains config on the level of the os
 type OSConfig struct {
 	// Command for editing a file. Should contain "{{filename}}".
@@ -744,6 +873,7 @@ func GetDefaultConfig() *UserConfig {
 			CommitHashLength:             8,
 			ShowBranchCommitHash:         false,
 			ShowDivergenceFromBaseBranch: "none",
+			ShowWorktreeStatus:           false,
 			CommandLogSize:               8,
 			SplitDiff:                    "auto",
 			SkipRewordInEditorWarning:    false,
@@ -779,19 +909,36 @@ func GetDefaultConfig() *UserConfig {
 				SquashMergeMessage: "Squash merge {{selectedRef}} into {{currentBranch}}",
 			},
 			Log: LogConfig{
-				Order:          "topo-order",
-				ShowGraph:      "always",
-				ShowWholeGraph: false,
+				Order:               "topo-order",
+				ShowGraph:           "always",
+				ShowWholeGraph:      false,
+				ShowSignatureStatus: false,
+			},
+			ProtectedBranches: ProtectedBranchesConfig{
+				Patterns:  []string{},
+				ForcePush: "block",
+				Reset:     "confirm",
+				Rebase:    "confirm",
+				Commit:    "confirm",
+				Delete:    "block",
 			},
 			SkipHookPrefix:               "WIP",
 			MainBranches:                 []string{"master", "main"},
 			AutoFetch:                    true,
 			AutoRefresh:                  true,
+			LogStatusTiming:              false,
 			FetchAll:                     true,
+			SubmoduleJobs:                4,
 			AutoStageResolvedConflicts:   true,
 			BranchLogCmd:                 "git log --graph --color=always --abbrev-commit --decorate --date=relative --pretty=medium {{branchName}} --",
 			AllBranchesLogCmd:            "git log --graph --all --color=always --abbrev-commit --decorate --date=relative  --pretty=medium",
 			DisableForcePushing:          false,
+			StaleBranchAgeDays:           90,
+			PushOptions:                  map[string][]string(nil),
+			RemoteFetch:                  map[string]RemoteFetchConfig(nil),
+			UseAskpass:                   true,
+			CredentialPrompts:            []CredentialPromptConfig(nil),
+			CacheCredentials:             false,
 			CommitPrefixes:               map[string][]CommitPrefixConfig(nil),
 			BranchPrefix:                 "",
 			ParseEmoji:                   false,
@@ -799,7 +946,10 @@ func GetDefaultConfig() *UserConfig {
 		},
 		Refresher: RefresherConfig{
 			RefreshInterval: 10,
+			WatchFiles:      true,
 			FetchInterval:   60,
+			FetchMaxBackoff: 1800,
+			NotifyOnFetch:   true,
 		},
 		Update: UpdateConfig{
 			Method: "prompt",
@@ -864,6 +1014,7 @@ func GetDefaultConfig() *UserConfig {
 				CreateRebaseOptionsMenu:           "m",
 				Push:                              "P",
 				Pull:                              "p",
+				ViewPushOptions:                   "^",
 				Refresh:                           "R",
 				CreatePatchOptionsMenu:            "<c-p>",
 				NextTab:                           "]",
@@ -884,11 +1035,14 @@ func GetDefaultConfig() *UserConfig {
 				IncreaseRenameSimilarityThreshold: ")",
 				DecreaseRenameSimilarityThreshold: "(",
 				OpenDiffTool:                      "<c-t>",
+				ClearCredentialCache:              "<c-x>",
 			},
 			Status: KeybindingStatusConfig{
 				CheckForUpdate:      "u",
 				RecentRepos:         "<enter>",
 				AllBranchesLogGraph: "a",
+				CloneRepository:     "c",
+				PerformanceSettings: "f",
 			},
 			Files: KeybindingFilesConfig{
 				CommitChanges:            "c",
@@ -923,10 +1077,14 @@ func GetDefaultConfig() *UserConfig {
 				ViewGitFlowOptions:     "i",
 				FastForward:            "f",
 				CreateTag:              "T",
+				CreateSignedTag:        "S",
+				CreateReleaseTag:       "r",
 				PushTag:                "P",
 				SetUpstream:            "u",
 				FetchRemote:            "f",
 				SortOrder:              "s",
+				CleanUpStaleBranches:   "D",
+				ViewFetchOptions:       "<c-f>",
 			},
 			Worktrees: KeybindingWorktreesConfig{
 				ViewWorktreeOptions: "w",
@@ -956,6 +1114,7 @@ func GetDefaultConfig() *UserConfig {
 				OpenInBrowser:                  "o",
 				ViewBisectOptions:              "b",
 				StartInteractiveRebase:         "i",
+				GenerateChangelog:              "G",
 			},
 			AmendAttribute: KeybindingAmendAttributeConfig{
 				ResetAuthor: "a",
@@ -983,6 +1142,10 @@ func GetDefaultConfig() *UserConfig {
 			CommitMessage: KeybindingCommitMessageConfig{
 				CommitMenu: "<c-o>",
 			},
+			CommandLog: KeybindingCommandLogConfig{
+				RerunCommand: "r",
+				ExportScript: "e",
+			},
 		},
 	}
 }
@@ -2,6 +2,8 @@ package config
 
 import (
 	"fmt"
+	"path"
+	"regexp"
 	"slices"
 	"strings"
 )
@@ -15,6 +17,33 @@ func (config *UserConfig) Validate() error {
 		[]string{"none", "onlyArrow", "arrowAndNumber"}); err != nil {
 		return err
 	}
+	for _, prompt := range config.Git.CredentialPrompts {
+		if err := validateEnum("git.credentialPrompts.type", prompt.Type,
+			[]string{"username", "password", "passphrase", "pin", "token"}); err != nil {
+			return err
+		}
+		if _, err := regexp.Compile(prompt.Pattern); err != nil {
+			return fmt.Errorf("Invalid pattern '%s' in 'git.credentialPrompts': %w", prompt.Pattern, err)
+		}
+	}
+	protectedBranches := config.Git.ProtectedBranches
+	for _, policy := range []struct{ name, value string }{
+		{"forcePush", protectedBranches.ForcePush},
+		{"reset", protectedBranches.Reset},
+		{"rebase", protectedBranches.Rebase},
+		{"commit", protectedBranches.Commit},
+		{"delete", protectedBranches.Delete},
+	} {
+		if err := validateEnum("git.protectedBranches."+policy.name, policy.value,
+			[]string{"block", "confirm", "allow"}); err != nil {
+			return err
+		}
+	}
+	for _, pattern := range protectedBranches.Patterns {
+		if _, err := path.Match(pattern, ""); err != nil {
+			return fmt.Errorf("Invalid pattern '%s' in 'git.protectedBranches.patterns': %w", pattern, err)
+		}
+	}
 	return nil
 }
 
@@ -29,6 +29,66 @@ func TestUserConfigValidate_enums(t *testing.T) {
 				{value: "invalid_value", valid: false},
 			},
 		},
+		{
+			name: "Git.CredentialPrompts.Type",
+			setup: func(config *UserConfig, value string) {
+				config.Git.CredentialPrompts = []CredentialPromptConfig{{Pattern: "Kennwort:", Type: value}}
+			},
+			testCases: []testCase{
+				{value: "username", valid: true},
+				{value: "password", valid: true},
+				{value: "passphrase", valid: true},
+				{value: "pin", valid: true},
+				{value: "token", valid: true},
+				{value: "", valid: false},
+				{value: "invalid_value", valid: false},
+			},
+		},
+		{
+			name: "Git.CredentialPrompts.Pattern",
+			setup: func(config *UserConfig, value string) {
+				config.Git.CredentialPrompts = []CredentialPromptConfig{{Pattern: value, Type: "password"}}
+			},
+			testCases: []testCase{
+				{value: "Kennwort für '.+':", valid: true},
+				{value: "Kennwort für '(.+':", valid: false},
+			},
+		},
+		{
+			name: "Git.ProtectedBranches.ForcePush",
+			setup: func(config *UserConfig, value string) {
+				config.Git.ProtectedBranches.ForcePush = value
+			},
+			testCases: []testCase{
+				{value: "block", valid: true},
+				{value: "confirm", valid: true},
+				{value: "allow", valid: true},
+				{value: "", valid: false},
+				{value: "invalid_value", valid: false},
+			},
+		},
+		{
+			name: "Git.ProtectedBranches.Delete",
+			setup: func(config *UserConfig, value string) {
+				config.Git.ProtectedBranches.Delete = value
+			},
+			testCases: []testCase{
+				{value: "block", valid: true},
+				{value: "confirm", valid: true},
+				{value: "allow", valid: true},
+				{value: "", valid: false},
+			},
+		},
+		{
+			name: "Git.ProtectedBranches.Patterns",
+			setup: func(config *UserConfig, value string) {
+				config.Git.ProtectedBranches.Patterns = []string{value}
+			},
+			testCases: []testCase{
+				{value: "release/*", valid: true},
+				{value: "release/[", valid: false},
+			},
+		},
 	}
 
 	for _, s := range scenarios {
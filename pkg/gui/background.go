@@ -3,11 +3,17 @@ package gui
 import (
 	"fmt"
 	"runtime"
+	"strconv"
+	"strings"
+	"sync/atomic"
 	"time"
 
 	"github.com/jesseduffield/gocui"
+	"github.com/jesseduffield/lazygit/pkg/commands/models"
+	"github.com/jesseduffield/lazygit/pkg/gui/filewatcher"
 	"github.com/jesseduffield/lazygit/pkg/gui/types"
 	"github.com/jesseduffield/lazygit/pkg/utils"
+	"github.com/samber/lo"
 )
 
 type BackgroundRoutineMgr struct {
@@ -17,12 +23,30 @@ type BackgroundRoutineMgr struct {
 	// we typically want to pause some things that are running like background
 	// file refreshes
 	pauseBackgroundRefreshes bool
+
+	// set while the terminal window doesn't have focus (for terminals that
+	// report focus events); there's no point in fetching while the user isn't
+	// looking. Set on the UI thread and read by the background fetch.
+	terminalUnfocused atomic.Bool
+
+	// when to next fetch each remote, keyed by repo path and then by remote name
+	fetchSchedules map[string]map[string]*fetchSchedule
+}
+
+type fetchSchedule struct {
+	next time.Time
+	// the number of background fetches of this remote that failed in a row
+	failures int
 }
 
 func (self *BackgroundRoutineMgr) PauseBackgroundRefreshes(pause bool) {
 	self.pauseBackgroundRefreshes = pause
 }
 
+func (self *BackgroundRoutineMgr) SetTerminalFocused(focused bool) {
+	self.terminalUnfocused.Store(!focused)
+}
+
 func (self *BackgroundRoutineMgr) startBackgroundRoutines() {
 	userConfig := self.gui.UserConfig()
 
@@ -75,28 +99,201 @@ func (self *BackgroundRoutineMgr) startBackgroundRoutines() {
 func (self *BackgroundRoutineMgr) startBackgroundFetch() {
 	self.gui.waitForIntro.Wait()
 
-	fetch := func() error {
-		return self.gui.helpers.AppStatus.WithWaitingStatusImpl(self.gui.Tr.FetchingStatus, func(gocui.Task) error {
-			return self.backgroundFetch()
-		}, nil)
+	self.fetchSchedules = map[string]map[string]*fetchSchedule{}
+
+	// Rather than fetching on a fixed interval, we check every second which
+	// remotes are due. This lets us spread out the fetches of the different
+	// remotes, back off for remotes that keep failing, and catch up quickly
+	// after having been paused. The first check happens right away so that
+	// we get an immediate fetch at startup.
+	ticker := time.NewTicker(time.Second)
+	defer ticker.Stop()
+	for {
+		self.fetchDueRemotes()
+
+		select {
+		case <-ticker.C:
+		case <-self.gui.stopChan:
+			return
+		}
+	}
+}
+
+func (self *BackgroundRoutineMgr) fetchDueRemotes() {
+	if self.pauseBackgroundRefreshes || self.terminalUnfocused.Load() {
+		return
 	}
 
-	// We want an immediate fetch at startup, and since goEvery starts by
-	// waiting for the interval, we need to trigger one manually first
-	_ = fetch()
+	repoPath := self.gui.git.RepoPaths.RepoPath()
+	schedules, ok := self.fetchSchedules[repoPath]
+	if !ok {
+		schedules = map[string]*fetchSchedule{}
+		self.fetchSchedules[repoPath] = schedules
+	}
 
-	userConfig := self.gui.UserConfig()
-	self.goEvery(time.Second*time.Duration(userConfig.Refresher.FetchInterval), self.gui.stopChan, fetch)
+	remoteNames := self.remotesToFetch()
+	now := time.Now()
+	for i, remoteName := range remoteNames {
+		if _, ok := schedules[remoteName]; !ok {
+			stagger := self.fetchInterval() * time.Duration(i) / time.Duration(len(remoteNames))
+			schedules[remoteName] = &fetchSchedule{next: now.Add(stagger)}
+		}
+	}
+
+	for _, remoteName := range remoteNames {
+		schedule := schedules[remoteName]
+		if time.Now().Before(schedule.next) {
+			continue
+		}
+
+		done := make(chan error)
+		self.gui.c.OnWorker(func(gocui.Task) error {
+			done <- self.gui.helpers.AppStatus.WithWaitingStatusImpl(self.gui.Tr.FetchingStatus, func(gocui.Task) error {
+				return self.backgroundFetch(remoteName)
+			}, nil)
+			return nil
+		})
+		// waiting so that we only ever run one background fetch at a time
+		err := <-done
+
+		self.scheduleNextFetch(remoteName, schedule, err)
+	}
+}
+
+// With git.fetchAll we fetch each remote separately, starting with the one
+// that the checked-out branch tracks. Otherwise we leave it to git to pick the
+// remote, which we represent by an empty remote name.
+func (self *BackgroundRoutineMgr) remotesToFetch() []string {
+	if !self.gui.UserConfig().Git.FetchAll {
+		return []string{""}
+	}
+
+	currentRemoteName := ""
+	if branches := self.gui.c.Model().Branches; len(branches) > 0 {
+		currentRemoteName = branches[0].UpstreamRemote
+	}
+
+	remoteNames := lo.Map(self.gui.c.Model().Remotes, func(remote *models.Remote, _ int) string {
+		return remote.Name
+	})
+	isCurrentRemote := func(remoteName string, _ int) bool {
+		return remoteName == currentRemoteName
+	}
+	return append(lo.Filter(remoteNames, isCurrentRemote), lo.Reject(remoteNames, isCurrentRemote)...)
+}
+
+func (self *BackgroundRoutineMgr) scheduleNextFetch(remoteName string, schedule *fetchSchedule, err error) {
+	interval := self.fetchInterval()
+	if err == nil {
+		schedule.failures = 0
+		schedule.next = time.Now().Add(interval)
+		return
+	}
+
+	schedule.failures++
+	maxBackoff := time.Second * time.Duration(self.gui.UserConfig().Refresher.FetchMaxBackoff)
+	delay := fetchBackoff(interval, maxBackoff, schedule.failures)
+	schedule.next = time.Now().Add(delay)
+
+	self.gui.c.Log.Warnf("Background fetch of remote '%s' failed %d time(s) in a row, retrying in %s: %v",
+		remoteName, schedule.failures, delay, err)
+}
+
+// Returns how long to wait before fetching a remote again after the given
+// number of failed fetches in a row. We back off exponentially, so that we
+// don't keep hammering a remote that we can't reach or that wants credentials,
+// but never wait longer than maxBackoff (or the interval, if that's longer).
+func fetchBackoff(interval time.Duration, maxBackoff time.Duration, failures int) time.Duration {
+	maxBackoff = max(interval, maxBackoff)
+	delay := interval
+	for i := 0; i < failures && delay < maxBackoff; i++ {
+		delay *= 2
+	}
+	return min(delay, maxBackoff)
+}
+
+func (self *BackgroundRoutineMgr) fetchInterval() time.Duration {
+	return time.Second * time.Duration(self.gui.UserConfig().Refresher.FetchInterval)
 }
 
 func (self *BackgroundRoutineMgr) startBackgroundFilesRefresh(refreshInterval int) {
 	self.gui.waitForIntro.Wait()
 
+	if self.gui.UserConfig().Refresher.WatchFiles {
+		err := self.watchFiles()
+		if err == nil {
+			return
+		}
+
+		self.gui.c.Log.Warnf("Can't watch the repo for changes, falling back to refreshing every %d seconds: %v",
+			refreshInterval, err)
+	}
+
 	self.goEvery(time.Second*time.Duration(refreshInterval), self.gui.stopChan, func() error {
 		return self.gui.c.Refresh(types.RefreshOptions{Scope: []types.RefreshableView{types.FILES}})
 	})
 }
 
+// watchFiles refreshes whatever is affected by changes to the repo until we
+// quit. It returns an error if the repo can't be watched (any more), in which
+// case we need to poll instead.
+func (self *BackgroundRoutineMgr) watchFiles() error {
+	for {
+		repoPaths := self.gui.git.RepoPaths
+		listIgnored := func(dirs ...string) ([]string, error) {
+			return self.gui.git.WorkingTree.IgnoredPaths(repoPaths.WorktreePath(), dirs...)
+		}
+		watcher, err := filewatcher.New(filewatcher.Paths{
+			Worktree:       repoPaths.WorktreePath(),
+			WorktreeGitDir: repoPaths.WorktreeGitDirPath(),
+			RepoGitDir:     repoPaths.RepoGitDirPath(),
+		}, listIgnored, self.gui.c.Log)
+		if err != nil {
+			return err
+		}
+
+		stopped, err := self.refreshOnChanges(watcher, repoPaths.WorktreePath())
+		watcher.Close()
+		if stopped || err != nil {
+			return err
+		}
+	}
+}
+
+// refreshOnChanges returns when we quit (in which case stopped is true), when
+// the watcher fails, or when we switch to a different repo, which needs a
+// watcher of its own
+func (self *BackgroundRoutineMgr) refreshOnChanges(watcher *filewatcher.Watcher, worktreePath string) (stopped bool, err error) {
+	ticker := time.NewTicker(time.Second)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case scopes := <-watcher.Changes():
+			if self.pauseBackgroundRefreshes {
+				continue
+			}
+
+			done := make(chan struct{})
+			self.gui.c.OnWorker(func(gocui.Task) error {
+				_ = self.gui.c.Refresh(types.RefreshOptions{Scope: scopes})
+				done <- struct{}{}
+				return nil
+			})
+			// waiting so that we don't bunch up refreshes if the refresh takes longer than the debounce delay
+			<-done
+		case err := <-watcher.Errors():
+			return false, err
+		case <-ticker.C:
+			if self.gui.git.RepoPaths.WorktreePath() != worktreePath {
+				return false, nil
+			}
+		case <-self.gui.stopChan:
+			return true, nil
+		}
+	}
+}
+
 func (self *BackgroundRoutineMgr) goEvery(interval time.Duration, stop chan struct{}, function func() error) {
 	done := make(chan struct{})
 	go utils.Safe(func() {
@@ -122,10 +319,76 @@ func (self *BackgroundRoutineMgr) goEvery(interval time.Duration, stop chan stru
 	})
 }
 
-func (self *BackgroundRoutineMgr) backgroundFetch() (err error) {
-	err = self.gui.git.Sync.FetchBackground()
+func (self *BackgroundRoutineMgr) backgroundFetch(remoteName string) error {
+	notify := self.gui.UserConfig().Refresher.NotifyOnFetch
+
+	var hashesBefore map[string]string
+	if notify {
+		var err error
+		hashesBefore, err = self.gui.git.Sync.RemoteBranchHashes(remoteName)
+		if err != nil {
+			self.gui.c.Log.Error(err)
+			notify = false
+		}
+	}
+
+	var err error
+	if remoteName == "" {
+		err = self.gui.git.Sync.FetchBackground()
+	} else {
+		err = self.gui.git.Sync.FetchRemoteBackground(remoteName)
+	}
+
+	if err == nil && notify {
+		self.notifyAboutFetchedChanges(remoteName, hashesBefore)
+	}
 
 	_ = self.gui.c.Refresh(types.RefreshOptions{Scope: []types.RefreshableView{types.BRANCHES, types.COMMITS, types.REMOTES, types.TAGS}, Mode: types.ASYNC})
 
 	return err
 }
+
+// Shows a toast if the fetch brought in new commits for the upstream of the
+// checked-out branch, or if it turns out that the upstream of any of our
+// local branches was force-pushed
+func (self *BackgroundRoutineMgr) notifyAboutFetchedChanges(remoteName string, hashesBefore map[string]string) {
+	hashesAfter, err := self.gui.git.Sync.RemoteBranchHashes(remoteName)
+	if err != nil {
+		self.gui.c.Log.Error(err)
+		return
+	}
+
+	branches := self.gui.c.Model().Branches
+	upstreamRefNames := lo.Uniq(lo.FilterMap(branches, func(branch *models.Branch, _ int) (string, bool) {
+		refName := branch.FullUpstreamRefName()
+		return refName, refName != ""
+	}))
+	updates, err := self.gui.git.Sync.RemoteBranchUpdates(hashesBefore, hashesAfter, upstreamRefNames)
+	if err != nil {
+		self.gui.c.Log.Error(err)
+		return
+	}
+
+	currentUpstreamRefName := ""
+	if len(branches) > 0 && !branches[0].DetachedHead {
+		currentUpstreamRefName = branches[0].FullUpstreamRefName()
+	}
+
+	for _, update := range updates {
+		branchName := strings.TrimPrefix(update.RefName, "refs/remotes/")
+		if update.Forced {
+			self.gui.c.Toast(utils.ResolvePlaceholderString(self.gui.Tr.FetchedForcePushedBranch, map[string]string{
+				"branch": branchName,
+			}))
+		} else if update.RefName == currentUpstreamRefName {
+			message := self.gui.Tr.FetchedNewUpstreamCommits
+			if update.NewCommits == 1 {
+				message = self.gui.Tr.FetchedNewUpstreamCommit
+			}
+			self.gui.c.Toast(utils.ResolvePlaceholderString(message, map[string]string{
+				"branch": branchName,
+				"count":  strconv.Itoa(update.NewCommits),
+			}))
+		}
+	}
+}
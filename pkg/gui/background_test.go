@@ -0,0 +1,67 @@
+package gui
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFetchBackoff(t *testing.T) {
+	scenarios := []struct {
+		name       string
+		interval   time.Duration
+		maxBackoff time.Duration
+		failures   int
+		expected   time.Duration
+	}{
+		{
+			name:       "no failures",
+			interval:   time.Minute,
+			maxBackoff: time.Hour,
+			failures:   0,
+			expected:   time.Minute,
+		},
+		{
+			name:       "one failure",
+			interval:   time.Minute,
+			maxBackoff: time.Hour,
+			failures:   1,
+			expected:   2 * time.Minute,
+		},
+		{
+			name:       "doubles with each failure",
+			interval:   time.Minute,
+			maxBackoff: time.Hour,
+			failures:   4,
+			expected:   16 * time.Minute,
+		},
+		{
+			name:       "capped at the max backoff",
+			interval:   time.Minute,
+			maxBackoff: time.Hour,
+			failures:   6,
+			expected:   time.Hour,
+		},
+		{
+			name:       "many failures don't overflow",
+			interval:   time.Minute,
+			maxBackoff: time.Hour,
+			failures:   1000,
+			expected:   time.Hour,
+		},
+		{
+			name:       "max backoff shorter than the interval",
+			interval:   time.Hour,
+			maxBackoff: time.Minute,
+			failures:   3,
+			expected:   time.Hour,
+		},
+	}
+
+	for _, s := range scenarios {
+		t.Run(s.name, func(t *testing.T) {
+			assert.Equal(t, s.expected, fetchBackoff(s.interval, s.maxBackoff, s.failures))
+		})
+	}
+}
@@ -1,14 +1,21 @@
 package gui
 
 import (
+	"encoding/json"
 	"fmt"
 	"math/rand"
+	"os"
+	"slices"
 	"strings"
 	"time"
+	"unicode/utf8"
 
+	"github.com/jesseduffield/lazygit/pkg/commands/oscommands"
+	"github.com/jesseduffield/lazygit/pkg/config"
 	"github.com/jesseduffield/lazygit/pkg/constants"
 	"github.com/jesseduffield/lazygit/pkg/gui/keybindings"
 	"github.com/jesseduffield/lazygit/pkg/gui/style"
+	"github.com/jesseduffield/lazygit/pkg/gui/types"
 	"github.com/jesseduffield/lazygit/pkg/theme"
 )
 
@@ -32,11 +39,21 @@ func (gui *Gui) LogAction(action string) {
 
 	gui.GuiLog = append(gui.GuiLog, action)
 	fmt.Fprint(gui.Views.Extras, "\n"+style.FgYellow.Sprint(action))
+
+	gui.Mutexes.CommandLogMutex.Lock()
+	gui.commandLogAction = action
+	gui.Mutexes.CommandLogMutex.Unlock()
 }
 
 func (gui *Gui) LogCommand(cmdStr string, commandLine bool) {
+	gui.logCommand(cmdStr, commandLine)
+}
+
+// Like LogCommand, but returns the ID of the command log entry (see
+// CommandLogEntry.RunID), or 0 if we didn't add one
+func (gui *Gui) logCommand(cmdStr string, commandLine bool) int {
 	if gui.Views.Extras == nil {
-		return
+		return 0
 	}
 
 	gui.Views.Extras.Autoscroll = true
@@ -50,6 +67,152 @@ func (gui *Gui) LogCommand(cmdStr string, commandLine bool) {
 	gui.GuiLog = append(gui.GuiLog, cmdStr)
 	indentedCmdStr := "  " + strings.Replace(cmdStr, "\n", "\n  ", -1)
 	fmt.Fprint(gui.Views.Extras, "\n"+textStyle.Sprint(indentedCmdStr))
+
+	return gui.addCommandLogEntry(cmdStr, commandLine)
+}
+
+const (
+	// The number of entries of the structured command log that we keep per repo
+	maxCommandLogEntries = 500
+	// The amount of output per entry that we keep; we keep the end of the
+	// output because that's where errors are
+	maxCommandLogOutputLength = 10000
+)
+
+func (gui *Gui) addCommandLogEntry(cmdStr string, commandLine bool) int {
+	if gui.State == nil {
+		return 0
+	}
+
+	gui.Mutexes.CommandLogMutex.Lock()
+	gui.lastCommandLogRunID++
+	entry := &types.CommandLogEntry{
+		RunID:         gui.lastCommandLogRunID,
+		Action:        gui.commandLogAction,
+		Command:       cmdStr,
+		IsCommandLine: commandLine,
+		Time:          time.Now(),
+		// things that aren't commands are done as soon as they are logged
+		Finished: !commandLine,
+	}
+	entries := append([]*types.CommandLogEntry{entry}, gui.State.Model.CommandLogEntries...)
+	gui.State.Model.CommandLogEntries = entries[:min(len(entries), maxCommandLogEntries)]
+	gui.Mutexes.CommandLogMutex.Unlock()
+
+	if !commandLine {
+		gui.saveCommandLog()
+	}
+	gui.renderCommandLogEntries()
+
+	return entry.RunID
+}
+
+// LogCommandResult records how a command that we logged with LogCommand went
+func (gui *Gui) LogCommandResult(result oscommands.CommandResult) {
+	if gui.State == nil {
+		return
+	}
+
+	gui.Mutexes.CommandLogMutex.Lock()
+	entries := gui.State.Model.CommandLogEntries
+	index := slices.IndexFunc(entries, func(entry *types.CommandLogEntry) bool {
+		return result.LogID != 0 && entry.RunID == result.LogID && !entry.Finished
+	})
+	if index != -1 {
+		// replacing the entry and the slice rather than updating them in place,
+		// because they might be being rendered right now
+		entry := *entries[index]
+		entry.Finished = true
+		entry.Args = result.Args
+		entry.ExitCode = result.ExitCode
+		entry.Duration = result.Duration
+		entry.Output = cleanCommandOutput(result.Output)
+		entry.UsedDaemon = result.UsedDaemon
+		entries = slices.Clone(entries)
+		entries[index] = &entry
+		gui.State.Model.CommandLogEntries = entries
+	}
+	gui.Mutexes.CommandLogMutex.Unlock()
+
+	if index == -1 {
+		return
+	}
+
+	gui.saveCommandLog()
+	gui.renderCommandLogEntries()
+}
+
+func (gui *Gui) renderCommandLogEntries() {
+	gui.c.OnUIThread(func() error {
+		gui.c.PostRefreshUpdate(gui.State.Contexts.CommandLogEntries)
+		return nil
+	})
+}
+
+// Removes progress updates (lines overwritten using carriage returns) and
+// truncates the output to maxCommandLogOutputLength
+func cleanCommandOutput(output string) string {
+	lines := strings.Split(strings.ReplaceAll(output, "\r\n", "\n"), "\n")
+	for i, line := range lines {
+		if idx := strings.LastIndex(strings.TrimRight(line, "\r"), "\r"); idx != -1 {
+			lines[i] = line[idx+1:]
+		}
+		lines[i] = strings.TrimRight(lines[i], "\r")
+	}
+	output = strings.TrimRight(strings.Join(lines, "\n"), "\n")
+
+	if len(output) > maxCommandLogOutputLength {
+		start := len(output) - maxCommandLogOutputLength
+		for start < len(output) && !utf8.RuneStart(output[start]) {
+			start++
+		}
+		output = "…" + output[start:]
+	}
+
+	return output
+}
+
+func (gui *Gui) loadCommandLog(repoPath string) []*types.CommandLogEntry {
+	path, err := config.CommandLogFilePath(repoPath)
+	if err != nil {
+		gui.c.Log.Error(err)
+		return nil
+	}
+
+	// we might be switching back to a repo whose log hasn't been written yet
+	gui.commandLogWriter.flush()
+
+	content, err := os.ReadFile(path)
+	if err != nil {
+		if !os.IsNotExist(err) {
+			gui.c.Log.Error(err)
+		}
+		return nil
+	}
+
+	var entries []*types.CommandLogEntry
+	if err := json.Unmarshal(content, &entries); err != nil {
+		gui.c.Log.Error(err)
+		return nil
+	}
+
+	return entries
+}
+
+func (gui *Gui) saveCommandLog() {
+	path, err := config.CommandLogFilePath(gui.git.RepoPaths.WorktreePath())
+	if err != nil {
+		gui.c.Log.Error(err)
+		return
+	}
+
+	gui.Mutexes.CommandLogMutex.Lock()
+	// entries are replaced rather than modified, so a copy of the slice is
+	// enough for writing it in the background
+	entries := slices.Clone(gui.State.Model.CommandLogEntries)
+	gui.Mutexes.CommandLogMutex.Unlock()
+
+	gui.commandLogWriter.save(path, entries)
 }
 
 func (gui *Gui) printCommandLogHeader() {
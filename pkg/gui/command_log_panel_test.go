@@ -0,0 +1,53 @@
+package gui
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCleanCommandOutput(t *testing.T) {
+	scenarios := []struct {
+		name     string
+		output   string
+		expected string
+	}{
+		{
+			name:     "empty",
+			output:   "",
+			expected: "",
+		},
+		{
+			name:     "trailing newlines are removed",
+			output:   "Already up to date.\n\n",
+			expected: "Already up to date.",
+		},
+		{
+			name:     "progress updates are collapsed",
+			output:   "Counting objects:  50% (1/2)\rCounting objects: 100% (2/2)\rCounting objects: 100% (2/2), done.\nTotal 2\n",
+			expected: "Counting objects: 100% (2/2), done.\nTotal 2",
+		},
+		{
+			name:     "windows line endings",
+			output:   "one\r\ntwo\r\n",
+			expected: "one\ntwo",
+		},
+	}
+
+	for _, s := range scenarios {
+		t.Run(s.name, func(t *testing.T) {
+			assert.Equal(t, s.expected, cleanCommandOutput(s.output))
+		})
+	}
+}
+
+func TestCleanCommandOutputTruncatesFromTheStart(t *testing.T) {
+	output := strings.Repeat("ü", maxCommandLogOutputLength) + "error: the end"
+
+	result := cleanCommandOutput(output)
+
+	assert.True(t, strings.HasPrefix(result, "…ü"))
+	assert.True(t, strings.HasSuffix(result, "error: the end"))
+	assert.LessOrEqual(t, len(result), maxCommandLogOutputLength+len("…"))
+}
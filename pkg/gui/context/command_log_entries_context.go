@@ -0,0 +1,101 @@
+package context
+
+import (
+	"strings"
+	"time"
+
+	"github.com/jesseduffield/generics/set"
+	"github.com/jesseduffield/lazygit/pkg/gui/presentation"
+	"github.com/jesseduffield/lazygit/pkg/gui/types"
+)
+
+type CommandLogEntriesContext struct {
+	*FilteredListViewModel[*types.CommandLogEntry]
+	*ListContextTrait
+
+	// the IDs of the entries whose output is shown below them
+	expandedEntryIDs *set.Set[string]
+}
+
+var _ types.IListContext = (*CommandLogEntriesContext)(nil)
+
+func NewCommandLogEntriesContext(c *ContextCommon) *CommandLogEntriesContext {
+	viewModel := NewFilteredListViewModel(
+		func() []*types.CommandLogEntry {
+			// entries are logged from worker goroutines; the slice is replaced
+			// rather than modified, so we only need the lock to read the field
+			c.Mutexes().CommandLogMutex.Lock()
+			defer c.Mutexes().CommandLogMutex.Unlock()
+
+			return c.Model().CommandLogEntries
+		},
+		func(entry *types.CommandLogEntry) []string {
+			return []string{entry.Action, entry.Command, entry.Output}
+		},
+	)
+
+	expandedEntryIDs := set.New[string]()
+
+	getDisplayStrings := func(startIdx int, endIdx int) [][]string {
+		return presentation.GetCommandLogEntryListDisplayStrings(
+			viewModel.GetItems()[startIdx:endIdx],
+			time.Now(),
+			c.UserConfig().Gui.TimeFormat,
+			c.UserConfig().Gui.ShortTimeFormat,
+		)
+	}
+
+	getNonModelItems := func() []*NonModelItem {
+		result := []*NonModelItem{}
+		for i, entry := range viewModel.GetItems() {
+			if !expandedEntryIDs.Includes(entry.ID()) {
+				continue
+			}
+
+			output := entry.Output
+			if output == "" {
+				output = c.Tr.CommandLogNoOutput
+			}
+			for _, line := range strings.Split(output, "\n") {
+				result = append(result, &NonModelItem{
+					Index:   i + 1,
+					Content: "    " + line,
+				})
+			}
+		}
+
+		return result
+	}
+
+	return &CommandLogEntriesContext{
+		FilteredListViewModel: viewModel,
+		ListContextTrait: &ListContextTrait{
+			Context: NewSimpleContext(NewBaseContext(NewBaseContextOpts{
+				View:       c.Views().CommandLogEntries,
+				WindowName: "extras",
+				Key:        COMMAND_LOG_ENTRIES_CONTEXT_KEY,
+				Kind:       types.EXTRAS_CONTEXT,
+				Focusable:  true,
+			})),
+			ListRenderer: ListRenderer{
+				list:              viewModel,
+				getDisplayStrings: getDisplayStrings,
+				getNonModelItems:  getNonModelItems,
+			},
+			c: c,
+		},
+		expandedEntryIDs: expandedEntryIDs,
+	}
+}
+
+func (self *CommandLogEntriesContext) IsExpanded(entry *types.CommandLogEntry) bool {
+	return self.expandedEntryIDs.Includes(entry.ID())
+}
+
+func (self *CommandLogEntriesContext) ToggleExpanded(entry *types.CommandLogEntry) {
+	if self.IsExpanded(entry) {
+		self.expandedEntryIDs.Remove(entry.ID())
+	} else {
+		self.expandedEntryIDs.Add(entry.ID())
+	}
+}
@@ -39,14 +39,15 @@ const (
 	STATUS_SPACER1_CONTEXT_KEY types.ContextKey = "statusSpacer1"
 	STATUS_SPACER2_CONTEXT_KEY types.ContextKey = "statusSpacer2"
 
-	MENU_CONTEXT_KEY               types.ContextKey = "menu"
-	CONFIRMATION_CONTEXT_KEY       types.ContextKey = "confirmation"
-	SEARCH_CONTEXT_KEY             types.ContextKey = "search"
-	COMMIT_MESSAGE_CONTEXT_KEY     types.ContextKey = "commitMessage"
-	COMMIT_DESCRIPTION_CONTEXT_KEY types.ContextKey = "commitDescription"
-	SUBMODULES_CONTEXT_KEY         types.ContextKey = "submodules"
-	SUGGESTIONS_CONTEXT_KEY        types.ContextKey = "suggestions"
-	COMMAND_LOG_CONTEXT_KEY        types.ContextKey = "cmdLog"
+	MENU_CONTEXT_KEY                types.ContextKey = "menu"
+	CONFIRMATION_CONTEXT_KEY        types.ContextKey = "confirmation"
+	SEARCH_CONTEXT_KEY              types.ContextKey = "search"
+	COMMIT_MESSAGE_CONTEXT_KEY      types.ContextKey = "commitMessage"
+	COMMIT_DESCRIPTION_CONTEXT_KEY  types.ContextKey = "commitDescription"
+	SUBMODULES_CONTEXT_KEY          types.ContextKey = "submodules"
+	SUGGESTIONS_CONTEXT_KEY         types.ContextKey = "suggestions"
+	COMMAND_LOG_CONTEXT_KEY         types.ContextKey = "cmdLog"
+	COMMAND_LOG_ENTRIES_CONTEXT_KEY types.ContextKey = "commandLogEntries"
 )
 
 var AllContextKeys = []types.ContextKey{
@@ -78,6 +79,7 @@ var AllContextKeys = []types.ContextKey{
 	SUBMODULES_CONTEXT_KEY,
 	SUGGESTIONS_CONTEXT_KEY,
 	COMMAND_LOG_CONTEXT_KEY,
+	COMMAND_LOG_ENTRIES_CONTEXT_KEY,
 }
 
 type ContextTree struct {
@@ -109,6 +111,7 @@ type ContextTree struct {
 	CommitMessage               *CommitMessageContext
 	CommitDescription           types.Context
 	CommandLog                  types.Context
+	CommandLogEntries           *CommandLogEntriesContext
 
 	// display contexts
 	AppStatus     types.Context
@@ -153,6 +156,7 @@ func (self *ContextTree) Flatten() []types.Context {
 		self.Normal,
 
 		self.Suggestions,
+		self.CommandLogEntries,
 		self.CommandLog,
 		self.AppStatus,
 		self.Options,
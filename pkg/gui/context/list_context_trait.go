@@ -60,10 +60,24 @@ func (self *ListContextTrait) FocusLine() {
 
 func (self *ListContextTrait) refreshViewport() {
 	startIdx, length := self.GetViewTrait().ViewPortYBounds()
+	if self.totalLength() <= length {
+		// see HandleRender
+		self.GetViewTrait().SetContent(self.renderLines(-1, -1))
+		return
+	}
 	content := self.renderLines(startIdx, startIdx+length)
 	self.GetViewTrait().SetViewPortContent(content)
 }
 
+// the number of lines of the view, including non-model items
+func (self *ListContextTrait) totalLength() int {
+	totalLength := self.list.Len()
+	if self.getNonModelItems != nil {
+		totalLength += len(self.getNonModelItems())
+	}
+	return totalLength
+}
+
 func (self *ListContextTrait) setFooter() {
 	self.GetViewTrait().SetFooter(formatListFooter(self.list.GetSelectedLineIdx(), self.list.Len()))
 }
@@ -93,15 +107,15 @@ func (self *ListContextTrait) HandleFocusLost(opts types.OnFocusLostOpts) {
 // OnFocus assumes that the content of the context has already been rendered to the view. OnRender is the function which actually renders the content to the view
 func (self *ListContextTrait) HandleRender() {
 	self.list.ClampSelection()
-	if self.renderOnlyVisibleLines {
+	totalLength := self.totalLength()
+	startIdx, length := self.GetViewTrait().ViewPortYBounds()
+	// When all lines fit in the view there's nothing to save, so we render
+	// them like for any other view; that also avoids padding each line to the
+	// width of the view, which overwriting the viewport does.
+	if self.renderOnlyVisibleLines && totalLength > length {
 		// Rendering only the visible area can save a lot of cell memory for
 		// those views that support it.
-		totalLength := self.list.Len()
-		if self.getNonModelItems != nil {
-			totalLength += len(self.getNonModelItems())
-		}
 		self.GetViewTrait().SetContentLineCount(totalLength)
-		startIdx, length := self.GetViewTrait().ViewPortYBounds()
 		content := self.renderLines(startIdx, startIdx+length)
 		self.GetViewTrait().SetViewPortContentAndClearEverythingElse(content)
 	} else {
@@ -120,6 +120,7 @@ func NewContextTree(c *ContextCommon) *ContextTree {
 				Focusable:  true,
 			}),
 		),
+		CommandLogEntries: NewCommandLogEntriesContext(c),
 		CommandLog: NewSimpleContext(
 			NewBaseContext(NewBaseContextOpts{
 				Kind:       types.EXTRAS_CONTEXT,
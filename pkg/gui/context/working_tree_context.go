@@ -1,6 +1,8 @@
 package context
 
 import (
+	"strings"
+
 	"github.com/jesseduffield/gocui"
 	"github.com/jesseduffield/lazygit/pkg/commands/models"
 	"github.com/jesseduffield/lazygit/pkg/gui/filetree"
@@ -28,10 +30,10 @@ func NewWorkingTreeContext(c *ContextCommon) *WorkingTreeContext {
 		c.UserConfig().Gui.ShowFileTree,
 	)
 
-	getDisplayStrings := func(_ int, _ int) [][]string {
+	getDisplayStrings := func(startIdx int, endIdx int) [][]string {
 		showFileIcons := icons.IsIconEnabled() && c.UserConfig().Gui.ShowFileIcons
 		showNumstat := c.UserConfig().Gui.ShowNumstatInFilesView
-		lines := presentation.RenderFileTree(viewModel, c.Model().Submodules, showFileIcons, showNumstat)
+		lines := presentation.RenderFileTree(viewModel, c.Model().Submodules, showFileIcons, showNumstat, startIdx, endIdx)
 		return lo.Map(lines, func(line string, _ int) []string {
 			return []string{line}
 		})
@@ -47,12 +49,19 @@ func NewWorkingTreeContext(c *ContextCommon) *WorkingTreeContext {
 				Key:        FILES_CONTEXT_KEY,
 				Kind:       types.SIDE_CONTEXT,
 				Focusable:  true,
+				// we only render the visible lines
+				NeedsRerenderOnHeightChange: true,
 			})),
 			ListRenderer: ListRenderer{
 				list:              viewModel,
 				getDisplayStrings: getDisplayStrings,
 			},
 			c: c,
+			// after switching branches there can be tens of thousands of files
+			renderOnlyVisibleLines: true,
+			// e.g. selecting a search result can scroll to lines we haven't
+			// rendered yet
+			refreshViewportOnChange: true,
 		},
 	}
 
@@ -62,5 +71,10 @@ func NewWorkingTreeContext(c *ContextCommon) *WorkingTreeContext {
 }
 
 func (self *WorkingTreeContext) ModelSearchResults(searchStr string, caseSensitive bool) []gocui.SearchPosition {
-	return nil
+	normalize := lo.Ternary(caseSensitive, func(s string) string { return s }, strings.ToLower)
+	return lo.FilterMap(presentation.FileTreeNames(self.FileTreeViewModel), func(name string, idx int) (gocui.SearchPosition, bool) {
+		// The names are never truncated, so the view will always find the search
+		// string in the line and doesn't need the X positions
+		return gocui.SearchPosition{Y: idx}, strings.Contains(normalize(name), searchStr)
+	})
 }
@@ -27,11 +27,13 @@ func (gui *Gui) resetHelpersAndControllers() {
 	helperCommon := gui.c
 	recordDirectoryHelper := helpers.NewRecordDirectoryHelper(helperCommon)
 	reposHelper := helpers.NewRecentReposHelper(helperCommon, recordDirectoryHelper, gui.onNewRepo)
-	refsHelper := helpers.NewRefsHelper(helperCommon)
+	lossPreviewHelper := helpers.NewLossPreviewHelper(helperCommon)
+	protectedBranchesHelper := helpers.NewProtectedBranchesHelper(helperCommon)
+	refsHelper := helpers.NewRefsHelper(helperCommon, lossPreviewHelper, protectedBranchesHelper)
 	suggestionsHelper := helpers.NewSuggestionsHelper(helperCommon)
 	worktreeHelper := helpers.NewWorktreeHelper(helperCommon, reposHelper, refsHelper, suggestionsHelper)
 
-	rebaseHelper := helpers.NewMergeAndRebaseHelper(helperCommon, refsHelper)
+	rebaseHelper := helpers.NewMergeAndRebaseHelper(helperCommon, refsHelper, protectedBranchesHelper)
 
 	setCommitSummary := gui.getCommitMessageSetTextareaTextFn(func() *gocui.View { return gui.Views.CommitMessage })
 	setCommitDescription := gui.getCommitMessageSetTextareaTextFn(func() *gocui.View { return gui.Views.CommitDescription })
@@ -91,24 +93,27 @@ func (gui *Gui) resetHelpersAndControllers() {
 		modeHelper,
 	)
 
+	hostHelper := helpers.NewHostHelper(helperCommon)
+
 	setSubCommits := func(commits []*models.Commit) {
 		gui.Mutexes.SubCommitsMutex.Lock()
 		defer gui.Mutexes.SubCommitsMutex.Unlock()
 
 		gui.State.Model.SubCommits = commits
 	}
+	branchesHelper := helpers.NewBranchesHelper(helperCommon, worktreeHelper, lossPreviewHelper, protectedBranchesHelper)
 	gui.helpers = &helpers.Helpers{
 		Refs:            refsHelper,
-		Host:            helpers.NewHostHelper(helperCommon),
+		Host:            hostHelper,
 		PatchBuilding:   patchBuildingHelper,
 		Staging:         stagingHelper,
 		Bisect:          bisectHelper,
 		Suggestions:     suggestionsHelper,
 		Files:           helpers.NewFilesHelper(helperCommon),
-		WorkingTree:     helpers.NewWorkingTreeHelper(helperCommon, refsHelper, commitsHelper, gpgHelper),
-		Tags:            helpers.NewTagsHelper(helperCommon, commitsHelper),
-		BranchesHelper:  helpers.NewBranchesHelper(helperCommon, worktreeHelper),
-		GPG:             helpers.NewGpgHelper(helperCommon),
+		WorkingTree:     helpers.NewWorkingTreeHelper(helperCommon, refsHelper, commitsHelper, gpgHelper, protectedBranchesHelper),
+		Tags:            helpers.NewTagsHelper(helperCommon, commitsHelper, gpgHelper),
+		BranchesHelper:  branchesHelper,
+		GPG:             gpgHelper,
 		MergeAndRebase:  rebaseHelper,
 		MergeConflicts:  mergeConflictsHelper,
 		CherryPick:      cherryPickHelper,
@@ -134,9 +139,15 @@ func (gui *Gui) resetHelpersAndControllers() {
 			modeHelper,
 			appStatusHelper,
 		),
-		Search:     searchHelper,
-		Worktree:   worktreeHelper,
-		SubCommits: helpers.NewSubCommitsHelper(helperCommon, refreshHelper, setSubCommits),
+		Search:            searchHelper,
+		Worktree:          worktreeHelper,
+		SubCommits:        helpers.NewSubCommitsHelper(helperCommon, refreshHelper, setSubCommits),
+		Changelog:         helpers.NewChangelogHelper(helperCommon, hostHelper, suggestionsHelper),
+		CommandLog:        helpers.NewCommandLogHelper(helperCommon, suggestionsHelper),
+		LossPreview:       lossPreviewHelper,
+		ProtectedBranches: protectedBranchesHelper,
+		Submodules:        helpers.NewSubmodulesHelper(helperCommon),
+		StaleBranches:     helpers.NewStaleBranchesHelper(helperCommon, branchesHelper, protectedBranchesHelper),
 	}
 
 	gui.CustomCommandsClient = custom_commands.NewClient(
@@ -195,6 +206,7 @@ func (gui *Gui) resetHelpersAndControllers() {
 	subCommitsController := controllers.NewSubCommitsController(common)
 	statusController := controllers.NewStatusController(common)
 	commandLogController := controllers.NewCommandLogController(common)
+	commandLogEntriesController := controllers.NewCommandLogEntriesController(common)
 	confirmationController := controllers.NewConfirmationController(common)
 	suggestionsController := controllers.NewSuggestionsController(common)
 	jumpToSideWindowController := controllers.NewJumpToSideWindowController(common, gui.handleNextTab)
@@ -219,6 +231,7 @@ func (gui *Gui) resetHelpersAndControllers() {
 		gui.State.Contexts.Branches,
 		gui.State.Contexts.RemoteBranches,
 		gui.State.Contexts.Tags,
+		gui.State.Contexts.Worktrees,
 	} {
 		controllers.AttachControllers(context, controllers.NewWorktreeOptionsController(common, context))
 	}
@@ -369,6 +382,10 @@ func (gui *Gui) resetHelpersAndControllers() {
 		commandLogController,
 	)
 
+	controllers.AttachControllers(gui.State.Contexts.CommandLogEntries,
+		commandLogEntriesController,
+	)
+
 	controllers.AttachControllers(gui.State.Contexts.Confirmation,
 		confirmationController,
 	)
@@ -70,6 +70,14 @@ func (self *BasicCommitsController) GetKeybindings(opts types.KeybindingsOpts) [
 			GetDisabledReason: self.require(self.singleItemSelected()),
 			Description:       self.c.Tr.OpenCommitInBrowser,
 		},
+		{
+			Key:               opts.GetKey(opts.Config.Commits.GenerateChangelog),
+			Handler:           self.withItem(self.generateChangelog),
+			GetDisabledReason: self.require(self.singleItemSelected()),
+			Description:       self.c.Tr.GenerateChangelog,
+			Tooltip:           self.c.Tr.GenerateChangelogTooltip,
+			OpensMenu:         true,
+		},
 		{
 			Key:               opts.GetKey(opts.Config.Universal.New),
 			Handler:           self.withItem(self.newBranch),
@@ -212,6 +220,10 @@ func (self *BasicCommitsController) copyCommitAttribute(commit *models.Commit) e
 	})
 }
 
+func (self *BasicCommitsController) generateChangelog(commit *models.Commit) error {
+	return self.c.Helpers().Changelog.OpenChangelogPrompt(commit.Hash, commit.ShortHash())
+}
+
 func (self *BasicCommitsController) copyCommitHashToClipboard(commit *models.Commit) error {
 	self.c.LogAction(self.c.Tr.Actions.CopyCommitHashToClipboard)
 	if err := self.c.OS().CopyToClipboard(commit.Hash); err != nil {
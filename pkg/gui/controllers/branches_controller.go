@@ -3,12 +3,15 @@ package controllers
 import (
 	"errors"
 	"fmt"
+	"strings"
+	"sync"
 
 	"github.com/jesseduffield/gocui"
 	"github.com/jesseduffield/lazygit/pkg/commands/git_commands"
 	"github.com/jesseduffield/lazygit/pkg/commands/models"
 	"github.com/jesseduffield/lazygit/pkg/gui/context"
 	"github.com/jesseduffield/lazygit/pkg/gui/controllers/helpers"
+	"github.com/jesseduffield/lazygit/pkg/gui/style"
 	"github.com/jesseduffield/lazygit/pkg/gui/types"
 	"github.com/jesseduffield/lazygit/pkg/utils"
 	"github.com/samber/lo"
@@ -118,8 +121,8 @@ func (self *BranchesController) GetKeybindings(opts types.KeybindingsOpts) []*ty
 		},
 		{
 			Key:               opts.GetKey(opts.Config.Branches.FastForward),
-			Handler:           self.withItem(self.fastForward),
-			GetDisabledReason: self.require(self.singleItemSelected(self.branchIsReal)),
+			Handler:           self.withItems(self.fastForwardBranches),
+			GetDisabledReason: self.require(self.itemRangeSelected(self.branchesAreReal)),
 			Description:       self.c.Tr.FastForward,
 			Tooltip:           self.c.Tr.FastForwardTooltip,
 		},
@@ -134,6 +137,13 @@ func (self *BranchesController) GetKeybindings(opts types.KeybindingsOpts) []*ty
 			Handler:     self.createSortMenu,
 			Description: self.c.Tr.SortOrder,
 		},
+		{
+			Key:         opts.GetKey(opts.Config.Branches.CleanUpStaleBranches),
+			Handler:     self.c.Helpers().StaleBranches.OpenCleanupMenu,
+			Description: self.c.Tr.CleanUpStaleBranches,
+			Tooltip:     self.c.Tr.CleanUpStaleBranchesTooltip,
+			OpensMenu:   true,
+		},
 		{
 			Key:               opts.GetKey(opts.Config.Commits.ViewResetOptions),
 			Handler:           self.withItem(self.createResetMenu),
@@ -142,6 +152,14 @@ func (self *BranchesController) GetKeybindings(opts types.KeybindingsOpts) []*ty
 			OpensMenu:         true,
 			DisplayOnScreen:   true,
 		},
+		{
+			Key:               opts.GetKey(opts.Config.Commits.GenerateChangelog),
+			Handler:           self.withItem(self.generateChangelog),
+			GetDisabledReason: self.require(self.singleItemSelected()),
+			Description:       self.c.Tr.GenerateChangelog,
+			Tooltip:           self.c.Tr.GenerateChangelogTooltip,
+			OpensMenu:         true,
+		},
 		{
 			Key:               opts.GetKey(opts.Config.Branches.RenameBranch),
 			Handler:           self.withItem(self.rename),
@@ -150,8 +168,8 @@ func (self *BranchesController) GetKeybindings(opts types.KeybindingsOpts) []*ty
 		},
 		{
 			Key:               opts.GetKey(opts.Config.Branches.SetUpstream),
-			Handler:           self.withItem(self.viewUpstreamOptions),
-			GetDisabledReason: self.require(self.singleItemSelected()),
+			Handler:           self.withItems(self.viewUpstreamOptionsForBranches),
+			GetDisabledReason: self.require(self.itemRangeSelected()),
 			Description:       self.c.Tr.ViewBranchUpstreamOptions,
 			Tooltip:           self.c.Tr.ViewBranchUpstreamOptionsTooltip,
 			ShortDescription:  self.c.Tr.Upstream,
@@ -611,7 +629,46 @@ func (self *BranchesController) rebase(branch *models.Branch) error {
 	return self.c.Helpers().MergeAndRebase.RebaseOntoRef(branch.Name)
 }
 
+func (self *BranchesController) fastForwardBranches(branches []*models.Branch) error {
+	if len(branches) == 1 {
+		return self.fastForward(branches[0])
+	}
+
+	return self.runOnBranches(self.c.Tr.Actions.FastForwardBranches, lo.Map(branches, func(branch *models.Branch, _ int) branchOperation {
+		return branchOperation{
+			branch:    branch,
+			operation: types.ItemOperationFastForwarding,
+			run: func(task gocui.Task) error {
+				if err := self.canFastForward(branch); err != nil {
+					return err
+				}
+				return self.fastForwardAux(task, branch)
+			},
+		}
+	}))
+}
+
 func (self *BranchesController) fastForward(branch *models.Branch) error {
+	if err := self.canFastForward(branch); err != nil {
+		return err
+	}
+
+	action := self.c.Tr.Actions.FastForwardBranch
+
+	return self.c.WithInlineStatus(branch, types.ItemOperationFastForwarding, context.LOCAL_BRANCHES_CONTEXT_KEY, func(task gocui.Task) error {
+		self.c.LogAction(action)
+
+		err := self.fastForwardAux(task, branch)
+		if _, ok := self.worktreeForBranch(branch); ok {
+			_ = self.c.Refresh(types.RefreshOptions{Mode: types.ASYNC})
+		} else {
+			_ = self.c.Refresh(types.RefreshOptions{Mode: types.ASYNC, Scope: []types.RefreshableView{types.BRANCHES}})
+		}
+		return err
+	})
+}
+
+func (self *BranchesController) canFastForward(branch *models.Branch) error {
 	if !branch.IsTrackingRemote() {
 		return errors.New(self.c.Tr.FwdNoUpstream)
 	}
@@ -622,43 +679,244 @@ func (self *BranchesController) fastForward(branch *models.Branch) error {
 		return errors.New(self.c.Tr.FwdCommitsToPush)
 	}
 
-	action := self.c.Tr.Actions.FastForwardBranch
+	return nil
+}
 
-	return self.c.WithInlineStatus(branch, types.ItemOperationFastForwarding, context.LOCAL_BRANCHES_CONTEXT_KEY, func(task gocui.Task) error {
-		worktree, ok := self.worktreeForBranch(branch)
-		if ok {
-			self.c.LogAction(action)
-
-			worktreeGitDir := ""
-			worktreePath := ""
-			// if it is the current worktree path, no need to specify the path
-			if !worktree.IsCurrent {
-				worktreeGitDir = worktree.GitDir
-				worktreePath = worktree.Path
-			}
+func (self *BranchesController) fastForwardAux(task gocui.Task, branch *models.Branch) error {
+	worktree, ok := self.worktreeForBranch(branch)
+	if !ok {
+		return self.c.Git().Sync.FastForward(
+			task, branch.Name, branch.UpstreamRemote, branch.UpstreamBranch,
+		)
+	}
+
+	worktreeGitDir := ""
+	worktreePath := ""
+	// if it is the current worktree path, no need to specify the path
+	if !worktree.IsCurrent {
+		worktreeGitDir = worktree.GitDir
+		worktreePath = worktree.Path
+	}
+
+	return self.c.Git().Sync.Pull(
+		task,
+		git_commands.PullOptions{
+			RemoteName:      branch.UpstreamRemote,
+			BranchName:      branch.UpstreamBranch,
+			FastForwardOnly: true,
+			WorktreeGitDir:  worktreeGitDir,
+			WorktreePath:    worktreePath,
+		},
+	)
+}
+
+func (self *BranchesController) viewUpstreamOptionsForBranches(branches []*models.Branch) error {
+	if len(branches) == 1 {
+		return self.viewUpstreamOptions(branches[0])
+	}
+
+	return self.c.Menu(types.CreateMenuOptions{
+		Title: utils.ResolvePlaceholderString(self.c.Tr.BranchesUpstreamOptionsTitle, map[string]string{
+			"count": fmt.Sprint(len(branches)),
+		}),
+		Items: []*types.MenuItem{
+			{
+				Label:   self.c.Tr.PushSelectedBranches,
+				Tooltip: self.c.Tr.PushSelectedBranchesTooltip,
+				OnPress: func() error { return self.pushBranches(branches) },
+				Key:     'P',
+			},
+			{
+				Label:   self.c.Tr.FastForwardSelectedBranches,
+				Tooltip: self.c.Tr.FastForwardBranchesTooltip,
+				OnPress: func() error { return self.fastForwardBranches(branches) },
+				Key:     'f',
+			},
+			{
+				Label:   self.c.Tr.SetUpstreamOfSelectedBranches,
+				Tooltip: self.c.Tr.SetUpstreamOfBranchesTooltip,
+				OnPress: func() error { return self.promptToSetUpstreamOfBranches(branches) },
+				Key:     's',
+			},
+		},
+	})
+}
 
-			err := self.c.Git().Sync.Pull(
-				task,
-				git_commands.PullOptions{
-					RemoteName:      branch.UpstreamRemote,
-					BranchName:      branch.UpstreamBranch,
-					FastForwardOnly: true,
-					WorktreeGitDir:  worktreeGitDir,
-					WorktreePath:    worktreePath,
+func (self *BranchesController) pushBranches(branches []*models.Branch) error {
+	return self.runOnBranches(self.c.Tr.Actions.PushBranches, lo.Map(branches, func(branch *models.Branch, _ int) branchOperation {
+		return branchOperation{
+			branch:    branch,
+			operation: types.ItemOperationPushing,
+			run: func(task gocui.Task) error {
+				if !branch.IsTrackingRemote() {
+					return errors.New(self.c.Tr.BranchHasNoUpstream)
+				}
+
+				return self.c.Git().Sync.Push(task, git_commands.PushOpts{
+					CurrentBranch:  branch.Name,
+					UpstreamRemote: branch.UpstreamRemote,
+					UpstreamBranch: branch.UpstreamBranch,
+				})
+			},
+		}
+	}))
+}
+
+func (self *BranchesController) promptToSetUpstreamOfBranches(branches []*models.Branch) error {
+	initialContent := ""
+	if len(self.c.Model().Remotes) > 0 {
+		initialContent = self.c.Model().Remotes[0].Name
+	}
+
+	self.c.Prompt(types.PromptOpts{
+		Title: utils.ResolvePlaceholderString(self.c.Tr.SetUpstreamOfBranchesPrompt, map[string]string{
+			"count": fmt.Sprint(len(branches)),
+		}),
+		InitialContent:      initialContent,
+		FindSuggestionsFunc: self.c.Helpers().Suggestions.GetRemoteSuggestionsFunc(),
+		HandleConfirm: func(remoteName string) error {
+			return self.setUpstreamOfBranches(branches, remoteName)
+		},
+	})
+
+	return nil
+}
+
+// Sets the upstream of each branch to the branch of the same name on the given
+// remote, pushing the ones that don't exist there yet
+func (self *BranchesController) setUpstreamOfBranches(branches []*models.Branch, remoteName string) error {
+	remote, ok := lo.Find(self.c.Model().Remotes, func(remote *models.Remote) bool {
+		return remote.Name == remoteName
+	})
+	if !ok {
+		return errors.New(utils.ResolvePlaceholderString(self.c.Tr.RemoteNotFound, map[string]string{
+			"remote": remoteName,
+		}))
+	}
+
+	// Setting an upstream writes to the repo's config, which git can't do from
+	// several processes at once, so while the pushes run concurrently we set
+	// the upstreams one at a time once each push is done
+	var configMutex sync.Mutex
+	setUpstream := func(branch *models.Branch) error {
+		configMutex.Lock()
+		defer configMutex.Unlock()
+
+		return self.c.Git().Branch.SetUpstream(remoteName, branch.Name, branch.Name)
+	}
+
+	return self.runOnBranches(self.c.Tr.Actions.SetUpstreamOfBranches, lo.Map(branches, func(branch *models.Branch, _ int) branchOperation {
+		existsOnRemote := lo.ContainsBy(remote.Branches, func(remoteBranch *models.RemoteBranch) bool {
+			return remoteBranch.Name == branch.Name
+		})
+		if existsOnRemote {
+			return branchOperation{
+				branch:    branch,
+				operation: types.ItemOperationNone,
+				run: func(gocui.Task) error {
+					return setUpstream(branch)
 				},
-			)
-			_ = self.c.Refresh(types.RefreshOptions{Mode: types.ASYNC})
-			return err
-		} else {
-			self.c.LogAction(action)
+			}
+		}
 
-			err := self.c.Git().Sync.FastForward(
-				task, branch.Name, branch.UpstreamRemote, branch.UpstreamBranch,
-			)
-			_ = self.c.Refresh(types.RefreshOptions{Mode: types.ASYNC, Scope: []types.RefreshableView{types.BRANCHES}})
+		return branchOperation{
+			branch:    branch,
+			operation: types.ItemOperationPushing,
+			run: func(task gocui.Task) error {
+				if err := self.c.Git().Sync.Push(task, git_commands.PushOpts{
+					CurrentBranch:  branch.Name,
+					UpstreamRemote: remoteName,
+					UpstreamBranch: branch.Name,
+				}); err != nil {
+					return err
+				}
+
+				return setUpstream(branch)
+			},
+		}
+	}))
+}
+
+type branchOperation struct {
+	branch    *models.Branch
+	operation types.ItemOperation
+	run       func(gocui.Task) error
+}
+
+// The number of branches we push or fast-forward at the same time. Each of
+// them is a network operation that may prompt for credentials, so we don't
+// want to start too many at once.
+const maxConcurrentBranchOperations = 4
+
+// Runs the operations concurrently, at most maxConcurrentBranchOperations at a
+// time, each showing an inline status next to its branch, and summarises the
+// results once all of them are done
+func (self *BranchesController) runOnBranches(action string, operations []branchOperation) error {
+	self.c.LogAction(action)
+
+	errs := make([]error, len(operations))
+	semaphore := make(chan struct{}, maxConcurrentBranchOperations)
+	wg := sync.WaitGroup{}
+	wg.Add(len(operations))
+	for i, operation := range operations {
+		_ = self.c.WithInlineStatus(operation.branch, operation.operation, context.LOCAL_BRANCHES_CONTEXT_KEY, func(task gocui.Task) error {
+			defer wg.Done()
+
+			semaphore <- struct{}{}
+			defer func() { <-semaphore }()
+
+			errs[i] = operation.run(task)
+			return nil
+		})
+	}
+
+	self.c.OnWorker(func(gocui.Task) error {
+		wg.Wait()
+
+		if err := self.c.Refresh(types.RefreshOptions{Mode: types.SYNC}); err != nil {
 			return err
 		}
+
+		self.c.OnUIThread(func() error {
+			self.showBranchOperationResults(action, operations, errs)
+			return nil
+		})
+		return nil
+	})
+
+	return nil
+}
+
+func (self *BranchesController) showBranchOperationResults(action string, operations []branchOperation, errs []error) {
+	failedCount := lo.CountBy(errs, func(err error) bool { return err != nil })
+	if failedCount == 0 {
+		self.c.Toast(utils.ResolvePlaceholderString(self.c.Tr.BranchOperationsSucceeded, map[string]string{
+			"action": action,
+			"count":  fmt.Sprint(len(operations)),
+		}))
+		return
+	}
+
+	lines := lo.Map(operations, func(operation branchOperation, i int) string {
+		if errs[i] == nil {
+			return style.FgGreen.Sprintf("✓ %s", operation.branch.Name)
+		}
+		firstLine, _, _ := strings.Cut(strings.TrimSpace(errs[i].Error()), "\n")
+		return style.FgRed.Sprintf("✗ %s", operation.branch.Name) + ": " + firstLine
 	})
+
+	self.c.Alert(
+		utils.ResolvePlaceholderString(self.c.Tr.BranchOperationsFailedTitle, map[string]string{
+			"action": action,
+			"failed": fmt.Sprint(failedCount),
+			"total":  fmt.Sprint(len(operations)),
+		}),
+		strings.Join(lines, "\n"),
+	)
+}
+
+func (self *BranchesController) generateChangelog(branch *models.Branch) error {
+	return self.c.Helpers().Changelog.OpenChangelogPrompt(branch.FullRefName(), branch.Name)
 }
 
 func (self *BranchesController) createTag(branch *models.Branch) error {
@@ -666,7 +924,7 @@ func (self *BranchesController) createTag(branch *models.Branch) error {
 }
 
 func (self *BranchesController) createSortMenu() error {
-	return self.c.Helpers().Refs.CreateSortOrderMenu([]string{"recency", "alphabetical", "date"}, func(sortOrder string) error {
+	return self.c.Helpers().Refs.CreateSortOrderMenu([]string{"recency", "alphabetical", "date"}, "committerdate", func(sortOrder string) error {
 		if self.c.GetAppState().LocalBranchSortOrder != sortOrder {
 			self.c.GetAppState().LocalBranchSortOrder = sortOrder
 			self.c.SaveAppStateAndLogError()
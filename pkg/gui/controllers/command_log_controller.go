@@ -26,6 +26,14 @@ func (self *CommandLogController) GetKeybindings(opts types.KeybindingsOpts) []*
 	return bindings
 }
 
+func (self *CommandLogController) GetOnFocus() func(types.OnFocusOpts) {
+	return func(types.OnFocusOpts) {
+		// we can also get here by switching tabs from the commands tab, so we
+		// can't rely on the parent context having been set before pushing
+		self.context().SetParentContext(self.c.Context().CurrentSide())
+	}
+}
+
 func (self *CommandLogController) GetOnFocusLost() func(types.OnFocusLostOpts) {
 	return func(types.OnFocusLostOpts) {
 		self.c.Views().Extras.Autoscroll = true
@@ -0,0 +1,282 @@
+package controllers
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/jesseduffield/lazygit/pkg/gui/context"
+	"github.com/jesseduffield/lazygit/pkg/gui/controllers/helpers"
+	"github.com/jesseduffield/lazygit/pkg/gui/presentation"
+	"github.com/jesseduffield/lazygit/pkg/gui/style"
+	"github.com/jesseduffield/lazygit/pkg/gui/types"
+	"github.com/jesseduffield/lazygit/pkg/utils"
+	"github.com/samber/lo"
+)
+
+type CommandLogEntriesController struct {
+	baseController
+	*ListControllerTrait[*types.CommandLogEntry]
+	c *ControllerCommon
+}
+
+var _ types.IController = &CommandLogEntriesController{}
+
+func NewCommandLogEntriesController(
+	c *ControllerCommon,
+) *CommandLogEntriesController {
+	return &CommandLogEntriesController{
+		baseController: baseController{},
+		ListControllerTrait: NewListControllerTrait[*types.CommandLogEntry](
+			c,
+			c.Contexts().CommandLogEntries,
+			c.Contexts().CommandLogEntries.GetSelected,
+			c.Contexts().CommandLogEntries.GetSelectedItems,
+		),
+		c: c,
+	}
+}
+
+func (self *CommandLogEntriesController) GetKeybindings(opts types.KeybindingsOpts) []*types.Binding {
+	bindings := []*types.Binding{
+		{
+			Key:               opts.GetKey(opts.Config.Universal.GoInto),
+			Handler:           self.withItem(self.toggleOutput),
+			GetDisabledReason: self.require(self.singleItemSelected()),
+			Description:       self.c.Tr.ToggleCommandOutput,
+			Tooltip:           self.c.Tr.ToggleCommandOutputTooltip,
+			DisplayOnScreen:   true,
+		},
+		{
+			Key:               opts.GetKey(opts.Config.Universal.CopyToClipboard),
+			Handler:           self.withItem(self.copyToClipboard),
+			GetDisabledReason: self.require(self.singleItemSelected()),
+			Description:       self.c.Tr.CopyCommandToClipboard,
+			DisplayOnScreen:   true,
+		},
+		{
+			Key:               opts.GetKey(opts.Config.CommandLog.RerunCommand),
+			Handler:           self.withItem(self.rerun),
+			GetDisabledReason: self.require(self.singleItemSelected(self.canRerun)),
+			Description:       self.c.Tr.RerunCommand,
+			Tooltip:           self.c.Tr.RerunCommandTooltip,
+			DisplayOnScreen:   true,
+		},
+		{
+			Key:               opts.GetKey(opts.Config.CommandLog.ExportScript),
+			Handler:           self.c.Helpers().CommandLog.OpenExportSessionScriptMenu,
+			GetDisabledReason: self.c.Helpers().CommandLog.GetExportSessionScriptDisabledReason,
+			Description:       self.c.Tr.ExportCommandLogScript,
+			Tooltip:           self.c.Tr.ExportCommandLogScriptTooltip,
+			OpensMenu:         true,
+		},
+	}
+
+	return bindings
+}
+
+func (self *CommandLogEntriesController) GetOnFocus() func(types.OnFocusOpts) {
+	return func(types.OnFocusOpts) {
+		// so that pressing escape returns to where we came from, no matter
+		// whether we got here via the extras menu or by switching tabs
+		self.context().SetParentContext(self.c.Context().CurrentSide())
+	}
+}
+
+func (self *CommandLogEntriesController) GetOnRenderToMain() func() {
+	return func() {
+		var task types.UpdateTask
+		entry := self.context().GetSelected()
+		if entry == nil {
+			task = types.NewRenderStringTask("")
+		} else {
+			task = types.NewRenderStringTask(self.entryDetails(entry))
+		}
+
+		self.c.RenderToMainViews(types.RefreshMainOpts{
+			Pair: self.c.MainViewPairs().Normal,
+			Main: &types.ViewUpdateOpts{
+				Title: self.c.Tr.CommandLogEntryTitle,
+				Task:  task,
+			},
+		})
+	}
+}
+
+func (self *CommandLogEntriesController) entryDetails(entry *types.CommandLogEntry) string {
+	lines := []string{}
+	if entry.Action != "" {
+		lines = append(lines, style.FgYellow.Sprint(entry.Action))
+	}
+	lines = append(lines, style.FgCyan.Sprint(entry.Command), "")
+
+	switch {
+	case !entry.IsCommandLine:
+		lines = append(lines, self.c.Tr.CommandLogNotACommand)
+	case !entry.Finished:
+		lines = append(lines, self.c.Tr.CommandLogStillRunning)
+	default:
+		exitCodeStyle := style.FgGreen
+		if entry.Failed() {
+			exitCodeStyle = style.FgRed
+		}
+		lines = append(lines,
+			fmt.Sprintf("%s: %s", self.c.Tr.CommandLogExitCode, exitCodeStyle.Sprint(strconv.Itoa(entry.ExitCode))),
+			fmt.Sprintf("%s: %s", self.c.Tr.CommandLogDuration, presentation.FormatCommandDuration(entry.Duration)),
+			"",
+			entry.Output,
+		)
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+func (self *CommandLogEntriesController) context() *context.CommandLogEntriesContext {
+	return self.c.Contexts().CommandLogEntries
+}
+
+func (self *CommandLogEntriesController) toggleOutput(entry *types.CommandLogEntry) error {
+	self.context().ToggleExpanded(entry)
+	self.c.PostRefreshUpdate(self.context())
+	return nil
+}
+
+func (self *CommandLogEntriesController) copyToClipboard(entry *types.CommandLogEntry) error {
+	if err := self.c.OS().CopyToClipboard(entry.Command); err != nil {
+		return err
+	}
+
+	self.c.Toast(self.c.Tr.CommandCopiedToClipboard)
+	return nil
+}
+
+func (self *CommandLogEntriesController) canRerun(entry *types.CommandLogEntry) *types.DisabledReason {
+	if !entry.IsCommandLine {
+		return &types.DisabledReason{Text: self.c.Tr.CannotRerunNonCommand}
+	}
+	if !entry.CanRerun() {
+		return &types.DisabledReason{Text: self.c.Tr.CannotRerunUnfinishedCommand}
+	}
+	if entry.UsedDaemon {
+		return &types.DisabledReason{Text: self.c.Tr.CannotRerunCommandUsedDaemon}
+	}
+
+	return nil
+}
+
+func (self *CommandLogEntriesController) rerun(entry *types.CommandLogEntry) error {
+	self.c.Confirm(types.ConfirmOpts{
+		Title: self.c.Tr.RerunCommand,
+		Prompt: utils.ResolvePlaceholderString(self.c.Tr.RerunCommandPrompt, map[string]string{
+			"command": entry.Command,
+		}),
+		HandleConfirm: func() error {
+			return self.guardRerun(entry.Args, func() error {
+				self.c.LogAction(self.c.Tr.Actions.RerunCommand)
+				return self.c.RunSubprocessAndRefresh(self.c.OS().Cmd.New(entry.Args))
+			})
+		},
+	})
+
+	return nil
+}
+
+// Re-running a command must be subject to the same restrictions as the action
+// that ran it in the first place
+func (self *CommandLogEntriesController) guardRerun(args []string, f func() error) error {
+	effect := getRerunEffect(args)
+	if effect.forcePush && self.c.UserConfig().Git.DisableForcePushing {
+		return errors.New(self.c.Tr.ForcePushingDisabled)
+	}
+	if effect.protectedBranchOperation == nil {
+		return f()
+	}
+
+	protectedBranches := self.c.Helpers().ProtectedBranches
+	if len(effect.branchNames) > 0 {
+		return protectedBranches.Guard(*effect.protectedBranchOperation, effect.branchNames, f)
+	}
+	if effect.forcePush {
+		// pushing without refspecs pushes the checked-out branch
+		if branch := self.c.Helpers().Refs.GetCheckedOutRef(); branch != nil {
+			return protectedBranches.Guard(*effect.protectedBranchOperation, []string{branch.Name, branch.UpstreamBranch}, f)
+		}
+		return f()
+	}
+	return protectedBranches.GuardCheckedOutBranch(*effect.protectedBranchOperation, f)
+}
+
+// What re-running a command can do to branches that might be protected
+type rerunEffect struct {
+	// nil if the command doesn't affect any branches in a way that's protected
+	protectedBranchOperation *helpers.ProtectedBranchOperation
+	// the branches that the command affects; empty if it's the checked-out one
+	branchNames []string
+	forcePush   bool
+}
+
+func getRerunEffect(args []string) rerunEffect {
+	subcommand, subArgs, ok := presentation.GitSubcommand(args)
+	if !ok {
+		return rerunEffect{}
+	}
+
+	hasFlag := func(flags ...string) bool {
+		return lo.SomeBy(subArgs, func(arg string) bool {
+			return lo.SomeBy(flags, func(flag string) bool {
+				return arg == flag || strings.HasPrefix(arg, flag+"=")
+			})
+		})
+	}
+	positionalArgs := lo.Reject(subArgs, func(arg string, _ int) bool {
+		return strings.HasPrefix(arg, "-")
+	})
+	operation := func(operation helpers.ProtectedBranchOperation, branchNames []string) rerunEffect {
+		return rerunEffect{protectedBranchOperation: &operation, branchNames: branchNames}
+	}
+
+	switch subcommand {
+	case "reset":
+		return operation(helpers.ProtectedBranchReset, nil)
+	case "rebase":
+		return operation(helpers.ProtectedBranchRebase, nil)
+	case "commit":
+		if hasFlag("--amend") {
+			return operation(helpers.ProtectedBranchRebase, nil)
+		}
+		return operation(helpers.ProtectedBranchCommit, nil)
+	case "merge", "cherry-pick", "revert", "pull", "am":
+		return operation(helpers.ProtectedBranchCommit, nil)
+	case "branch":
+		if hasFlag("-d", "-D", "--delete") {
+			return operation(helpers.ProtectedBranchDelete, positionalArgs)
+		}
+	case "push":
+		// the first positional argument is the remote, the rest are refspecs
+		refspecs := lo.Drop(positionalArgs, 1)
+		destinations := lo.Map(refspecs, func(refspec string, _ int) string {
+			refspec = strings.TrimPrefix(refspec, "+")
+			if _, destination, found := strings.Cut(refspec, ":"); found {
+				refspec = destination
+			}
+			return strings.TrimPrefix(refspec, "refs/heads/")
+		})
+
+		if hasFlag("-d", "--delete") || lo.SomeBy(refspecs, func(refspec string) bool {
+			return strings.HasPrefix(refspec, ":")
+		}) {
+			return operation(helpers.ProtectedBranchDelete, destinations)
+		}
+
+		if hasFlag("-f", "--force", "--force-with-lease") || lo.SomeBy(refspecs, func(refspec string) bool {
+			return strings.HasPrefix(refspec, "+")
+		}) {
+			effect := operation(helpers.ProtectedBranchForcePush, destinations)
+			effect.forcePush = true
+			return effect
+		}
+	}
+
+	return rerunEffect{}
+}
@@ -0,0 +1,88 @@
+package controllers
+
+import (
+	"testing"
+
+	"github.com/jesseduffield/lazygit/pkg/gui/controllers/helpers"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetRerunEffect(t *testing.T) {
+	operation := func(operation helpers.ProtectedBranchOperation) *helpers.ProtectedBranchOperation {
+		return &operation
+	}
+
+	scenarios := []struct {
+		args     []string
+		expected rerunEffect
+	}{
+		{
+			args:     []string{"git", "add", "--", "file"},
+			expected: rerunEffect{},
+		},
+		{
+			args:     []string{"sh", "-c", "git reset --hard"},
+			expected: rerunEffect{},
+		},
+		{
+			args:     []string{"git", "reset", "--hard", "HEAD^"},
+			expected: rerunEffect{protectedBranchOperation: operation(helpers.ProtectedBranchReset)},
+		},
+		{
+			args:     []string{"git", "-c", "core.editor=true", "rebase", "--continue"},
+			expected: rerunEffect{protectedBranchOperation: operation(helpers.ProtectedBranchRebase)},
+		},
+		{
+			args:     []string{"git", "commit", "-m", "msg"},
+			expected: rerunEffect{protectedBranchOperation: operation(helpers.ProtectedBranchCommit)},
+		},
+		{
+			args:     []string{"git", "commit", "--amend", "--no-edit"},
+			expected: rerunEffect{protectedBranchOperation: operation(helpers.ProtectedBranchRebase)},
+		},
+		{
+			args:     []string{"git", "branch", "-D", "feature", "main"},
+			expected: rerunEffect{protectedBranchOperation: operation(helpers.ProtectedBranchDelete), branchNames: []string{"feature", "main"}},
+		},
+		{
+			args:     []string{"git", "branch", "--set-upstream-to=origin/main", "main"},
+			expected: rerunEffect{},
+		},
+		{
+			args:     []string{"git", "push", "origin", "--delete", "main"},
+			expected: rerunEffect{protectedBranchOperation: operation(helpers.ProtectedBranchDelete), branchNames: []string{"main"}},
+		},
+		{
+			args:     []string{"git", "push", "origin", "refs/heads/main:main"},
+			expected: rerunEffect{},
+		},
+		{
+			args: []string{"git", "push", "--force-with-lease=refs/heads/main:abc123", "origin", "refs/heads/main:main"},
+			expected: rerunEffect{
+				protectedBranchOperation: operation(helpers.ProtectedBranchForcePush),
+				branchNames:              []string{"main"},
+				forcePush:                true,
+			},
+		},
+		{
+			args: []string{"git", "push", "origin", "+feature:main"},
+			expected: rerunEffect{
+				protectedBranchOperation: operation(helpers.ProtectedBranchForcePush),
+				branchNames:              []string{"main"},
+				forcePush:                true,
+			},
+		},
+		{
+			args: []string{"git", "push", "--force"},
+			expected: rerunEffect{
+				protectedBranchOperation: operation(helpers.ProtectedBranchForcePush),
+				branchNames:              []string{},
+				forcePush:                true,
+			},
+		},
+	}
+
+	for _, s := range scenarios {
+		assert.Equal(t, s.expected, getRerunEffect(s.args), s.args)
+	}
+}
@@ -49,19 +49,19 @@ func (self *CustomPatchOptionsMenuAction) Call() error {
 			{
 				Label:   fmt.Sprintf(self.c.Tr.RemovePatchFromOriginalCommit, self.c.Git().Patch.PatchBuilder.To),
 				Tooltip: self.c.Tr.RemovePatchFromOriginalCommitTooltip,
-				OnPress: self.handleDeletePatchFromCommit,
+				OnPress: self.guardCheckedOutBranch(self.handleDeletePatchFromCommit),
 				Key:     'd',
 			},
 			{
 				Label:   self.c.Tr.MovePatchOutIntoIndex,
 				Tooltip: self.c.Tr.MovePatchOutIntoIndexTooltip,
-				OnPress: self.handleMovePatchIntoWorkingTree,
+				OnPress: self.guardCheckedOutBranch(self.handleMovePatchIntoWorkingTree),
 				Key:     'i',
 			},
 			{
 				Label:   self.c.Tr.MovePatchIntoNewCommit,
 				Tooltip: self.c.Tr.MovePatchIntoNewCommitTooltip,
-				OnPress: self.handlePullPatchIntoNewCommit,
+				OnPress: self.guardCheckedOutBranch(self.handlePullPatchIntoNewCommit),
 				Key:     'n',
 			},
 		}...)
@@ -83,7 +83,7 @@ func (self *CustomPatchOptionsMenuAction) Call() error {
 							{
 								Label:          fmt.Sprintf(self.c.Tr.MovePatchToSelectedCommit, selectedCommit.Hash),
 								Tooltip:        self.c.Tr.MovePatchToSelectedCommitTooltip,
-								OnPress:        self.handleMovePatchToSelectedCommit,
+								OnPress:        self.guardCheckedOutBranch(self.handleMovePatchToSelectedCommit),
 								Key:            'm',
 								DisabledReason: disabledReason,
 							},
@@ -105,6 +105,14 @@ func (self *CustomPatchOptionsMenuAction) Call() error {
 	return self.c.Menu(types.CreateMenuOptions{Title: self.c.Tr.PatchOptionsTitle, Items: menuItems})
 }
 
+// Guards the options that rewrite the commits of the checked-out branch
+// against doing so on a protected branch
+func (self *CustomPatchOptionsMenuAction) guardCheckedOutBranch(handler func() error) func() error {
+	return func() error {
+		return self.c.Helpers().ProtectedBranches.GuardCheckedOutBranch(helpers.ProtectedBranchRebase, handler)
+	}
+}
+
 func (self *CustomPatchOptionsMenuAction) getPatchCommitIndex() int {
 	for index, commit := range self.c.Model().Commits {
 		if commit.Hash == self.c.Git().Patch.PatchBuilder.To {
@@ -9,6 +9,7 @@ import (
 	"github.com/jesseduffield/lazygit/pkg/commands/git_commands"
 	"github.com/jesseduffield/lazygit/pkg/commands/models"
 	"github.com/jesseduffield/lazygit/pkg/gui/context"
+	"github.com/jesseduffield/lazygit/pkg/gui/controllers/helpers"
 	"github.com/jesseduffield/lazygit/pkg/gui/filetree"
 	"github.com/jesseduffield/lazygit/pkg/gui/types"
 	"github.com/jesseduffield/lazygit/pkg/utils"
@@ -697,7 +698,9 @@ func (self *FilesController) handleAmendCommitPress() error {
 				return errors.New(self.c.Tr.NoCommitToAmend)
 			}
 
-			return self.c.Helpers().AmendHelper.AmendHead()
+			return self.c.Helpers().ProtectedBranches.GuardCheckedOutBranch(
+				helpers.ProtectedBranchRebase, self.c.Helpers().AmendHelper.AmendHead,
+			)
 		})
 	}
 
@@ -3,8 +3,14 @@ package controllers
 import (
 	"fmt"
 	"strings"
+	"time"
 
+	"github.com/jesseduffield/gocui"
+	"github.com/jesseduffield/lazygit/pkg/commands/models"
+	"github.com/jesseduffield/lazygit/pkg/gui/presentation"
 	"github.com/jesseduffield/lazygit/pkg/gui/types"
+	"github.com/jesseduffield/lazygit/pkg/utils"
+	"github.com/samber/lo"
 )
 
 type FilteringMenuAction struct {
@@ -90,6 +96,13 @@ func (self *FilteringMenuAction) Call() error {
 		Tooltip: tooltip,
 	})
 
+	menuItems = append(menuItems, &types.MenuItem{
+		Label:     self.c.Tr.ShowAuthorStats,
+		OnPress:   self.showAuthorStats,
+		Tooltip:   self.c.Tr.ShowAuthorStatsTooltip,
+		OpensMenu: true,
+	})
+
 	if self.c.Modes().Filtering.Active() {
 		menuItems = append(menuItems, &types.MenuItem{
 			Label:   self.c.Tr.ExitFilterMode,
@@ -100,6 +113,46 @@ func (self *FilteringMenuAction) Call() error {
 	return self.c.Menu(types.CreateMenuOptions{Title: self.c.Tr.FilteringMenuTitle, Items: menuItems})
 }
 
+// Shows statistics for the authors of the commits that are currently loaded in
+// the commits panel; selecting one filters by that author.
+func (self *FilteringMenuAction) showAuthorStats() error {
+	commits := lo.Filter(self.c.Model().Commits, func(commit *models.Commit, _ int) bool {
+		return !commit.IsTODO()
+	})
+
+	return self.c.WithWaitingStatus(self.c.Tr.LoadingAuthorStatsStatus, func(gocui.Task) error {
+		hashes := lo.Map(commits, func(commit *models.Commit, _ int) string { return commit.Hash })
+		numstats, err := self.c.Git().Loaders.CommitLoader.GetNumstats(hashes)
+		if err != nil {
+			return err
+		}
+
+		allStats := presentation.GetAuthorStats(commits, numstats)
+		now := time.Now()
+		timeFormat := self.c.UserConfig().Gui.TimeFormat
+		shortTimeFormat := self.c.UserConfig().Gui.ShortTimeFormat
+
+		menuItems := lo.Map(allStats, func(stats *models.AuthorStats, _ int) *types.MenuItem {
+			author := stats.Combined()
+			return &types.MenuItem{
+				LabelColumns: presentation.GetAuthorStatsDisplayStrings(stats, now, timeFormat, shortTimeFormat, self.c.Tr),
+				OnPress: func() error {
+					return self.setFilteringAuthor(author)
+				},
+				Tooltip: utils.ResolvePlaceholderString(self.c.Tr.AuthorStatsItemTooltip, map[string]string{
+					"author":      author,
+					"directories": strings.Join(stats.Directories, " "),
+				}),
+			}
+		})
+
+		self.c.OnUIThread(func() error {
+			return self.c.Menu(types.CreateMenuOptions{Title: self.c.Tr.AuthorStatsTitle, Items: menuItems})
+		})
+		return nil
+	})
+}
+
 func (self *FilteringMenuAction) setFilteringPath(path string) error {
 	self.c.Modes().Filtering.Reset()
 	self.c.Modes().Filtering.SetPath(path)
@@ -1,8 +1,11 @@
 package controllers
 
 import (
+	"strconv"
+
 	"github.com/jesseduffield/gocui"
 	"github.com/jesseduffield/lazygit/pkg/gui/types"
+	"github.com/jesseduffield/lazygit/pkg/utils"
 )
 
 type GlobalController struct {
@@ -126,6 +129,13 @@ func (self *GlobalController) GetKeybindings(opts types.KeybindingsOpts) []*type
 			Description: self.c.Tr.ToggleWhitespaceInDiffView,
 			Tooltip:     self.c.Tr.ToggleWhitespaceInDiffViewTooltip,
 		},
+		{
+			Key:               opts.GetKey(opts.Config.Universal.ClearCredentialCache),
+			Handler:           self.clearCredentialCache,
+			Description:       self.c.Tr.ClearCredentialCache,
+			Tooltip:           self.c.Tr.ClearCredentialCacheTooltip,
+			GetDisabledReason: self.clearCredentialCacheDisabledReason,
+		},
 	}
 }
 
@@ -191,3 +201,24 @@ func (self *GlobalController) escape() error {
 func (self *GlobalController) toggleWhitespace() error {
 	return (&ToggleWhitespaceAction{c: self.c}).Call()
 }
+
+func (self *GlobalController) clearCredentialCache() error {
+	count := self.c.OS().CachedCredentialCount()
+	self.c.OS().ClearCredentialCache()
+	self.c.Toast(utils.ResolvePlaceholderString(self.c.Tr.ClearedCachedCredentials, map[string]string{
+		"count": strconv.Itoa(count),
+	}))
+
+	return nil
+}
+
+func (self *GlobalController) clearCredentialCacheDisabledReason() *types.DisabledReason {
+	if !self.c.UserConfig().Git.CacheCredentials {
+		return &types.DisabledReason{Text: self.c.Tr.CredentialCacheDisabled}
+	}
+	if self.c.OS().CachedCredentialCount() == 0 {
+		return &types.DisabledReason{Text: self.c.Tr.NoCachedCredentials}
+	}
+
+	return nil
+}
@@ -14,18 +14,34 @@ import (
 )
 
 type BranchesHelper struct {
-	c              *HelperCommon
-	worktreeHelper *WorktreeHelper
+	c                       *HelperCommon
+	worktreeHelper          *WorktreeHelper
+	lossPreviewHelper       *LossPreviewHelper
+	protectedBranchesHelper *ProtectedBranchesHelper
 }
 
-func NewBranchesHelper(c *HelperCommon, worktreeHelper *WorktreeHelper) *BranchesHelper {
+func NewBranchesHelper(
+	c *HelperCommon,
+	worktreeHelper *WorktreeHelper,
+	lossPreviewHelper *LossPreviewHelper,
+	protectedBranchesHelper *ProtectedBranchesHelper,
+) *BranchesHelper {
 	return &BranchesHelper{
-		c:              c,
-		worktreeHelper: worktreeHelper,
+		c:                       c,
+		worktreeHelper:          worktreeHelper,
+		lossPreviewHelper:       lossPreviewHelper,
+		protectedBranchesHelper: protectedBranchesHelper,
 	}
 }
 
 func (self *BranchesHelper) ConfirmLocalDelete(branches []*models.Branch) error {
+	branchNames := lo.Map(branches, func(branch *models.Branch, _ int) string { return branch.Name })
+	return self.protectedBranchesHelper.Guard(ProtectedBranchDelete, branchNames, func() error {
+		return self.confirmLocalDelete(branches)
+	})
+}
+
+func (self *BranchesHelper) confirmLocalDelete(branches []*models.Branch) error {
 	if len(branches) > 1 {
 		if lo.SomeBy(branches, func(branch *models.Branch) bool { return self.checkedOutByOtherWorktree(branch) }) {
 			return errors.New(self.c.Tr.SomeBranchesCheckedOutByWorktreeError)
@@ -69,18 +85,23 @@ func (self *BranchesHelper) ConfirmLocalDelete(branches []*models.Branch) error
 		message = self.c.Tr.ForceDeleteBranchesMessage
 	}
 
-	self.c.Confirm(types.ConfirmOpts{
+	return self.confirmWithLocalDeletePreview(types.ConfirmOpts{
 		Title:  title,
 		Prompt: message,
 		HandleConfirm: func() error {
 			return doDelete()
 		},
-	})
-
-	return nil
+	}, branches, nil)
 }
 
 func (self *BranchesHelper) ConfirmDeleteRemote(remoteBranches []*models.RemoteBranch) error {
+	branchNames := lo.Map(remoteBranches, func(branch *models.RemoteBranch, _ int) string { return branch.Name })
+	return self.protectedBranchesHelper.Guard(ProtectedBranchDelete, branchNames, func() error {
+		return self.confirmDeleteRemote(remoteBranches)
+	})
+}
+
+func (self *BranchesHelper) confirmDeleteRemote(remoteBranches []*models.RemoteBranch) error {
 	var title string
 	if len(remoteBranches) == 1 {
 		title = utils.ResolvePlaceholderString(
@@ -121,6 +142,15 @@ func (self *BranchesHelper) ConfirmDeleteRemote(remoteBranches []*models.RemoteB
 }
 
 func (self *BranchesHelper) ConfirmLocalAndRemoteDelete(branches []*models.Branch) error {
+	branchNames := lo.FlatMap(branches, func(branch *models.Branch, _ int) []string {
+		return []string{branch.Name, branch.UpstreamBranch}
+	})
+	return self.protectedBranchesHelper.Guard(ProtectedBranchDelete, branchNames, func() error {
+		return self.confirmLocalAndRemoteDelete(branches)
+	})
+}
+
+func (self *BranchesHelper) confirmLocalAndRemoteDelete(branches []*models.Branch) error {
 	if lo.SomeBy(branches, func(branch *models.Branch) bool { return self.checkedOutByOtherWorktree(branch) }) {
 		return errors.New(self.c.Tr.SomeBranchesCheckedOutByWorktreeError)
 	}
@@ -157,16 +187,16 @@ func (self *BranchesHelper) ConfirmLocalAndRemoteDelete(branches []*models.Branc
 		}
 	}
 
-	self.c.Confirm(types.ConfirmOpts{
+	remoteBranches := lo.Map(branches, func(branch *models.Branch, _ int) *models.RemoteBranch {
+		return &models.RemoteBranch{Name: branch.UpstreamBranch, RemoteName: branch.UpstreamRemote}
+	})
+	return self.confirmWithLocalDeletePreview(types.ConfirmOpts{
 		Title:  self.c.Tr.DeleteLocalAndRemoteBranch,
 		Prompt: prompt,
 		HandleConfirm: func() error {
 			return self.c.WithWaitingStatus(self.c.Tr.DeletingStatus, func(task gocui.Task) error {
 				// Delete the remote branches first so that we keep the local ones
 				// in case of failure
-				remoteBranches := lo.Map(branches, func(branch *models.Branch, _ int) *models.RemoteBranch {
-					return &models.RemoteBranch{Name: branch.UpstreamBranch, RemoteName: branch.UpstreamRemote}
-				})
 				if err := self.deleteRemoteBranches(remoteBranches, task); err != nil {
 					return err
 				}
@@ -183,9 +213,22 @@ func (self *BranchesHelper) ConfirmLocalAndRemoteDelete(branches []*models.Branc
 				return self.c.Refresh(types.RefreshOptions{Mode: types.ASYNC, Scope: []types.RefreshableView{types.BRANCHES, types.REMOTES}})
 			})
 		},
-	})
+	}, branches, remoteBranches)
+}
 
-	return nil
+// Asks for confirmation, appending the commits that deleting the branches (and
+// remoteBranches along with them) would make unreachable to the prompt
+func (self *BranchesHelper) confirmWithLocalDeletePreview(opts types.ConfirmOpts, branches []*models.Branch, remoteBranches []*models.RemoteBranch) error {
+	return self.lossPreviewHelper.WithPreview(func() (*models.LossPreview, error) {
+		return self.c.Git().Branch.LocalDeletePreview(
+			lo.Map(branches, func(branch *models.Branch, _ int) string { return branch.Name }),
+			lo.Map(remoteBranches, func(branch *models.RemoteBranch, _ int) string { return branch.FullName() }),
+		)
+	}, func(preview *models.LossPreview, err error) error {
+		opts.Prompt, _ = self.lossPreviewHelper.PromptWithPreview(opts.Prompt, preview, err, self.c.Tr.LossPreviewLostCommits)
+		self.c.Confirm(opts)
+		return nil
+	})
 }
 
 func ShortBranchName(fullBranchName string) string {
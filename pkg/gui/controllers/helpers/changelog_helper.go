@@ -0,0 +1,197 @@
+package helpers
+
+import (
+	"github.com/jesseduffield/gocui"
+	"github.com/jesseduffield/lazygit/pkg/commands/models"
+	"github.com/jesseduffield/lazygit/pkg/gui/presentation"
+	"github.com/jesseduffield/lazygit/pkg/gui/types"
+	"github.com/jesseduffield/lazygit/pkg/utils"
+	"github.com/samber/lo"
+)
+
+// Generates release notes for a range of commits, to be shown in the main view,
+// copied to the clipboard or written to a file.
+
+type ChangelogHelper struct {
+	c                 *HelperCommon
+	hostHelper        *HostHelper
+	suggestionsHelper *SuggestionsHelper
+}
+
+func NewChangelogHelper(
+	c *HelperCommon,
+	hostHelper *HostHelper,
+	suggestionsHelper *SuggestionsHelper,
+) *ChangelogHelper {
+	return &ChangelogHelper{
+		c:                 c,
+		hostHelper:        hostHelper,
+		suggestionsHelper: suggestionsHelper,
+	}
+}
+
+// OpenChangelogPrompt asks for the ref to start from and then for how to group
+// and output the changelog of the commits between that ref and toRef
+func (self *ChangelogHelper) OpenChangelogPrompt(toRef string, toRefLabel string) error {
+	self.c.Prompt(types.PromptOpts{
+		Title: utils.ResolvePlaceholderString(
+			self.c.Tr.ChangelogFromRefTitle,
+			map[string]string{"to": toRefLabel},
+		),
+		InitialContent:      self.previousReleaseTag(toRefLabel),
+		FindSuggestionsFunc: self.suggestionsHelper.GetRefsSuggestionsFunc(),
+		HandleConfirm: func(fromRef string) error {
+			return self.openGroupingMenu(presentation.ChangelogOpts{
+				FromRef: fromRef,
+				ToRef:   toRefLabel,
+			}, toRef)
+		},
+	})
+
+	return nil
+}
+
+// Returns the highest semver tag that is lower than the given ref's version,
+// or just the highest one if the ref isn't a version
+func (self *ChangelogHelper) previousReleaseTag(toRef string) string {
+	tagNames := lo.Map(self.c.Model().Tags, func(tag *models.Tag, _ int) string {
+		return tag.Name
+	})
+
+	if toVersion, ok := utils.ParseSemVer(toRef); ok {
+		tagNames = lo.Filter(tagNames, func(tagName string, _ int) bool {
+			version, ok := utils.ParseSemVer(tagName)
+			return ok && version.Compare(toVersion) < 0
+		})
+	}
+
+	_, tagName, _ := utils.LatestSemVer(tagNames)
+	return tagName
+}
+
+func (self *ChangelogHelper) openGroupingMenu(opts presentation.ChangelogOpts, toRef string) error {
+	menuItem := func(label string, grouping presentation.ChangelogGrouping, key types.Key) *types.MenuItem {
+		return &types.MenuItem{
+			Label: label,
+			OnPress: func() error {
+				opts.Grouping = grouping
+				return self.generate(opts, toRef)
+			},
+			Key: key,
+		}
+	}
+
+	return self.c.Menu(types.CreateMenuOptions{
+		Title: self.c.Tr.ChangelogGroupingTitle,
+		Items: []*types.MenuItem{
+			menuItem(self.c.Tr.ChangelogGroupByType, presentation.ChangelogGroupByType, 't'),
+			menuItem(self.c.Tr.ChangelogGroupByPullRequest, presentation.ChangelogGroupByPullRequest, 'p'),
+		},
+	})
+}
+
+func (self *ChangelogHelper) generate(opts presentation.ChangelogOpts, toRef string) error {
+	return self.c.WithWaitingStatus(self.c.Tr.GeneratingChangelogStatus, func(gocui.Task) error {
+		commits, err := self.c.Git().Loaders.CommitLoader.GetCommitsInRange(opts.FromRef, toRef)
+		if err != nil {
+			return err
+		}
+
+		opts.GetCommitURL = self.getCommitURLFunc()
+		changelog := presentation.GetMarkdownChangelog(commits, opts, self.c.Tr)
+
+		self.c.OnUIThread(func() error {
+			return self.openOutputMenu(changelog, opts)
+		})
+		return nil
+	})
+}
+
+// Creating the hosting service manager involves reading the remote's URL, so
+// we only do that once rather than for every commit
+func (self *ChangelogHelper) getCommitURLFunc() func(hash string) string {
+	mgr, err := self.hostHelper.getHostingServiceMgr()
+	if err != nil {
+		return nil
+	}
+
+	return func(hash string) string {
+		url, err := mgr.GetCommitURL(hash)
+		if err != nil {
+			return ""
+		}
+		return url
+	}
+}
+
+func (self *ChangelogHelper) openOutputMenu(changelog string, opts presentation.ChangelogOpts) error {
+	return self.c.Menu(types.CreateMenuOptions{
+		Title: self.c.Tr.ChangelogOutputTitle,
+		Items: []*types.MenuItem{
+			{
+				Label: self.c.Tr.ChangelogShowInMainView,
+				OnPress: func() error {
+					self.renderToMain(changelog, opts)
+					return nil
+				},
+				Key: 's',
+			},
+			{
+				Label: self.c.Tr.CopyToClipboardMenu,
+				OnPress: func() error {
+					self.c.LogAction(self.c.Tr.Actions.CopyChangelogToClipboard)
+					if err := self.c.OS().CopyToClipboard(changelog); err != nil {
+						return err
+					}
+
+					self.c.Toast(self.c.Tr.ChangelogCopiedToClipboard)
+					return nil
+				},
+				Key: 'c',
+			},
+			{
+				Label: self.c.Tr.ChangelogWriteToFile,
+				OnPress: func() error {
+					return self.openWriteToFilePrompt(changelog)
+				},
+				Key: 'w',
+			},
+		},
+	})
+}
+
+func (self *ChangelogHelper) renderToMain(changelog string, opts presentation.ChangelogOpts) {
+	self.c.RenderToMainViews(types.RefreshMainOpts{
+		Pair: self.c.MainViewPairs().Normal,
+		Main: &types.ViewUpdateOpts{
+			Title: utils.ResolvePlaceholderString(
+				self.c.Tr.ChangelogMainTitle,
+				map[string]string{"from": opts.FromRef, "to": opts.ToRef},
+			),
+			Task: types.NewRenderStringTask(changelog),
+		},
+	})
+}
+
+func (self *ChangelogHelper) openWriteToFilePrompt(changelog string) error {
+	self.c.Prompt(types.PromptOpts{
+		Title:               self.c.Tr.ChangelogFilePathTitle,
+		InitialContent:      "CHANGELOG.md",
+		FindSuggestionsFunc: self.suggestionsHelper.GetFilePathSuggestionsFunc(),
+		HandleConfirm: func(path string) error {
+			self.c.LogAction(self.c.Tr.Actions.WriteChangelogToFile)
+			if err := self.c.OS().CreateFileWithContent(path, changelog); err != nil {
+				return err
+			}
+
+			self.c.Toast(utils.ResolvePlaceholderString(
+				self.c.Tr.ChangelogWrittenToFile,
+				map[string]string{"path": path},
+			))
+
+			return self.c.Refresh(types.RefreshOptions{Mode: types.ASYNC, Scope: []types.RefreshableView{types.FILES}})
+		},
+	})
+
+	return nil
+}
@@ -0,0 +1,122 @@
+package helpers
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/gui/presentation"
+	"github.com/jesseduffield/lazygit/pkg/gui/types"
+	"github.com/jesseduffield/lazygit/pkg/utils"
+	"github.com/samber/lo"
+)
+
+// Exports the commands that lazygit ran in the current session as a shell
+// script, to be shown in the main view, copied to the clipboard or written to
+// a file.
+
+type CommandLogHelper struct {
+	c                 *HelperCommon
+	suggestionsHelper *SuggestionsHelper
+}
+
+func NewCommandLogHelper(
+	c *HelperCommon,
+	suggestionsHelper *SuggestionsHelper,
+) *CommandLogHelper {
+	return &CommandLogHelper{
+		c:                 c,
+		suggestionsHelper: suggestionsHelper,
+	}
+}
+
+// SessionEntries returns the command log entries of the current repo that
+// were logged since lazygit was started, newest first
+func (self *CommandLogHelper) SessionEntries() []*types.CommandLogEntry {
+	self.c.Mutexes().CommandLogMutex.Lock()
+	entries := self.c.Model().CommandLogEntries
+	self.c.Mutexes().CommandLogMutex.Unlock()
+
+	sessionStart := self.c.State().GetSessionStartTime()
+	return lo.Filter(entries, func(entry *types.CommandLogEntry, _ int) bool {
+		return !entry.Time.Before(sessionStart)
+	})
+}
+
+func (self *CommandLogHelper) GetExportSessionScriptDisabledReason() *types.DisabledReason {
+	if len(self.SessionEntries()) == 0 {
+		return &types.DisabledReason{Text: self.c.Tr.NoCommandsInThisSession}
+	}
+
+	return nil
+}
+
+func (self *CommandLogHelper) OpenExportSessionScriptMenu() error {
+	script := presentation.GetCommandLogScript(
+		self.SessionEntries(),
+		presentation.CommandLogScriptOpts{
+			RepoPath:     self.c.Git().RepoPaths.WorktreePath(),
+			SessionStart: self.c.State().GetSessionStartTime(),
+		},
+		self.c.Tr,
+	)
+
+	return self.c.Menu(types.CreateMenuOptions{
+		Title: self.c.Tr.CommandLogScriptTitle,
+		Items: []*types.MenuItem{
+			{
+				Label: self.c.Tr.CommandLogScriptShowInMainView,
+				OnPress: func() error {
+					self.c.RenderToMainViews(types.RefreshMainOpts{
+						Pair: self.c.MainViewPairs().Normal,
+						Main: &types.ViewUpdateOpts{
+							Title: self.c.Tr.CommandLogScriptTitle,
+							Task:  types.NewRenderStringTask(script),
+						},
+					})
+					return nil
+				},
+				Key: 's',
+			},
+			{
+				Label: self.c.Tr.CopyToClipboardMenu,
+				OnPress: func() error {
+					self.c.LogAction(self.c.Tr.Actions.CopyCommandLogScriptToClipboard)
+					if err := self.c.OS().CopyToClipboard(script); err != nil {
+						return err
+					}
+
+					self.c.Toast(self.c.Tr.CommandLogScriptCopiedToClipboard)
+					return nil
+				},
+				Key: 'c',
+			},
+			{
+				Label: self.c.Tr.CommandLogScriptWriteToFile,
+				OnPress: func() error {
+					return self.openWriteToFilePrompt(script)
+				},
+				Key: 'w',
+			},
+		},
+	})
+}
+
+func (self *CommandLogHelper) openWriteToFilePrompt(script string) error {
+	self.c.Prompt(types.PromptOpts{
+		Title:               self.c.Tr.CommandLogScriptFilePathTitle,
+		InitialContent:      "lazygit-session-" + self.c.State().GetSessionStartTime().Format("20060102-150405") + ".sh",
+		FindSuggestionsFunc: self.suggestionsHelper.GetFilePathSuggestionsFunc(),
+		HandleConfirm: func(path string) error {
+			self.c.LogAction(self.c.Tr.Actions.WriteCommandLogScriptToFile)
+			if err := self.c.OS().CreateFileWithContent(path, script); err != nil {
+				return err
+			}
+
+			self.c.Toast(utils.ResolvePlaceholderString(
+				self.c.Tr.CommandLogScriptWrittenToFile,
+				map[string]string{"path": path},
+			))
+
+			return self.c.Refresh(types.RefreshOptions{Mode: types.ASYNC, Scope: []types.RefreshableView{types.FILES}})
+		},
+	})
+
+	return nil
+}
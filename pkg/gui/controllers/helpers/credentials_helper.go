@@ -1,6 +1,8 @@
 package helpers
 
 import (
+	"strings"
+
 	"github.com/jesseduffield/lazygit/pkg/commands/oscommands"
 	"github.com/jesseduffield/lazygit/pkg/gui/types"
 )
@@ -21,11 +23,37 @@ func NewCredentialsHelper(
 // We return a channel rather than returning the string directly so that the calling function knows
 // when the prompt has been created (before the user has entered anything) so that it can
 // note that we're now waiting on user input and lazygit isn't processing anything.
-func (self *CredentialsHelper) PromptUserForCredential(passOrUname oscommands.CredentialType) <-chan string {
+func (self *CredentialsHelper) PromptUserForCredential(request oscommands.CredentialRequest) <-chan string {
 	ch := make(chan string)
 
 	self.c.OnUIThread(func() error {
-		title, mask := self.getTitleAndMask(passOrUname)
+		switch request.Type {
+		case oscommands.Notification:
+			self.c.Toast(strings.TrimSpace(request.Prompt))
+			ch <- "\n"
+			return nil
+		case oscommands.Confirmation:
+			self.c.Confirm(types.ConfirmOpts{
+				Title:  self.c.Tr.CredentialsConfirmation,
+				Prompt: strings.TrimSpace(request.Prompt),
+				HandleConfirm: func() error {
+					ch <- "yes\n"
+					return nil
+				},
+				HandleClose: func() error {
+					ch <- "no\n"
+					return nil
+				},
+			})
+			return nil
+		}
+
+		title, mask := self.getTitleAndMask(request.Type)
+		// if we know the exact prompt (e.g. "Password for 'https://github.com':")
+		// we show that, because it tells the user what the credential is for
+		if prompt := strings.TrimSpace(request.Prompt); prompt != "" {
+			title = prompt
+		}
 
 		self.c.Prompt(types.PromptOpts{
 			Title: title,
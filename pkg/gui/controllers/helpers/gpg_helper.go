@@ -4,8 +4,10 @@ import (
 	"fmt"
 
 	"github.com/jesseduffield/gocui"
+	"github.com/jesseduffield/lazygit/pkg/commands/git_commands"
 	"github.com/jesseduffield/lazygit/pkg/commands/oscommands"
 	"github.com/jesseduffield/lazygit/pkg/gui/types"
+	"github.com/samber/lo"
 )
 
 type GpgHelper struct {
@@ -23,7 +25,19 @@ func NewGpgHelper(c *HelperCommon) *GpgHelper {
 // fix this bug, or just stop running subprocesses from within there, given that
 // we don't need to see a loading status if we're in a subprocess.
 func (self *GpgHelper) WithGpgHandling(cmdObj oscommands.ICmdObj, waitingStatus string, onSuccess func() error) error {
-	useSubprocess := self.c.Git().Config.UsingGpg()
+	return self.withGpgHandling(self.c.Git().Config.UsingGpg(), cmdObj, waitingStatus, onSuccess)
+}
+
+// WithTagGpgHandling is like WithGpgHandling, but for creating tags. Pass
+// sign=true if the command explicitly asks git to sign the tag.
+func (self *GpgHelper) WithTagGpgHandling(cmdObj oscommands.ICmdObj, sign bool, waitingStatus string, onSuccess func() error) error {
+	useSubprocess := lo.Ternary(sign,
+		self.c.Git().Config.NeedsGpgSubprocessForSigning(),
+		self.c.Git().Config.NeedsGpgSubprocess(git_commands.TagGpgSign))
+	return self.withGpgHandling(useSubprocess, cmdObj, waitingStatus, onSuccess)
+}
+
+func (self *GpgHelper) withGpgHandling(useSubprocess bool, cmdObj oscommands.ICmdObj, waitingStatus string, onSuccess func() error) error {
 	if useSubprocess {
 		success, err := self.c.RunSubprocess(cmdObj)
 		if success && onSuccess != nil {
@@ -52,6 +52,12 @@ type Helpers struct {
 	Search            *SearchHelper
 	Worktree          *WorktreeHelper
 	SubCommits        *SubCommitsHelper
+	Changelog         *ChangelogHelper
+	CommandLog        *CommandLogHelper
+	LossPreview       *LossPreviewHelper
+	ProtectedBranches *ProtectedBranchesHelper
+	Submodules        *SubmodulesHelper
+	StaleBranches     *StaleBranchesHelper
 }
 
 func NewStubHelpers() *Helpers {
@@ -66,6 +72,10 @@ func NewStubHelpers() *Helpers {
 		MergeConflicts:    &MergeConflictsHelper{},
 		CherryPick:        &CherryPickHelper{},
 		Host:              &HostHelper{},
+		Changelog:         &ChangelogHelper{},
+		CommandLog:        &CommandLogHelper{},
+		LossPreview:       &LossPreviewHelper{},
+		ProtectedBranches: &ProtectedBranchesHelper{},
 		PatchBuilding:     &PatchBuildingHelper{},
 		Staging:           &StagingHelper{},
 		GPG:               &GpgHelper{},
@@ -89,5 +99,7 @@ func NewStubHelpers() *Helpers {
 		Search:            &SearchHelper{},
 		Worktree:          &WorktreeHelper{},
 		SubCommits:        &SubCommitsHelper{},
+		Submodules:        &SubmodulesHelper{},
+		StaleBranches:     &StaleBranchesHelper{},
 	}
 }
@@ -0,0 +1,92 @@
+package helpers
+
+import (
+	"github.com/jesseduffield/gocui"
+	"github.com/jesseduffield/lazygit/pkg/commands/models"
+	"github.com/jesseduffield/lazygit/pkg/gui/presentation"
+	"github.com/jesseduffield/lazygit/pkg/gui/types"
+	"github.com/jesseduffield/lazygit/pkg/utils"
+)
+
+// Shows what destructive operations (hard resets, deleting unmerged branches,
+// force pushes) would lose in their confirmation popups, before doing them.
+
+type LossPreviewHelper struct {
+	c *HelperCommon
+}
+
+func NewLossPreviewHelper(c *HelperCommon) *LossPreviewHelper {
+	return &LossPreviewHelper{
+		c: c,
+	}
+}
+
+// PromptWithPreview appends the preview (or the error we got computing it) to
+// the prompt. The returned bool is false if the operation doesn't lose
+// anything.
+func (self *LossPreviewHelper) PromptWithPreview(
+	prompt string,
+	preview *models.LossPreview,
+	err error,
+	commitsHeading string,
+) (string, bool) {
+	if err != nil {
+		self.c.Log.Error(err)
+		return prompt + "\n\n" + utils.ResolvePlaceholderString(
+			self.c.Tr.LossPreviewFailed,
+			map[string]string{"error": err.Error()},
+		), true
+	}
+
+	if preview.IsEmpty() {
+		return prompt, false
+	}
+
+	return prompt + "\n\n" + presentation.GetLossPreviewText(preview, commitsHeading, self.c.Tr), true
+}
+
+// WithPreview computes the preview in the background, because that runs git
+// commands which can take a while in a big repo, and then calls f with it on
+// the UI thread
+func (self *LossPreviewHelper) WithPreview(
+	getPreview func() (*models.LossPreview, error),
+	f func(preview *models.LossPreview, err error) error,
+) error {
+	return self.c.WithWaitingStatus(self.c.Tr.LossPreviewStatus, func(gocui.Task) error {
+		preview, err := getPreview()
+		self.c.OnUIThread(func() error {
+			return f(preview, err)
+		})
+		return nil
+	})
+}
+
+// ConfirmWithPreview is like ConfirmIfLosingAnything, but computes the preview
+// first (see WithPreview)
+func (self *LossPreviewHelper) ConfirmWithPreview(
+	opts types.ConfirmOpts,
+	getPreview func() (*models.LossPreview, error),
+	commitsHeading string,
+) error {
+	return self.WithPreview(getPreview, func(preview *models.LossPreview, err error) error {
+		return self.ConfirmIfLosingAnything(opts, preview, err, commitsHeading)
+	})
+}
+
+// ConfirmIfLosingAnything asks for confirmation with a preview of what will be
+// lost, or goes ahead right away if nothing will
+func (self *LossPreviewHelper) ConfirmIfLosingAnything(
+	opts types.ConfirmOpts,
+	preview *models.LossPreview,
+	err error,
+	commitsHeading string,
+) error {
+	prompt, losesAnything := self.PromptWithPreview(opts.Prompt, preview, err, commitsHeading)
+	if !losesAnything {
+		return opts.HandleConfirm()
+	}
+
+	opts.Prompt = prompt
+	self.c.Confirm(opts)
+	return nil
+}
@@ -18,17 +18,20 @@ import (
 )
 
 type MergeAndRebaseHelper struct {
-	c          *HelperCommon
-	refsHelper *RefsHelper
+	c                       *HelperCommon
+	refsHelper              *RefsHelper
+	protectedBranchesHelper *ProtectedBranchesHelper
 }
 
 func NewMergeAndRebaseHelper(
 	c *HelperCommon,
 	refsHelper *RefsHelper,
+	protectedBranchesHelper *ProtectedBranchesHelper,
 ) *MergeAndRebaseHelper {
 	return &MergeAndRebaseHelper{
-		c:          c,
-		refsHelper: refsHelper,
+		c:                       c,
+		refsHelper:              refsHelper,
+		protectedBranchesHelper: protectedBranchesHelper,
 	}
 }
 
@@ -388,6 +391,13 @@ func (self *MergeAndRebaseHelper) RebaseOntoRef(ref string) error {
 		},
 	}
 
+	for _, menuItem := range menuItems {
+		onPress := menuItem.OnPress
+		menuItem.OnPress = func() error {
+			return self.protectedBranchesHelper.GuardCheckedOutBranch(ProtectedBranchRebase, onPress)
+		}
+	}
+
 	title := utils.ResolvePlaceholderString(
 		lo.Ternary(self.c.Modes().MarkedBaseCommit.GetHash() != "",
 			self.c.Tr.RebasingFromBaseCommitTitle,
@@ -0,0 +1,141 @@
+package helpers
+
+import (
+	"errors"
+	"path"
+
+	"github.com/jesseduffield/lazygit/pkg/gui/types"
+	"github.com/jesseduffield/lazygit/pkg/utils"
+	"github.com/samber/lo"
+)
+
+// Enforces the git.protectedBranches config: operations that affect a
+// protected branch are either blocked, or need the user to type the name of
+// the branch to go ahead.
+
+type ProtectedBranchOperation int
+
+const (
+	ProtectedBranchForcePush ProtectedBranchOperation = iota
+	ProtectedBranchReset
+	ProtectedBranchRebase
+	ProtectedBranchCommit
+	ProtectedBranchDelete
+)
+
+type ProtectedBranchesHelper struct {
+	c *HelperCommon
+}
+
+func NewProtectedBranchesHelper(c *HelperCommon) *ProtectedBranchesHelper {
+	return &ProtectedBranchesHelper{
+		c: c,
+	}
+}
+
+// IsProtected returns true if the (local) branch name matches one of the
+// configured patterns
+func (self *ProtectedBranchesHelper) IsProtected(branchName string) bool {
+	return lo.SomeBy(self.c.UserConfig().Git.ProtectedBranches.Patterns, func(pattern string) bool {
+		matched, _ := path.Match(pattern, branchName)
+		return matched
+	})
+}
+
+// Guard calls f unless the operation affects one of the given branches that
+// is protected. Depending on the config for the operation we then either
+// return an error, or ask the user to type the name of each protected branch
+// before calling f.
+func (self *ProtectedBranchesHelper) Guard(operation ProtectedBranchOperation, branchNames []string, f func() error) error {
+	protectedBranchNames := lo.Uniq(lo.Filter(branchNames, func(branchName string, _ int) bool {
+		return branchName != "" && self.IsProtected(branchName)
+	}))
+	if len(protectedBranchNames) == 0 {
+		return f()
+	}
+
+	switch self.policy(operation) {
+	case "block":
+		return errors.New(utils.ResolvePlaceholderString(self.c.Tr.ProtectedBranchBlocked, map[string]string{
+			"operation": self.describe(operation),
+			"branch":    protectedBranchNames[0],
+		}))
+	case "confirm":
+		return self.confirm(operation, protectedBranchNames, f)
+	default:
+		return f()
+	}
+}
+
+// GuardCheckedOutBranch is like Guard for operations that affect the
+// checked-out branch. When we're on a detached head (which includes being
+// in the middle of a rebase that was already guarded when it started) there
+// is no branch to protect.
+func (self *ProtectedBranchesHelper) GuardCheckedOutBranch(operation ProtectedBranchOperation, f func() error) error {
+	branches := self.c.Model().Branches
+	if len(branches) == 0 || branches[0].DetachedHead {
+		return f()
+	}
+
+	return self.Guard(operation, []string{branches[0].Name}, f)
+}
+
+func (self *ProtectedBranchesHelper) confirm(operation ProtectedBranchOperation, branchNames []string, f func() error) error {
+	if len(branchNames) == 0 {
+		return f()
+	}
+
+	branchName := branchNames[0]
+	self.c.Prompt(types.PromptOpts{
+		Title: utils.ResolvePlaceholderString(self.c.Tr.ProtectedBranchConfirmTitle, map[string]string{
+			"operation": self.describe(operation),
+			"branch":    branchName,
+		}),
+		HandleConfirm: func(input string) error {
+			if input != branchName {
+				return errors.New(utils.ResolvePlaceholderString(self.c.Tr.ProtectedBranchConfirmationMismatch, map[string]string{
+					"branch": branchName,
+				}))
+			}
+
+			return self.confirm(operation, branchNames[1:], f)
+		},
+	})
+
+	return nil
+}
+
+func (self *ProtectedBranchesHelper) policy(operation ProtectedBranchOperation) string {
+	config := self.c.UserConfig().Git.ProtectedBranches
+	switch operation {
+	case ProtectedBranchForcePush:
+		return config.ForcePush
+	case ProtectedBranchReset:
+		return config.Reset
+	case ProtectedBranchRebase:
+		return config.Rebase
+	case ProtectedBranchCommit:
+		return config.Commit
+	case ProtectedBranchDelete:
+		return config.Delete
+	}
+
+	panic("unknown protected branch operation")
+}
+
+func (self *ProtectedBranchesHelper) describe(operation ProtectedBranchOperation) string {
+	switch operation {
+	case ProtectedBranchForcePush:
+		return self.c.Tr.ProtectedBranchOperationForcePush
+	case ProtectedBranchReset:
+		return self.c.Tr.ProtectedBranchOperationReset
+	case ProtectedBranchRebase:
+		return self.c.Tr.ProtectedBranchOperationRebase
+	case ProtectedBranchCommit:
+		return self.c.Tr.ProtectedBranchOperationCommit
+	case ProtectedBranchDelete:
+		return self.c.Tr.ProtectedBranchOperationDelete
+	}
+
+	panic("unknown protected branch operation")
+}
@@ -1,8 +1,10 @@
 package helpers
 
 import (
+	"strconv"
 	"strings"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/jesseduffield/generics/set"
@@ -28,6 +30,13 @@ type RefreshHelper struct {
 	mergeConflictsHelper *MergeConflictsHelper
 	worktreeHelper       *WorktreeHelper
 	searchHelper         *SearchHelper
+
+	// true if files were refreshed since we last loaded submodule statuses
+	submoduleStatusesOutdated atomic.Bool
+
+	// the checked-out commit as of the last files refresh; guarded by
+	// RefreshingFilesMutex
+	filesHeadHash string
 }
 
 func NewRefreshHelper(
@@ -151,7 +160,7 @@ func (self *RefreshHelper) Refresh(options types.RefreshOptions) error {
 		if scopeSet.Includes(types.FILES) || scopeSet.Includes(types.SUBMODULES) {
 			fileWg.Add(1)
 			refresh("files", func() {
-				_ = self.refreshFilesAndSubmodules()
+				_ = self.refreshFilesAndSubmodules(scopeSet.Includes(types.SUBMODULES) || self.submodulesPanelShown())
 				fileWg.Done()
 			})
 		}
@@ -430,17 +439,46 @@ func (self *RefreshHelper) refreshTags() error {
 	return nil
 }
 
-func (self *RefreshHelper) refreshStateSubmoduleConfigs() error {
+// Loading the statuses of submodules runs git status in each of them, so we
+// only do it when the submodules scope is refreshed or the submodules panel is
+// showing; otherwise we keep the statuses we loaded last time.
+func (self *RefreshHelper) refreshStateSubmoduleConfigs(loadStatuses bool) error {
 	configs, err := self.c.Git().Submodule.GetConfigs(nil)
 	if err != nil {
 		return err
 	}
 
+	self.submoduleStatusesOutdated.Store(!loadStatuses)
+
+	if loadStatuses {
+		if err := self.c.Git().Submodule.LoadStatuses(configs); err != nil {
+			self.c.Log.Error(err)
+		}
+	} else {
+		prevStatuses := map[string]*models.SubmoduleStatus{}
+		for _, submodule := range self.c.Model().Submodules {
+			prevStatuses[submodule.FullPath()] = submodule.Status
+		}
+		for _, submodule := range configs {
+			submodule.Status = prevStatuses[submodule.FullPath()]
+		}
+	}
+
 	self.c.Model().Submodules = configs
 
 	return nil
 }
 
+func (self *RefreshHelper) SubmoduleStatusesOutdated() bool {
+	return self.submoduleStatusesOutdated.Load()
+}
+
+func (self *RefreshHelper) submodulesPanelShown() bool {
+	submodules := self.c.Contexts().Submodules
+	viewName, _ := self.c.State().GetRepoState().GetWindowViewNameMap().Get(submodules.GetWindowName())
+	return viewName == submodules.GetViewName()
+}
+
 // self.refreshStatus is called at the end of this because that's when we can
 // be sure there is a State.Model.Branches array to pick the current branch from
 func (self *RefreshHelper) refreshBranches(refreshWorktrees bool, keepBranchSelectionIndex bool, loadBehindCounts bool) {
@@ -509,7 +547,7 @@ func (self *RefreshHelper) refreshBranches(refreshWorktrees bool, keepBranchSele
 	self.refreshStatus()
 }
 
-func (self *RefreshHelper) refreshFilesAndSubmodules() error {
+func (self *RefreshHelper) refreshFilesAndSubmodules(loadSubmoduleStatuses bool) error {
 	self.c.Mutexes().RefreshingFilesMutex.Lock()
 	self.c.State().SetIsRefreshingFiles(true)
 	defer func() {
@@ -517,7 +555,7 @@ func (self *RefreshHelper) refreshFilesAndSubmodules() error {
 		self.c.Mutexes().RefreshingFilesMutex.Unlock()
 	}()
 
-	if err := self.refreshStateSubmoduleConfigs(); err != nil {
+	if err := self.refreshStateSubmoduleConfigs(loadSubmoduleStatuses); err != nil {
 		return err
 	}
 
@@ -528,6 +566,10 @@ func (self *RefreshHelper) refreshFilesAndSubmodules() error {
 	self.c.OnUIThread(func() error {
 		self.refreshView(self.c.Contexts().Submodules)
 		self.refreshView(self.c.Contexts().Files)
+		if self.c.UserConfig().Gui.ShowWorktreeStatus {
+			self.setCurrentWorktreeStatus()
+			self.refreshView(self.c.Contexts().Worktrees)
+		}
 		return nil
 	})
 
@@ -569,9 +611,32 @@ func (self *RefreshHelper) refreshStateFiles() error {
 		}
 	}
 
+	// In a huge working tree, `git status` takes a while. If we didn't have
+	// any files yet, or a different commit is checked out now (so that the
+	// files we have are probably all wrong), we show what we have so far
+	// rather than waiting for it to finish. Otherwise we keep showing the
+	// previous files until then, rather than a partial list.
+	headHash, _ := self.c.Git().Commit.HeadHash()
+	var onBatch func(files []*models.File)
+	if len(self.c.Model().Files) == 0 || headHash != self.filesHeadHash {
+		onBatch = func(files []*models.File) {
+			fileTreeViewModel.RWMutex.Lock()
+			self.c.Model().Files = files
+			fileTreeViewModel.SetTree()
+			fileTreeViewModel.RWMutex.Unlock()
+
+			self.c.OnUIThread(func() error {
+				self.refreshView(self.c.Contexts().Files)
+				return nil
+			})
+		}
+	}
+	self.filesHeadHash = headHash
+
 	files := self.c.Git().Loaders.FileLoader.
 		GetStatusFiles(git_commands.GetStatusFileOptions{
 			ForceShowUntracked: self.c.Contexts().Files.ForceShowUntracked(),
+			OnBatch:            onBatch,
 		})
 
 	conflictFileCount := 0
@@ -679,15 +744,59 @@ func (self *RefreshHelper) refreshRemotes() error {
 }
 
 func (self *RefreshHelper) loadWorktrees() {
-	worktrees, err := self.c.Git().Loaders.Worktrees.GetWorktrees()
+	worktrees, err := self.c.Git().Loaders.Worktrees.GetWorktrees(self.c.UserConfig().Gui.ShowWorktreeStatus)
 	if err != nil {
 		self.c.Log.Error(err)
 		self.c.Model().Worktrees = []*models.Worktree{}
 	}
 
+	// The status of the current worktree is derived from the files, so it's
+	// only recomputed after refreshing them; until then keep the previous one.
+	if previous, ok := lo.Find(self.c.Model().Worktrees, func(worktree *models.Worktree) bool {
+		return worktree.IsCurrent
+	}); ok {
+		for _, worktree := range worktrees {
+			if worktree.IsCurrent {
+				worktree.Status = previous.Status
+			}
+		}
+	}
+
 	self.c.Model().Worktrees = worktrees
 }
 
+// The worktree loader doesn't compute the status of the current worktree,
+// because we can derive it from the files and branches we've already loaded.
+func (self *RefreshHelper) setCurrentWorktreeStatus() {
+	if !self.c.UserConfig().Gui.ShowWorktreeStatus {
+		return
+	}
+
+	worktree, ok := lo.Find(self.c.Model().Worktrees, func(worktree *models.Worktree) bool {
+		return worktree.IsCurrent
+	})
+	if !ok {
+		return
+	}
+
+	status := &models.WorktreeStatus{
+		DirtyFileCount:   len(self.c.Model().Files),
+		WorkingTreeState: self.c.Git().Status.WorkingTreeState(),
+	}
+
+	if branch := self.refsHelper.GetCheckedOutRef(); branch != nil && branch.RemoteBranchStoredLocally() {
+		ahead, aheadErr := strconv.Atoi(branch.AheadForPull)
+		behind, behindErr := strconv.Atoi(branch.BehindForPull)
+		if aheadErr == nil && behindErr == nil {
+			status.HasUpstream = true
+			status.Ahead = ahead
+			status.Behind = behind
+		}
+	}
+
+	worktree.Status = status
+}
+
 func (self *RefreshHelper) refreshWorktrees() {
 	self.loadWorktrees()
 
@@ -721,6 +830,9 @@ func (self *RefreshHelper) refreshStatus() {
 	repoName := self.c.Git().RepoPaths.RepoName()
 
 	status := presentation.FormatStatus(repoName, currentBranch, types.ItemOperationNone, linkedWorktreeName, workingTreeState, self.c.Tr, self.c.UserConfig())
+	if cloneState := presentation.FormatCloneState(self.c.Git().Status.IsShallowRepo(), self.c.Git().Status.IsPartialClone(), self.c.Tr); cloneState != "" {
+		status += " " + cloneState
+	}
 
 	self.c.SetViewContent(self.c.Views().Status, status)
 }
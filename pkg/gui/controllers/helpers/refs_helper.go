@@ -24,14 +24,20 @@ type IRefsHelper interface {
 }
 
 type RefsHelper struct {
-	c *HelperCommon
+	c                       *HelperCommon
+	lossPreviewHelper       *LossPreviewHelper
+	protectedBranchesHelper *ProtectedBranchesHelper
 }
 
 func NewRefsHelper(
 	c *HelperCommon,
+	lossPreviewHelper *LossPreviewHelper,
+	protectedBranchesHelper *ProtectedBranchesHelper,
 ) *RefsHelper {
 	return &RefsHelper{
-		c: c,
+		c:                       c,
+		lossPreviewHelper:       lossPreviewHelper,
+		protectedBranchesHelper: protectedBranchesHelper,
 	}
 }
 
@@ -196,7 +202,9 @@ func (self *RefsHelper) ResetToRef(ref string, strength string, envVars []string
 	return nil
 }
 
-func (self *RefsHelper) CreateSortOrderMenu(sortOptionsOrder []string, onSelected func(sortOrder string) error, currentValue string) error {
+// dateSortKey is the field that the "date" option sorts by, e.g. committerdate
+// for branches or creatordate for tags
+func (self *RefsHelper) CreateSortOrderMenu(sortOptionsOrder []string, dateSortKey string, onSelected func(sortOrder string) error, currentValue string) error {
 	type sortMenuOption struct {
 		key         types.Key
 		label       string
@@ -206,7 +214,8 @@ func (self *RefsHelper) CreateSortOrderMenu(sortOptionsOrder []string, onSelecte
 	availableSortOptions := map[string]sortMenuOption{
 		"recency":      {label: self.c.Tr.SortByRecency, description: self.c.Tr.SortBasedOnReflog, key: 'r'},
 		"alphabetical": {label: self.c.Tr.SortAlphabetical, description: "--sort=refname", key: 'a'},
-		"date":         {label: self.c.Tr.SortByDate, description: "--sort=-committerdate", key: 'd'},
+		"date":         {label: self.c.Tr.SortByDate, description: "--sort=-" + dateSortKey, key: 'd'},
+		"version":      {label: self.c.Tr.SortByVersion, description: "--sort=-version:refname", key: 'v'},
 	}
 	sortOptions := make([]sortMenuOption, 0, len(sortOptionsOrder))
 	for _, key := range sortOptionsOrder {
@@ -258,8 +267,23 @@ func (self *RefsHelper) CreateGitResetMenu(ref string) error {
 				style.FgRed.Sprintf("reset --%s %s", row.strength, ref),
 			},
 			OnPress: func() error {
-				self.c.LogAction("Reset")
-				return self.ResetToRef(ref, row.strength, []string{})
+				return self.protectedBranchesHelper.GuardCheckedOutBranch(ProtectedBranchReset, func() error {
+					reset := func() error {
+						self.c.LogAction("Reset")
+						return self.ResetToRef(ref, row.strength, []string{})
+					}
+					if row.strength != "hard" {
+						return reset()
+					}
+
+					return self.lossPreviewHelper.ConfirmWithPreview(types.ConfirmOpts{
+						Title:         self.c.Tr.HardReset,
+						Prompt:        utils.ResolvePlaceholderString(self.c.Tr.HardResetPrompt, map[string]string{"ref": ref}),
+						HandleConfirm: reset,
+					}, func() (*models.LossPreview, error) {
+						return self.c.Git().WorkingTree.ResetHardPreview(ref)
+					}, self.c.Tr.LossPreviewLostCommits)
+				})
 			},
 			Key:     row.key,
 			Tooltip: row.tooltip,
@@ -5,12 +5,14 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"strconv"
 	"strings"
 	"sync"
 
 	"github.com/jesseduffield/gocui"
 	appTypes "github.com/jesseduffield/lazygit/pkg/app/types"
 	"github.com/jesseduffield/lazygit/pkg/commands"
+	"github.com/jesseduffield/lazygit/pkg/commands/git_commands"
 	"github.com/jesseduffield/lazygit/pkg/commands/models"
 	"github.com/jesseduffield/lazygit/pkg/env"
 	"github.com/jesseduffield/lazygit/pkg/gui/context"
@@ -180,3 +182,192 @@ func (self *ReposHelper) DispatchSwitchTo(path string, errMsg string, contextKey
 		return self.onNewRepo(appTypes.StartArgs{}, contextKey)
 	})
 }
+
+// CloneRepository asks for the URL to clone and the directory to clone into,
+// lets the user pick the clone options and then switches to the new repo
+func (self *ReposHelper) CloneRepository() error {
+	self.c.Prompt(types.PromptOpts{
+		Title:               self.c.Tr.CloneURLPrompt,
+		FindSuggestionsFunc: self.getCloneURLSuggestionsFunc(),
+		HandleConfirm: func(url string) error {
+			url = strings.TrimSpace(url)
+			if url == "" {
+				return nil
+			}
+
+			return self.promptForCloneDirectory(url)
+		},
+	})
+
+	return nil
+}
+
+// Suggests the URLs of the current repo's remotes right away, and adds those
+// of the remotes of the recently opened repos once they've been loaded in the
+// background, since that means opening each of those repos
+func (self *ReposHelper) getCloneURLSuggestionsFunc() func(string) []*types.Suggestion {
+	mutex := sync.Mutex{}
+	urls := lo.Uniq(lo.FlatMap(self.c.Model().Remotes, func(remote *models.Remote, _ int) []string {
+		return remote.Urls
+	}))
+
+	self.c.OnWorker(func(gocui.Task) error {
+		recentURLs := self.recentRemoteURLs()
+
+		mutex.Lock()
+		urls = lo.Uniq(append(urls, recentURLs...))
+		mutex.Unlock()
+
+		self.c.Contexts().Suggestions.RefreshSuggestions()
+		return nil
+	})
+
+	return func(input string) []*types.Suggestion {
+		mutex.Lock()
+		defer mutex.Unlock()
+
+		return FilterFunc(urls, self.c.UserConfig().Gui.UseFuzzySearch())(input)
+	}
+}
+
+// Returns the URLs of the remotes of the recently opened repos
+func (self *ReposHelper) recentRemoteURLs() []string {
+	return lo.FlatMap(self.c.GetAppState().RecentRepos, func(path string, _ int) []string {
+		return self.c.Git().Remote.RemoteURLsOfRepo(path)
+	})
+}
+
+func (self *ReposHelper) promptForCloneDirectory(url string) error {
+	// by default we clone next to the current repo
+	parentDir := filepath.Dir(self.c.Git().RepoPaths.RepoPath())
+
+	self.c.Prompt(types.PromptOpts{
+		Title:          self.c.Tr.CloneDirectoryPrompt,
+		InitialContent: filepath.Join(parentDir, git_commands.RepoNameFromURL(url)),
+		HandleConfirm: func(dir string) error {
+			dir = strings.TrimSpace(dir)
+			if dir == "" {
+				return nil
+			}
+			if !filepath.IsAbs(dir) {
+				dir = filepath.Join(parentDir, dir)
+			}
+
+			return self.openCloneOptionsMenu(git_commands.CloneOpts{URL: url, Directory: dir})
+		},
+	})
+
+	return nil
+}
+
+func (self *ReposHelper) openCloneOptionsMenu(opts git_commands.CloneOpts) error {
+	depth := lo.Ternary(opts.Depth > 0, strconv.Itoa(opts.Depth), self.c.Tr.CloneFullHistory)
+	branch := lo.Ternary(opts.Branch != "", opts.Branch, self.c.Tr.CloneDefaultBranch)
+	filter := lo.Ternary(opts.Filter != "", opts.Filter, self.c.Tr.CloneNoFilter)
+
+	return self.c.Menu(types.CreateMenuOptions{
+		Title: utils.ResolvePlaceholderString(self.c.Tr.CloneOptionsTitle, map[string]string{"url": opts.URL}),
+		Items: []*types.MenuItem{
+			{
+				LabelColumns: []string{self.c.Tr.CloneRepositoryAction, style.FgMagenta.Sprint(opts.Directory)},
+				Key:          'c',
+				OnPress:      func() error { return self.clone(opts) },
+			},
+			{
+				LabelColumns: []string{self.c.Tr.CloneDepth, style.FgCyan.Sprint(depth)},
+				Key:          'd',
+				OnPress:      func() error { return self.promptForCloneDepth(opts) },
+			},
+			{
+				LabelColumns: []string{self.c.Tr.CloneBranch, style.FgCyan.Sprint(branch)},
+				Key:          'b',
+				OnPress:      func() error { return self.promptForCloneBranch(opts) },
+			},
+			{
+				LabelColumns: []string{self.c.Tr.CloneFilter, style.FgCyan.Sprint(filter)},
+				Key:          'f',
+				OpensMenu:    true,
+				OnPress:      func() error { return self.openCloneFilterMenu(opts) },
+			},
+		},
+	})
+}
+
+func (self *ReposHelper) promptForCloneDepth(opts git_commands.CloneOpts) error {
+	initialContent := ""
+	if opts.Depth > 0 {
+		initialContent = strconv.Itoa(opts.Depth)
+	}
+
+	self.c.Prompt(types.PromptOpts{
+		Title:          self.c.Tr.CloneDepthPrompt,
+		InitialContent: initialContent,
+		HandleConfirm: func(response string) error {
+			response = strings.TrimSpace(response)
+			if response == "" {
+				opts.Depth = 0
+				return self.openCloneOptionsMenu(opts)
+			}
+
+			depth, err := strconv.Atoi(response)
+			if err != nil || depth < 1 {
+				return errors.New(self.c.Tr.InvalidFetchDepth)
+			}
+
+			opts.Depth = depth
+			return self.openCloneOptionsMenu(opts)
+		},
+	})
+
+	return nil
+}
+
+func (self *ReposHelper) promptForCloneBranch(opts git_commands.CloneOpts) error {
+	self.c.Prompt(types.PromptOpts{
+		Title:          self.c.Tr.CloneBranchPrompt,
+		InitialContent: opts.Branch,
+		HandleConfirm: func(response string) error {
+			opts.Branch = strings.TrimSpace(response)
+			return self.openCloneOptionsMenu(opts)
+		},
+	})
+
+	return nil
+}
+
+func (self *ReposHelper) openCloneFilterMenu(opts git_commands.CloneOpts) error {
+	filterItem := func(label string, filter string, tooltip string) *types.MenuItem {
+		return &types.MenuItem{
+			LabelColumns: []string{label, style.FgCyan.Sprint(filter)},
+			Tooltip:      tooltip,
+			Widget:       types.MakeMenuRadioButton(opts.Filter == filter),
+			OnPress: func() error {
+				opts.Filter = filter
+				return self.openCloneOptionsMenu(opts)
+			},
+		}
+	}
+
+	return self.c.Menu(types.CreateMenuOptions{
+		Title: self.c.Tr.CloneFilter,
+		Items: []*types.MenuItem{
+			filterItem(self.c.Tr.CloneNoFilter, "", self.c.Tr.CloneFilterNoneTooltip),
+			filterItem(self.c.Tr.CloneFilterBlobless, "blob:none", self.c.Tr.CloneFilterBloblessTooltip),
+			filterItem(self.c.Tr.CloneFilterTreeless, "tree:0", self.c.Tr.CloneFilterTreelessTooltip),
+		},
+	})
+}
+
+func (self *ReposHelper) clone(opts git_commands.CloneOpts) error {
+	return self.c.WithWaitingStatus(self.c.Tr.CloningStatus, func(task gocui.Task) error {
+		self.c.LogAction(self.c.Tr.Actions.CloneRepository)
+		if err := self.c.Git().Sync.Clone(task, opts); err != nil {
+			return err
+		}
+
+		// like when picking a recent repo, we forget about any submodule we
+		// were in so that hitting escape in the new repo does nothing
+		self.c.State().GetRepoPathStack().Clear()
+		return self.DispatchSwitchToRepo(opts.Directory, context.NO_CONTEXT)
+	})
+}
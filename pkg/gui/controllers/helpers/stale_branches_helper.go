@@ -0,0 +1,318 @@
+package helpers
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/jesseduffield/gocui"
+	"github.com/jesseduffield/lazygit/pkg/commands/git_commands"
+	"github.com/jesseduffield/lazygit/pkg/commands/models"
+	"github.com/jesseduffield/lazygit/pkg/gui/style"
+	"github.com/jesseduffield/lazygit/pkg/gui/types"
+	"github.com/jesseduffield/lazygit/pkg/utils"
+	"github.com/samber/lo"
+)
+
+// Finds local branches that are probably no longer needed (merged into a main
+// branch, upstream deleted on the remote, or not committed to for a long time)
+// and offers to delete them in bulk.
+
+type StaleBranchesHelper struct {
+	c                       *HelperCommon
+	branchesHelper          *BranchesHelper
+	protectedBranchesHelper *ProtectedBranchesHelper
+}
+
+func NewStaleBranchesHelper(
+	c *HelperCommon,
+	branchesHelper *BranchesHelper,
+	protectedBranchesHelper *ProtectedBranchesHelper,
+) *StaleBranchesHelper {
+	return &StaleBranchesHelper{
+		c:                       c,
+		branchesHelper:          branchesHelper,
+		protectedBranchesHelper: protectedBranchesHelper,
+	}
+}
+
+type staleBranch struct {
+	branch        *models.Branch
+	isMerged      bool
+	ageInDays     int
+	isOld         bool
+	upstreamGone  bool
+	hasLiveRemote bool
+}
+
+func (self *StaleBranchesHelper) reasons(staleBranch *staleBranch) []string {
+	reasons := []string{}
+	if staleBranch.isMerged {
+		reasons = append(reasons, self.c.Tr.StaleBranchMerged)
+	}
+	if staleBranch.upstreamGone {
+		reasons = append(reasons, self.c.Tr.StaleBranchUpstreamGone)
+	}
+	if staleBranch.isOld {
+		reasons = append(reasons, utils.ResolvePlaceholderString(self.c.Tr.StaleBranchOld, map[string]string{
+			"days": fmt.Sprint(staleBranch.ageInDays),
+		}))
+	}
+	return reasons
+}
+
+func (self *StaleBranchesHelper) OpenCleanupMenu() error {
+	return self.c.WithWaitingStatus(self.c.Tr.FindingStaleBranchesStatus, func(gocui.Task) error {
+		staleBranches, err := self.findStaleBranches()
+		if err != nil {
+			return err
+		}
+
+		self.c.OnUIThread(func() error {
+			return self.showCleanupMenu(staleBranches)
+		})
+		return nil
+	})
+}
+
+func (self *StaleBranchesHelper) findStaleBranches() ([]*staleBranch, error) {
+	lastCommitDates, err := self.c.Git().Branch.GetLastCommitDates()
+	if err != nil {
+		return nil, err
+	}
+
+	mainBranchNames := lo.Map(self.c.Model().MainBranches.Get(), func(mainBranch string, _ int) string {
+		return ShortBranchName(mainBranch)
+	})
+	maxAgeInDays := self.c.UserConfig().Git.StaleBranchAgeDays
+	now := time.Now()
+
+	result := []*staleBranch{}
+	for _, branch := range self.c.Model().Branches {
+		if branch.Head || branch.DetachedHead || lo.Contains(mainBranchNames, branch.Name) ||
+			self.protectedBranchesHelper.IsProtected(branch.Name) ||
+			git_commands.CheckedOutByOtherWorktree(branch, self.c.Model().Worktrees) {
+			continue
+		}
+
+		isMerged, err := self.c.Git().Branch.IsMergedIntoMainBranches(branch, self.c.Model().MainBranches)
+		if err != nil {
+			return nil, err
+		}
+
+		candidate := &staleBranch{
+			branch:        branch,
+			isMerged:      isMerged,
+			upstreamGone:  branch.UpstreamGone,
+			hasLiveRemote: branch.IsTrackingRemote() && !branch.UpstreamGone,
+		}
+		if lastCommitDate, ok := lastCommitDates[branch.Name]; ok {
+			candidate.ageInDays = int(now.Sub(lastCommitDate).Hours() / 24)
+			candidate.isOld = maxAgeInDays > 0 && candidate.ageInDays > maxAgeInDays
+		}
+
+		if candidate.isMerged || candidate.upstreamGone || candidate.isOld {
+			result = append(result, candidate)
+		}
+	}
+
+	return result, nil
+}
+
+func (self *StaleBranchesHelper) showCleanupMenu(staleBranches []*staleBranch) error {
+	categoryItem := func(label string, key types.Key, filter func(*staleBranch) bool) *types.MenuItem {
+		branches := lo.Filter(staleBranches, func(staleBranch *staleBranch, _ int) bool {
+			return filter(staleBranch)
+		})
+
+		item := &types.MenuItem{
+			LabelColumns: []string{label, style.FgYellow.Sprint(len(branches))},
+			OnPress: func() error {
+				return self.showDeleteMenu(branches)
+			},
+			Key:       key,
+			OpensMenu: true,
+		}
+		if len(branches) == 0 {
+			item.DisabledReason = &types.DisabledReason{Text: self.c.Tr.NoStaleBranchesFound}
+		}
+		return item
+	}
+
+	oldItem := categoryItem(
+		utils.ResolvePlaceholderString(self.c.Tr.StaleBranchesOlderThan, map[string]string{
+			"days": fmt.Sprint(self.c.UserConfig().Git.StaleBranchAgeDays),
+		}),
+		'o',
+		func(staleBranch *staleBranch) bool { return staleBranch.isOld },
+	)
+	if self.c.UserConfig().Git.StaleBranchAgeDays == 0 {
+		oldItem.DisabledReason = &types.DisabledReason{Text: self.c.Tr.StaleBranchAgeDisabled}
+	}
+
+	return self.c.Menu(types.CreateMenuOptions{
+		Title: self.c.Tr.StaleBranchCleanupTitle,
+		Items: []*types.MenuItem{
+			categoryItem(self.c.Tr.StaleBranchesMerged, 'm', func(staleBranch *staleBranch) bool { return staleBranch.isMerged }),
+			categoryItem(self.c.Tr.StaleBranchesUpstreamGone, 'g', func(staleBranch *staleBranch) bool { return staleBranch.upstreamGone }),
+			oldItem,
+			categoryItem(self.c.Tr.AllStaleBranches, 'a', func(*staleBranch) bool { return true }),
+			{
+				Label:   self.c.Tr.PruneRemoteTrackingBranches,
+				Tooltip: self.c.Tr.PruneTrackingBranchesTooltip,
+				OnPress: self.pruneRemoteTrackingBranches,
+				Key:     'p',
+			},
+		},
+	})
+}
+
+func (self *StaleBranchesHelper) showDeleteMenu(staleBranches []*staleBranch) error {
+	withLiveRemote := lo.Filter(staleBranches, func(staleBranch *staleBranch, _ int) bool {
+		return staleBranch.hasLiveRemote
+	})
+
+	deleteBothItem := &types.MenuItem{
+		Label:   self.c.Tr.DeleteLocalAndRemoteBranches,
+		Tooltip: self.c.Tr.DeleteStaleLocalAndRemoteTooltip,
+		OnPress: func() error {
+			return self.confirmDelete(staleBranches, true)
+		},
+		Key: 'b',
+	}
+	if len(withLiveRemote) == 0 {
+		deleteBothItem.DisabledReason = &types.DisabledReason{Text: self.c.Tr.UpstreamsNotSetError}
+	}
+
+	return self.c.Menu(types.CreateMenuOptions{
+		Title: utils.ResolvePlaceholderString(self.c.Tr.DeleteStaleBranchesTitle, map[string]string{
+			"count": fmt.Sprint(len(staleBranches)),
+		}),
+		Items: []*types.MenuItem{
+			{
+				Label: self.c.Tr.DeleteLocalBranches,
+				OnPress: func() error {
+					return self.confirmDelete(staleBranches, false)
+				},
+				Key: 'c',
+			},
+			deleteBothItem,
+		},
+	})
+}
+
+// Asks for confirmation, showing the exact commands that are going to run
+func (self *StaleBranchesHelper) confirmDelete(staleBranches []*staleBranch, includeRemote bool) error {
+	branches := lo.Map(staleBranches, func(staleBranch *staleBranch, _ int) *models.Branch {
+		return staleBranch.branch
+	})
+	remoteBranches := []*models.RemoteBranch{}
+	if includeRemote {
+		remoteBranches = lo.FilterMap(staleBranches, func(staleBranch *staleBranch, _ int) (*models.RemoteBranch, bool) {
+			return &models.RemoteBranch{Name: staleBranch.branch.UpstreamBranch, RemoteName: staleBranch.branch.UpstreamRemote},
+				staleBranch.hasLiveRemote
+		})
+	}
+
+	commands := [][]string{}
+	remoteBranchesByRemote := lo.GroupBy(remoteBranches, func(branch *models.RemoteBranch) string { return branch.RemoteName })
+	for _, remoteName := range lo.Uniq(lo.Map(remoteBranches, func(branch *models.RemoteBranch, _ int) string { return branch.RemoteName })) {
+		commands = append(commands, []string{
+			fmt.Sprintf("git push %s --delete %s", remoteName, strings.Join(lo.Map(remoteBranchesByRemote[remoteName], func(branch *models.RemoteBranch, _ int) string {
+				return branch.Name
+			}), " ")),
+			"",
+		})
+	}
+	for _, staleBranch := range staleBranches {
+		commands = append(commands, []string{
+			"git branch -D " + staleBranch.branch.Name,
+			style.FgYellow.Sprint(strings.Join(self.reasons(staleBranch), ", ")),
+		})
+	}
+
+	renderedCommands, _ := utils.RenderDisplayStrings(commands, nil)
+	prompt := utils.ResolvePlaceholderString(self.c.Tr.DeleteStaleBranchesPrompt, map[string]string{
+		"commands": strings.Join(renderedCommands, "\n"),
+	})
+	// Branches that are only gone or old are deleted with -D even though they
+	// aren't merged, so make sure the user knows that they lose commits
+	unmergedCount := lo.CountBy(staleBranches, func(staleBranch *staleBranch) bool {
+		return !staleBranch.isMerged
+	})
+	if unmergedCount > 0 {
+		prompt = style.FgRed.Sprint(utils.ResolvePlaceholderString(self.c.Tr.StaleBranchesForceDeleteWarning, map[string]string{
+			"count": fmt.Sprint(unmergedCount),
+		})) + "\n\n" + prompt
+	}
+
+	return self.branchesHelper.confirmWithLocalDeletePreview(types.ConfirmOpts{
+		Title: utils.ResolvePlaceholderString(self.c.Tr.DeleteStaleBranchesTitle, map[string]string{
+			"count": fmt.Sprint(len(staleBranches)),
+		}),
+		Prompt: prompt,
+		HandleConfirm: func() error {
+			return self.c.WithWaitingStatus(self.c.Tr.DeletingStatus, func(task gocui.Task) error {
+				// Delete the remote branches first so that we keep the local
+				// ones in case of failure
+				if err := self.branchesHelper.deleteRemoteBranches(remoteBranches, task); err != nil {
+					return err
+				}
+
+				self.c.LogAction(self.c.Tr.Actions.DeleteStaleBranches)
+				branchNames := lo.Map(branches, func(branch *models.Branch, _ int) string { return branch.Name })
+				if err := self.c.Git().Branch.LocalDelete(branchNames, true); err != nil {
+					return err
+				}
+
+				return self.c.Refresh(types.RefreshOptions{Mode: types.ASYNC, Scope: []types.RefreshableView{types.BRANCHES, types.REMOTES}})
+			})
+		},
+	}, branches, remoteBranches)
+}
+
+func (self *StaleBranchesHelper) pruneRemoteTrackingBranches() error {
+	return self.c.WithWaitingStatus(self.c.Tr.FindingStaleBranchesStatus, func(task gocui.Task) error {
+		remotesToPrune := []string{}
+		staleBranches := []string{}
+		for _, remote := range self.c.Model().Remotes {
+			staleBranchesOfRemote, err := self.c.Git().Remote.StaleRemoteBranches(task, remote.Name)
+			if err != nil {
+				return err
+			}
+			if len(staleBranchesOfRemote) > 0 {
+				remotesToPrune = append(remotesToPrune, remote.Name)
+				staleBranches = append(staleBranches, staleBranchesOfRemote...)
+			}
+		}
+
+		self.c.OnUIThread(func() error {
+			if len(staleBranches) == 0 {
+				self.c.Toast(self.c.Tr.NoRemoteTrackingBranchesToPrune)
+				return nil
+			}
+
+			self.c.Confirm(types.ConfirmOpts{
+				Title: self.c.Tr.PruneRemoteTrackingBranches,
+				Prompt: utils.ResolvePlaceholderString(self.c.Tr.PruneTrackingBranchesPrompt, map[string]string{
+					"branches": strings.Join(lo.Map(staleBranches, func(branch string, _ int) string {
+						return "  " + branch
+					}), "\n"),
+				}),
+				HandleConfirm: func() error {
+					return self.c.WithWaitingStatus(self.c.Tr.PruningStatus, func(task gocui.Task) error {
+						self.c.LogAction(self.c.Tr.Actions.PruneRemoteTrackingBranches)
+						for _, remoteName := range remotesToPrune {
+							if err := self.c.Git().Remote.Prune(task, remoteName); err != nil {
+								return err
+							}
+						}
+						return self.c.Refresh(types.RefreshOptions{Mode: types.ASYNC, Scope: []types.RefreshableView{types.BRANCHES, types.REMOTES}})
+					})
+				},
+			})
+			return nil
+		})
+		return nil
+	})
+}
@@ -0,0 +1,406 @@
+package helpers
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/jesseduffield/gocui"
+	"github.com/jesseduffield/lazygit/pkg/commands/git_commands"
+	"github.com/jesseduffield/lazygit/pkg/commands/models"
+	"github.com/jesseduffield/lazygit/pkg/gui/style"
+	"github.com/jesseduffield/lazygit/pkg/gui/types"
+	"github.com/jesseduffield/lazygit/pkg/utils"
+	"github.com/samber/lo"
+)
+
+// Runs an operation (init, update, sync or fetch) on many submodules in
+// parallel, showing the progress of each one in the main view. Nested
+// submodules are processed once their parent has succeeded. Steps that write
+// the config of the parent repo (init and sync) are run one at a time, because
+// git fails if another process holds the lock on the config file.
+
+type SubmodulesHelper struct {
+	c *HelperCommon
+
+	// The results of the most recent run stay in the main view for as long
+	// as the submodule that was selected when it started remains selected.
+	// Only accessed on the UI thread.
+	lastRun *submoduleRun
+}
+
+func NewSubmodulesHelper(c *HelperCommon) *SubmodulesHelper {
+	return &SubmodulesHelper{
+		c: c,
+	}
+}
+
+type submoduleJobState int
+
+const (
+	submoduleJobPending submoduleJobState = iota
+	submoduleJobRunning
+	submoduleJobSucceeded
+	submoduleJobFailed
+	// the operation doesn't apply to the submodule, e.g. fetching one that
+	// isn't initialized
+	submoduleJobSkipped
+)
+
+type submoduleJob struct {
+	submodule *models.SubmoduleConfig
+	state     submoduleJobState
+	start     time.Time
+	duration  time.Duration
+	err       error
+	// the tail of the output of the job's commands, shown while it's running
+	output string
+}
+
+// We only need the last line of the output, but since it can arrive in
+// arbitrary chunks we keep a bit more than that
+const submoduleJobOutputLimit = 1024
+
+type submoduleRun struct {
+	operation    git_commands.SubmoduleOperation
+	selectedPath string
+	mutex        sync.Mutex
+	jobs         []*submoduleJob
+	// set while a render of the results is scheduled
+	renderPending atomic.Bool
+}
+
+// Streams the output of a job's commands into the results
+type submoduleJobOutputWriter struct {
+	helper *SubmodulesHelper
+	run    *submoduleRun
+	job    *submoduleJob
+}
+
+func (self *submoduleJobOutputWriter) Write(p []byte) (int, error) {
+	self.run.mutex.Lock()
+	output := self.job.output + string(p)
+	if len(output) > submoduleJobOutputLimit {
+		output = output[len(output)-submoduleJobOutputLimit:]
+	}
+	self.job.output = output
+	self.run.mutex.Unlock()
+
+	self.helper.scheduleRender(self.run)
+	return len(p), nil
+}
+
+type submoduleOperationTexts struct {
+	title  string
+	status string
+	action string
+}
+
+func (self *SubmodulesHelper) texts(operation git_commands.SubmoduleOperation) submoduleOperationTexts {
+	switch operation {
+	case git_commands.SubmoduleOperationInit:
+		return submoduleOperationTexts{self.c.Tr.ParallelInitSubmodules, self.c.Tr.InitializingSubmodulesStatus, self.c.Tr.Actions.ParallelInitSubmodules}
+	case git_commands.SubmoduleOperationUpdate:
+		return submoduleOperationTexts{self.c.Tr.ParallelUpdateSubmodules, self.c.Tr.UpdatingSubmodulesStatus, self.c.Tr.Actions.ParallelUpdateSubmodules}
+	case git_commands.SubmoduleOperationSync:
+		return submoduleOperationTexts{self.c.Tr.ParallelSyncSubmodules, self.c.Tr.SyncingSubmodulesStatus, self.c.Tr.Actions.ParallelSyncSubmodules}
+	default:
+		return submoduleOperationTexts{self.c.Tr.ParallelFetchSubmodules, self.c.Tr.FetchingSubmodulesStatus, self.c.Tr.Actions.ParallelFetchSubmodules}
+	}
+}
+
+// RunRecursively runs the operation on the given submodules and, once each of
+// them has succeeded, on the submodules nested inside it
+func (self *SubmodulesHelper) RunRecursively(operation git_commands.SubmoduleOperation, submodules []*models.SubmoduleConfig) error {
+	texts := self.texts(operation)
+	run := &submoduleRun{operation: operation}
+	if selected := self.c.Contexts().Submodules.GetSelected(); selected != nil {
+		run.selectedPath = selected.FullPath()
+	}
+	self.lastRun = run
+
+	self.c.LogAction(texts.action)
+
+	return self.c.WithWaitingStatus(texts.status, func(gocui.Task) error {
+		for wave := submodules; len(wave) > 0; {
+			succeeded := self.runWave(run, wave)
+			wave = self.nestedSubmodules(operation, succeeded)
+		}
+
+		if err := self.c.Refresh(types.RefreshOptions{Scope: []types.RefreshableView{types.SUBMODULES, types.FILES}}); err != nil {
+			return err
+		}
+
+		self.c.OnUIThread(func() error {
+			self.renderResultsIfShown(run)
+			return self.showSummary(run)
+		})
+		return nil
+	})
+}
+
+// Runs the operation on all the given submodules and returns the ones that
+// succeeded. Each step of the operation is run on all submodules before the
+// next one starts; a submodule that fails a step is skipped for the rest.
+func (self *SubmodulesHelper) runWave(run *submoduleRun, submodules []*models.SubmoduleConfig) []*models.SubmoduleConfig {
+	jobs := lo.Map(submodules, func(submodule *models.SubmoduleConfig, _ int) *submoduleJob {
+		job := &submoduleJob{submodule: submodule}
+		if run.operation.RunsInsideSubmodule() && submodule.Status != nil && !submodule.Status.IsInitialized {
+			job.state = submoduleJobSkipped
+		}
+		return job
+	})
+
+	run.mutex.Lock()
+	run.jobs = append(run.jobs, jobs...)
+	run.mutex.Unlock()
+	self.c.OnUIThread(func() error {
+		self.renderResultsIfShown(run)
+		return nil
+	})
+
+	for _, step := range run.operation.Steps() {
+		concurrency := max(self.c.UserConfig().Git.SubmoduleJobs, 1)
+		if step.WritesParentConfig() {
+			concurrency = 1
+		}
+
+		self.runStep(run, step, lo.Filter(jobs, func(job *submoduleJob, _ int) bool {
+			return job.state != submoduleJobFailed && job.state != submoduleJobSkipped
+		}), concurrency)
+	}
+
+	succeeded := lo.Filter(jobs, func(job *submoduleJob, _ int) bool {
+		return job.state != submoduleJobFailed && job.state != submoduleJobSkipped
+	})
+	for _, job := range succeeded {
+		self.setJobState(run, job, submoduleJobSucceeded, nil)
+	}
+
+	return lo.Map(succeeded, func(job *submoduleJob, _ int) *models.SubmoduleConfig {
+		return job.submodule
+	})
+}
+
+// Runs a single step of the operation on the given jobs, at most concurrency
+// at a time
+func (self *SubmodulesHelper) runStep(run *submoduleRun, step git_commands.SubmoduleOperation, jobs []*submoduleJob, concurrency int) {
+	semaphore := make(chan struct{}, concurrency)
+	wg := sync.WaitGroup{}
+	for _, job := range jobs {
+		wg.Add(1)
+		go utils.Safe(func() {
+			defer wg.Done()
+
+			semaphore <- struct{}{}
+			defer func() { <-semaphore }()
+
+			if job.state == submoduleJobPending {
+				self.setJobState(run, job, submoduleJobRunning, nil)
+			}
+
+			err := self.c.Git().Submodule.OperationCmdObj(step, job.submodule).
+				WithOutputWriter(&submoduleJobOutputWriter{helper: self, run: run, job: job}).
+				Run()
+			if err != nil {
+				self.setJobState(run, job, submoduleJobFailed, err)
+			}
+		})
+	}
+	wg.Wait()
+}
+
+func (self *SubmodulesHelper) setJobState(run *submoduleRun, job *submoduleJob, state submoduleJobState, err error) {
+	run.mutex.Lock()
+	job.state = state
+	job.err = err
+	if state == submoduleJobRunning {
+		job.start = time.Now()
+	} else {
+		job.duration = time.Since(job.start)
+	}
+	run.mutex.Unlock()
+
+	self.c.OnUIThread(func() error {
+		self.renderResultsIfShown(run)
+		return nil
+	})
+}
+
+// Renders the results soon, unless a render is already scheduled. Used for
+// command output, which can come in many small chunks.
+func (self *SubmodulesHelper) scheduleRender(run *submoduleRun) {
+	if !run.renderPending.CompareAndSwap(false, true) {
+		return
+	}
+
+	go utils.Safe(func() {
+		time.Sleep(100 * time.Millisecond)
+		run.renderPending.Store(false)
+		self.c.OnUIThread(func() error {
+			self.renderResultsIfShown(run)
+			return nil
+		})
+	})
+}
+
+// Returns the submodules directly inside the given ones. Submodules that
+// aren't checked out yet have no .gitmodules file, so they have none. For
+// operations that only apply to initialized submodules, their statuses are
+// loaded too.
+func (self *SubmodulesHelper) nestedSubmodules(operation git_commands.SubmoduleOperation, parents []*models.SubmoduleConfig) []*models.SubmoduleConfig {
+	result := []*models.SubmoduleConfig{}
+	for _, parent := range parents {
+		configs, err := self.c.Git().Submodule.GetConfigs(parent)
+		if err != nil {
+			self.c.Log.Error(err)
+			continue
+		}
+
+		result = append(result, lo.Filter(configs, func(config *models.SubmoduleConfig, _ int) bool {
+			return config.ParentModule == parent
+		})...)
+	}
+
+	if operation.RunsInsideSubmodule() {
+		if err := self.c.Git().Submodule.LoadStatuses(result); err != nil {
+			self.c.Log.Error(err)
+		}
+	}
+
+	return result
+}
+
+// RenderLastRun renders the results of the most recent run to the main view,
+// unless a different submodule has been selected since it started. Returns
+// false if there is nothing to render.
+func (self *SubmodulesHelper) RenderLastRun(selected *models.SubmoduleConfig) bool {
+	if self.lastRun == nil {
+		return false
+	}
+
+	if selected == nil || selected.FullPath() != self.lastRun.selectedPath {
+		self.lastRun = nil
+		return false
+	}
+
+	self.renderResults(self.lastRun)
+	return true
+}
+
+func (self *SubmodulesHelper) renderResultsIfShown(run *submoduleRun) {
+	if self.lastRun == run {
+		self.renderResults(run)
+	}
+}
+
+func (self *SubmodulesHelper) renderResults(run *submoduleRun) {
+	run.mutex.Lock()
+	content := self.formatResults(run)
+	run.mutex.Unlock()
+
+	self.c.RenderToMainViews(types.RefreshMainOpts{
+		Pair: self.c.MainViewPairs().Normal,
+		Main: &types.ViewUpdateOpts{
+			Title: self.texts(run.operation).title,
+			Task:  types.NewRenderStringTask(content),
+		},
+	})
+}
+
+func (self *SubmodulesHelper) formatResults(run *submoduleRun) string {
+	done := lo.CountBy(run.jobs, func(job *submoduleJob) bool {
+		return job.state == submoduleJobSucceeded || job.state == submoduleJobFailed || job.state == submoduleJobSkipped
+	})
+	failed := lo.CountBy(run.jobs, func(job *submoduleJob) bool {
+		return job.state == submoduleJobFailed
+	})
+
+	lines := []string{
+		utils.ResolvePlaceholderString(self.c.Tr.SubmoduleJobsProgress, map[string]string{
+			"done":   fmt.Sprint(done),
+			"total":  fmt.Sprint(len(run.jobs)),
+			"failed": fmt.Sprint(failed),
+		}),
+		"",
+	}
+
+	for _, job := range run.jobs {
+		path := job.submodule.FullPath()
+		switch job.state {
+		case submoduleJobPending:
+			lines = append(lines, style.FgDefault.Sprintf("  %s", path))
+		case submoduleJobRunning:
+			line := style.FgCyan.Sprintf("… %s", path)
+			if lastLine := lastOutputLine(job.output); lastLine != "" {
+				line += " " + style.FgDefault.Sprint(lastLine)
+			}
+			lines = append(lines, line)
+		case submoduleJobSucceeded:
+			lines = append(lines, style.FgGreen.Sprintf("✓ %s", path)+fmt.Sprintf(" (%s)", job.duration.Round(time.Millisecond*10)))
+		case submoduleJobSkipped:
+			lines = append(lines, style.FgYellow.Sprintf("- %s", path)+" "+self.c.Tr.SubmoduleJobSkippedNotInitialized)
+		case submoduleJobFailed:
+			lines = append(lines, style.FgRed.Sprintf("✗ %s", path))
+			for _, line := range strings.Split(strings.TrimSpace(job.err.Error()), "\n") {
+				lines = append(lines, "    "+line)
+			}
+		}
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+// Returns the last non-empty line of the output. Progress output is updated
+// in place using carriage returns, so those count as line breaks too.
+func lastOutputLine(output string) string {
+	lines := strings.FieldsFunc(utils.Decolorise(output), func(r rune) bool {
+		return r == '\n' || r == '\r'
+	})
+	for i := len(lines) - 1; i >= 0; i-- {
+		if line := strings.TrimSpace(lines[i]); line != "" {
+			return line
+		}
+	}
+
+	return ""
+}
+
+func (self *SubmodulesHelper) showSummary(run *submoduleRun) error {
+	failedJobs := lo.Filter(run.jobs, func(job *submoduleJob, _ int) bool {
+		return job.state == submoduleJobFailed
+	})
+
+	if len(failedJobs) == 0 {
+		self.c.Toast(utils.ResolvePlaceholderString(self.c.Tr.SubmoduleJobsSucceeded, map[string]string{
+			"count": fmt.Sprint(lo.CountBy(run.jobs, func(job *submoduleJob) bool {
+				return job.state == submoduleJobSucceeded
+			})),
+		}))
+		return nil
+	}
+
+	failures := lo.Map(failedJobs, func(job *submoduleJob, _ int) string {
+		firstLine, _, _ := strings.Cut(strings.TrimSpace(job.err.Error()), "\n")
+		return fmt.Sprintf("- %s: %s", job.submodule.FullPath(), firstLine)
+	})
+
+	self.c.Confirm(types.ConfirmOpts{
+		Title: utils.ResolvePlaceholderString(self.c.Tr.SubmoduleJobsFailedTitle, map[string]string{
+			"failed": fmt.Sprint(len(failedJobs)),
+			"total":  fmt.Sprint(len(run.jobs)),
+		}),
+		Prompt: utils.ResolvePlaceholderString(self.c.Tr.SubmoduleJobsFailedPrompt, map[string]string{
+			"failures": strings.Join(failures, "\n"),
+		}),
+		HandleConfirm: func() error {
+			return self.RunRecursively(run.operation, lo.Map(failedJobs, func(job *submoduleJob, _ int) *models.SubmoduleConfig {
+				return job.submodule
+			}))
+		},
+	})
+
+	return nil
+}
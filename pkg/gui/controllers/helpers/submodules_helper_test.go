@@ -0,0 +1,42 @@
+package helpers
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLastOutputLine(t *testing.T) {
+	scenarios := []struct {
+		name     string
+		output   string
+		expected string
+	}{
+		{
+			name:     "empty",
+			output:   "",
+			expected: "",
+		},
+		{
+			name:     "trailing newline",
+			output:   "Cloning into 'sub'...\nDone\n",
+			expected: "Done",
+		},
+		{
+			name:     "progress updated in place",
+			output:   "Receiving objects:  10% (1/10)\rReceiving objects: 100% (10/10)\r\n",
+			expected: "Receiving objects: 100% (10/10)",
+		},
+		{
+			name:     "colored",
+			output:   "\x1b[31merror\x1b[0m\n  \n",
+			expected: "error",
+		},
+	}
+
+	for _, s := range scenarios {
+		t.Run(s.name, func(t *testing.T) {
+			assert.Equal(t, s.expected, lastOutputLine(s.output))
+		})
+	}
+}
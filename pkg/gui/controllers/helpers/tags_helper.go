@@ -2,43 +2,173 @@ package helpers
 
 import (
 	"github.com/jesseduffield/gocui"
+	"github.com/jesseduffield/lazygit/pkg/commands/models"
 	"github.com/jesseduffield/lazygit/pkg/gui/context"
+	"github.com/jesseduffield/lazygit/pkg/gui/presentation"
+	"github.com/jesseduffield/lazygit/pkg/gui/style"
 	"github.com/jesseduffield/lazygit/pkg/gui/types"
 	"github.com/jesseduffield/lazygit/pkg/utils"
+	"github.com/samber/lo"
 )
 
 type TagsHelper struct {
 	c             *HelperCommon
 	commitsHelper *CommitsHelper
+	gpg           *GpgHelper
 }
 
-func NewTagsHelper(c *HelperCommon, commitsHelper *CommitsHelper) *TagsHelper {
+func NewTagsHelper(c *HelperCommon, commitsHelper *CommitsHelper, gpg *GpgHelper) *TagsHelper {
 	return &TagsHelper{
 		c:             c,
 		commitsHelper: commitsHelper,
+		gpg:           gpg,
 	}
 }
 
+type createTagOpts struct {
+	ref  string
+	sign bool
+	// Prefills the tag name (first line) and description (remaining lines)
+	initialMessage string
+	// Called after the tag has been created
+	onCreated func(tagName string) error
+}
+
 func (self *TagsHelper) OpenCreateTagPrompt(ref string, onCreate func()) error {
-	doCreateTag := func(tagName string, description string, force bool) error {
-		return self.c.WithWaitingStatus(self.c.Tr.CreatingTag, func(gocui.Task) error {
-			if description != "" {
-				self.c.LogAction(self.c.Tr.Actions.CreateAnnotatedTag)
-				if err := self.c.Git().Tag.CreateAnnotated(tagName, ref, description, force); err != nil {
-					return err
-				}
-			} else {
-				self.c.LogAction(self.c.Tr.Actions.CreateLightweightTag)
-				if err := self.c.Git().Tag.CreateLightweight(tagName, ref, force); err != nil {
-					return err
-				}
-			}
+	return self.openCreateTagPrompt(createTagOpts{ref: ref})
+}
+
+// OpenCreateSignedTagPrompt is like OpenCreateTagPrompt, except that the
+// resulting tag is always annotated and signed
+func (self *TagsHelper) OpenCreateSignedTagPrompt(ref string, onCreate func()) error {
+	return self.openCreateTagPrompt(createTagOpts{ref: ref, sign: true})
+}
+
+// OpenCreateReleaseTagMenu offers to bump the latest semantic version tag, and
+// then lets the user create an annotated tag for the current commit whose
+// description is prefilled with a changelog since that tag.
+func (self *TagsHelper) OpenCreateReleaseTagMenu() error {
+	tagNames := lo.Map(self.c.Model().Tags, func(tag *models.Tag, _ int) string {
+		return tag.Name
+	})
+	latest, latestTagName, found := utils.LatestSemVer(tagNames)
+
+	title := self.c.Tr.NewReleaseTag
+	if found {
+		title = utils.ResolvePlaceholderString(
+			self.c.Tr.ReleaseTagBumpTitle,
+			map[string]string{"latestTag": latestTagName},
+		)
+	} else {
+		latest = utils.SemVer{Prefix: "v"}
+	}
+
+	menuItem := func(label string, version utils.SemVer, key types.Key) *types.MenuItem {
+		tagName := version.String()
+		return &types.MenuItem{
+			LabelColumns: []string{label, style.FgYellow.Sprint(tagName)},
+			OnPress: func() error {
+				return self.openCreateReleaseTagPrompt(tagName, latestTagName)
+			},
+			Key: key,
+		}
+	}
+
+	menuItems := []*types.MenuItem{
+		menuItem(self.c.Tr.BumpPatchVersion, latest.BumpPatch(), 'p'),
+		menuItem(self.c.Tr.BumpMinorVersion, latest.BumpMinor(), 'm'),
+		menuItem(self.c.Tr.BumpMajorVersion, latest.BumpMajor(), 'M'),
+		menuItem(self.c.Tr.BumpPrereleaseVersion, latest.BumpPrerelease("rc"), 'r'),
+	}
+
+	return self.c.Menu(types.CreateMenuOptions{
+		Title: title,
+		Items: menuItems,
+	})
+}
+
+func (self *TagsHelper) openCreateReleaseTagPrompt(tagName string, previousTagName string) error {
+	commits, err := self.c.Git().Loaders.CommitLoader.GetCommitsInRange(previousTagName, "HEAD")
+	if err != nil {
+		return err
+	}
+
+	changelog := presentation.GetPlainChangelog(commits, self.c.Tr)
+
+	return self.openCreateTagPrompt(createTagOpts{
+		initialMessage: tagName + "\n" + changelog,
+		onCreated:      self.openPushReleaseTagMenu,
+	})
+}
+
+func (self *TagsHelper) openPushReleaseTagMenu(tagName string) error {
+	menuItems := lo.Map(self.c.Model().Remotes, func(remote *models.Remote, _ int) *types.MenuItem {
+		return &types.MenuItem{
+			Label: remote.Name,
+			OnPress: func() error {
+				return self.c.WithWaitingStatus(self.c.Tr.PushingTagStatus, func(task gocui.Task) error {
+					self.c.LogAction(self.c.Tr.Actions.PushTag)
+					return self.c.Git().Tag.Push(task, remote.Name, tagName)
+				})
+			},
+		}
+	})
+	if len(menuItems) == 0 {
+		return nil
+	}
+
+	menuItems = append(menuItems, &types.MenuItem{
+		Label:   self.c.Tr.DontPushTag,
+		OnPress: func() error { return nil },
+	})
 
+	return self.c.Menu(types.CreateMenuOptions{
+		Title: utils.ResolvePlaceholderString(
+			self.c.Tr.PushReleaseTagTitle,
+			map[string]string{"tagName": tagName},
+		),
+		Items: menuItems,
+	})
+}
+
+func (self *TagsHelper) openCreateTagPrompt(opts createTagOpts) error {
+	ref := opts.ref
+	sign := opts.sign
+
+	doCreateTag := func(tagName string, description string, force bool) error {
+		onSuccess := func() error {
 			self.commitsHelper.OnCommitSuccess()
 
-			return self.c.Refresh(types.RefreshOptions{
+			if err := self.c.Refresh(types.RefreshOptions{
 				Mode: types.ASYNC, Scope: []types.RefreshableView{types.COMMITS, types.TAGS},
-			})
+			}); err != nil {
+				return err
+			}
+
+			if opts.onCreated != nil {
+				self.c.OnUIThread(func() error {
+					return opts.onCreated(tagName)
+				})
+			}
+
+			return nil
+		}
+
+		if sign || description != "" {
+			// Annotated tags may need signing (either because we asked for
+			// it or because of tag.gpgsign), so they go through the gpg helper
+			self.c.LogAction(lo.Ternary(sign, self.c.Tr.Actions.CreateSignedTag, self.c.Tr.Actions.CreateAnnotatedTag))
+			cmdObj := self.c.Git().Tag.CreateAnnotatedObj(tagName, ref, description, force, sign)
+			return self.gpg.WithTagGpgHandling(cmdObj, sign, self.c.Tr.CreatingTag, onSuccess)
+		}
+
+		return self.c.WithWaitingStatus(self.c.Tr.CreatingTag, func(gocui.Task) error {
+			self.c.LogAction(self.c.Tr.Actions.CreateLightweightTag)
+			if err := self.c.Git().Tag.CreateLightweight(tagName, ref, force); err != nil {
+				return err
+			}
+
+			return onSuccess()
 		})
 	}
 
@@ -69,7 +199,7 @@ func (self *TagsHelper) OpenCreateTagPrompt(ref string, onCreate func()) error {
 	self.commitsHelper.OpenCommitMessagePanel(
 		&OpenCommitMessagePanelOpts{
 			CommitIndex:      context.NoCommitIndex,
-			InitialMessage:   "",
+			InitialMessage:   opts.initialMessage,
 			SummaryTitle:     self.c.Tr.TagNameTitle,
 			DescriptionTitle: self.c.Tr.TagMessageTitle,
 			PreserveMessage:  false,
@@ -19,10 +19,11 @@ type IWorkingTreeHelper interface {
 }
 
 type WorkingTreeHelper struct {
-	c             *HelperCommon
-	refHelper     *RefsHelper
-	commitsHelper *CommitsHelper
-	gpgHelper     *GpgHelper
+	c                       *HelperCommon
+	refHelper               *RefsHelper
+	commitsHelper           *CommitsHelper
+	gpgHelper               *GpgHelper
+	protectedBranchesHelper *ProtectedBranchesHelper
 }
 
 func NewWorkingTreeHelper(
@@ -30,12 +31,14 @@ func NewWorkingTreeHelper(
 	refHelper *RefsHelper,
 	commitsHelper *CommitsHelper,
 	gpgHelper *GpgHelper,
+	protectedBranchesHelper *ProtectedBranchesHelper,
 ) *WorkingTreeHelper {
 	return &WorkingTreeHelper{
-		c:             c,
-		refHelper:     refHelper,
-		commitsHelper: commitsHelper,
-		gpgHelper:     gpgHelper,
+		c:                       c,
+		refHelper:               refHelper,
+		commitsHelper:           commitsHelper,
+		gpgHelper:               gpgHelper,
+		protectedBranchesHelper: protectedBranchesHelper,
 	}
 }
 
@@ -87,7 +90,7 @@ func (self *WorkingTreeHelper) OpenMergeTool() error {
 }
 
 func (self *WorkingTreeHelper) HandleCommitPressWithMessage(initialMessage string) error {
-	return self.WithEnsureCommittableFiles(func() error {
+	return self.withCommitGuards(func() error {
 		self.commitsHelper.OpenCommitMessagePanel(
 			&OpenCommitMessagePanelOpts{
 				CommitIndex:      context.NoCommitIndex,
@@ -131,7 +134,7 @@ func (self *WorkingTreeHelper) switchFromCommitMessagePanelToEditor(filepath str
 // HandleCommitEditorPress - handle when the user wants to commit changes via
 // their editor rather than via the popup panel
 func (self *WorkingTreeHelper) HandleCommitEditorPress() error {
-	return self.WithEnsureCommittableFiles(func() error {
+	return self.withCommitGuards(func() error {
 		self.c.LogAction(self.c.Tr.Actions.Commit)
 		return self.c.RunSubprocessAndRefresh(
 			self.c.Git().Commit.CommitEditorCmdObj(),
@@ -189,6 +192,14 @@ func (self *WorkingTreeHelper) WithEnsureCommittableFiles(handler func() error)
 	return handler()
 }
 
+// Like WithEnsureCommittableFiles, but also checks that the checked-out
+// branch isn't protected against committing to it directly
+func (self *WorkingTreeHelper) withCommitGuards(handler func() error) error {
+	return self.WithEnsureCommittableFiles(func() error {
+		return self.protectedBranchesHelper.GuardCheckedOutBranch(ProtectedBranchCommit, handler)
+	})
+}
+
 func (self *WorkingTreeHelper) promptToStageAllAndRetry(retry func() error) error {
 	self.c.Confirm(types.ConfirmOpts{
 		Title:  self.c.Tr.NoFilesStagedTitle,
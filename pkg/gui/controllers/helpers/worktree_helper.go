@@ -10,6 +10,7 @@ import (
 	"github.com/jesseduffield/lazygit/pkg/gui/context"
 	"github.com/jesseduffield/lazygit/pkg/gui/types"
 	"github.com/jesseduffield/lazygit/pkg/utils"
+	"github.com/samber/lo"
 )
 
 type IWorktreeHelper interface {
@@ -224,6 +225,14 @@ func (self *WorktreeHelper) Detach(worktree *models.Worktree) error {
 }
 
 func (self *WorktreeHelper) ViewWorktreeOptions(context types.IListContext, ref string) error {
+	if context == self.c.Contexts().Worktrees {
+		worktree := self.c.Contexts().Worktrees.GetSelected()
+		if worktree == nil {
+			return nil
+		}
+		return self.viewManageWorktreeOptions(worktree)
+	}
+
 	currentBranch := self.refsHelper.GetCheckedOutRef()
 	canCheckoutBase := context == self.c.Contexts().Branches && ref != currentBranch.RefName()
 
@@ -251,3 +260,176 @@ func (self *WorktreeHelper) ViewBranchWorktreeOptions(branchName string, canChec
 		},
 	})
 }
+
+func (self *WorktreeHelper) viewManageWorktreeOptions(worktree *models.Worktree) error {
+	lockItem := &types.MenuItem{
+		Label:   self.c.Tr.LockWorktree,
+		Tooltip: self.c.Tr.LockWorktreeTooltip,
+		OnPress: func() error {
+			return self.Lock(worktree)
+		},
+		Key: 'l',
+	}
+	if worktree.IsLocked {
+		lockItem = &types.MenuItem{
+			Label:   self.c.Tr.UnlockWorktree,
+			Tooltip: self.c.Tr.UnlockWorktreeTooltip,
+			OnPress: func() error {
+				return self.Unlock(worktree)
+			},
+			Key: 'l',
+		}
+	}
+	if worktree.IsMain {
+		lockItem.DisabledReason = &types.DisabledReason{Text: self.c.Tr.CantLockMainWorktree}
+	}
+
+	moveItem := &types.MenuItem{
+		Label:   self.c.Tr.MoveWorktree,
+		Tooltip: self.c.Tr.MoveWorktreeTooltip,
+		OnPress: func() error {
+			return self.Move(worktree)
+		},
+		Key: 'm',
+	}
+	if worktree.IsMain {
+		moveItem.DisabledReason = &types.DisabledReason{Text: self.c.Tr.CantMoveMainWorktree}
+	} else if worktree.IsCurrent {
+		moveItem.DisabledReason = &types.DisabledReason{Text: self.c.Tr.CantMoveCurrentWorktree}
+	} else if worktree.IsPathMissing {
+		moveItem.DisabledReason = &types.DisabledReason{Text: self.c.Tr.CantMoveMissingWorktree}
+	} else if worktree.IsLocked {
+		moveItem.DisabledReason = &types.DisabledReason{Text: self.c.Tr.CantMoveLockedWorktree}
+	}
+
+	pruneItem := &types.MenuItem{
+		Label:   self.c.Tr.PruneWorktrees,
+		Tooltip: self.c.Tr.PruneWorktreesTooltip,
+		OnPress: self.Prune,
+		Key:     'p',
+	}
+	if len(self.prunableWorktrees()) == 0 {
+		pruneItem.DisabledReason = &types.DisabledReason{Text: self.c.Tr.NoPrunableWorktrees}
+	}
+
+	repairItem := &types.MenuItem{
+		Label:   self.c.Tr.RepairWorktree,
+		Tooltip: self.c.Tr.RepairWorktreeTooltip,
+		OnPress: func() error {
+			return self.Repair(worktree)
+		},
+		Key: 'r',
+	}
+
+	return self.c.Menu(types.CreateMenuOptions{
+		Title: self.c.Tr.WorktreeTitle,
+		Items: []*types.MenuItem{lockItem, moveItem, pruneItem, repairItem},
+	})
+}
+
+func (self *WorktreeHelper) Lock(worktree *models.Worktree) error {
+	self.c.Prompt(types.PromptOpts{
+		Title: self.c.Tr.LockWorktreeReasonTitle,
+		HandleConfirm: func(reason string) error {
+			self.c.LogAction(self.c.Tr.Actions.LockWorktree)
+			if err := self.c.Git().Worktree.Lock(worktree.Path, reason); err != nil {
+				return err
+			}
+			return self.c.Refresh(types.RefreshOptions{Mode: types.ASYNC, Scope: []types.RefreshableView{types.WORKTREES}})
+		},
+	})
+
+	return nil
+}
+
+func (self *WorktreeHelper) Unlock(worktree *models.Worktree) error {
+	self.c.LogAction(self.c.Tr.Actions.UnlockWorktree)
+	if err := self.c.Git().Worktree.Unlock(worktree.Path); err != nil {
+		return err
+	}
+	return self.c.Refresh(types.RefreshOptions{Mode: types.ASYNC, Scope: []types.RefreshableView{types.WORKTREES}})
+}
+
+func (self *WorktreeHelper) Move(worktree *models.Worktree) error {
+	self.c.Prompt(types.PromptOpts{
+		Title: utils.ResolvePlaceholderString(
+			self.c.Tr.MoveWorktreePathTitle,
+			map[string]string{"worktreeName": worktree.Name},
+		),
+		InitialContent:      worktree.Path,
+		FindSuggestionsFunc: self.suggestionsHelper.GetFilePathSuggestionsFunc(),
+		HandleConfirm: func(newPath string) error {
+			return self.c.WithWaitingStatus(self.c.Tr.MovingWorktree, func(gocui.Task) error {
+				self.c.LogAction(self.c.Tr.Actions.MoveWorktree)
+				if err := self.c.Git().Worktree.Move(worktree.Path, newPath); err != nil {
+					return err
+				}
+				return self.c.Refresh(types.RefreshOptions{Mode: types.ASYNC, Scope: []types.RefreshableView{types.WORKTREES}})
+			})
+		},
+	})
+
+	return nil
+}
+
+// Worktrees whose directory is gone. Locked ones are skipped by git worktree
+// prune, so we don't list them.
+func (self *WorktreeHelper) prunableWorktrees() []*models.Worktree {
+	return lo.Filter(self.c.Model().Worktrees, func(worktree *models.Worktree, _ int) bool {
+		return worktree.IsPathMissing && !worktree.IsLocked
+	})
+}
+
+func (self *WorktreeHelper) Prune() error {
+	names := lo.Map(self.prunableWorktrees(), func(worktree *models.Worktree, _ int) string {
+		return "- " + worktree.Name
+	})
+
+	self.c.Confirm(types.ConfirmOpts{
+		Title: self.c.Tr.PruneWorktrees,
+		Prompt: utils.ResolvePlaceholderString(
+			self.c.Tr.PruneWorktreesPrompt,
+			map[string]string{"worktrees": strings.Join(names, "\n")},
+		),
+		HandleConfirm: func() error {
+			return self.c.WithWaitingStatus(self.c.Tr.PruningWorktrees, func(gocui.Task) error {
+				self.c.LogAction(self.c.Tr.Actions.PruneWorktrees)
+				if err := self.c.Git().Worktree.Prune(); err != nil {
+					return err
+				}
+				return self.c.Refresh(types.RefreshOptions{Mode: types.ASYNC, Scope: []types.RefreshableView{types.WORKTREES, types.BRANCHES}})
+			})
+		},
+	})
+
+	return nil
+}
+
+// If the worktree's directory was moved by hand, we need to ask where it went
+// so that git can fix up its links; otherwise we repair it in place.
+func (self *WorktreeHelper) Repair(worktree *models.Worktree) error {
+	repair := func(path string) error {
+		return self.c.WithWaitingStatus(self.c.Tr.RepairingWorktree, func(gocui.Task) error {
+			self.c.LogAction(self.c.Tr.Actions.RepairWorktree)
+			if err := self.c.Git().Worktree.Repair(path); err != nil {
+				return err
+			}
+			return self.c.Refresh(types.RefreshOptions{Mode: types.ASYNC, Scope: []types.RefreshableView{types.WORKTREES, types.BRANCHES}})
+		})
+	}
+
+	if !worktree.IsPathMissing {
+		return repair(worktree.Path)
+	}
+
+	self.c.Prompt(types.PromptOpts{
+		Title: utils.ResolvePlaceholderString(
+			self.c.Tr.RepairWorktreeNewPathTitle,
+			map[string]string{"worktreeName": worktree.Name},
+		),
+		FindSuggestionsFunc: self.suggestionsHelper.GetFilePathSuggestionsFunc(),
+		HandleConfirm:       repair,
+	})
+
+	return nil
+}
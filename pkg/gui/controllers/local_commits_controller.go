@@ -2,6 +2,7 @@ package controllers
 
 import (
 	"fmt"
+	"strconv"
 	"strings"
 
 	"github.com/go-errors/errors"
@@ -59,7 +60,7 @@ func (self *LocalCommitsController) GetKeybindings(opts types.KeybindingsOpts) [
 	outsideFilterModeBindings := []*types.Binding{
 		{
 			Key:     opts.GetKey(opts.Config.Commits.SquashDown),
-			Handler: self.withItemsRange(self.squashDown),
+			Handler: self.guardCheckedOutBranch(helpers.ProtectedBranchRebase, self.withItemsRange(self.squashDown)),
 			GetDisabledReason: self.require(
 				self.itemRangeSelected(
 					self.midRebaseCommandEnabled,
@@ -72,7 +73,7 @@ func (self *LocalCommitsController) GetKeybindings(opts types.KeybindingsOpts) [
 		},
 		{
 			Key:     opts.GetKey(opts.Config.Commits.MarkCommitAsFixup),
-			Handler: self.withItemsRange(self.fixup),
+			Handler: self.guardCheckedOutBranch(helpers.ProtectedBranchRebase, self.withItemsRange(self.fixup)),
 			GetDisabledReason: self.require(
 				self.itemRangeSelected(
 					self.midRebaseCommandEnabled,
@@ -85,7 +86,7 @@ func (self *LocalCommitsController) GetKeybindings(opts types.KeybindingsOpts) [
 		},
 		{
 			Key:     opts.GetKey(opts.Config.Commits.RenameCommit),
-			Handler: self.withItem(self.reword),
+			Handler: self.guardCheckedOutBranch(helpers.ProtectedBranchRebase, self.withItem(self.reword)),
 			GetDisabledReason: self.require(
 				self.singleItemSelected(self.rewordEnabled),
 			),
@@ -96,7 +97,7 @@ func (self *LocalCommitsController) GetKeybindings(opts types.KeybindingsOpts) [
 		},
 		{
 			Key:     opts.GetKey(opts.Config.Commits.RenameCommitWithEditor),
-			Handler: self.withItem(self.rewordEditor),
+			Handler: self.guardCheckedOutBranch(helpers.ProtectedBranchRebase, self.withItem(self.rewordEditor)),
 			GetDisabledReason: self.require(
 				self.singleItemSelected(self.rewordEnabled),
 			),
@@ -104,7 +105,7 @@ func (self *LocalCommitsController) GetKeybindings(opts types.KeybindingsOpts) [
 		},
 		{
 			Key:     opts.GetKey(opts.Config.Universal.Remove),
-			Handler: self.withItemsRange(self.drop),
+			Handler: self.guardCheckedOutBranch(helpers.ProtectedBranchRebase, self.withItemsRange(self.drop)),
 			GetDisabledReason: self.require(
 				self.itemRangeSelected(
 					self.canDropCommits,
@@ -116,7 +117,7 @@ func (self *LocalCommitsController) GetKeybindings(opts types.KeybindingsOpts) [
 		},
 		{
 			Key:     opts.GetKey(editCommitKey),
-			Handler: self.withItemsRange(self.edit),
+			Handler: self.guardCheckedOutBranch(helpers.ProtectedBranchRebase, self.withItemsRange(self.edit)),
 			GetDisabledReason: self.require(
 				self.itemRangeSelected(self.midRebaseCommandEnabled),
 			),
@@ -130,7 +131,7 @@ func (self *LocalCommitsController) GetKeybindings(opts types.KeybindingsOpts) [
 			// we're calling it 'quick-start interactive rebase' to differentiate it from
 			// when you manually select the base commit.
 			Key:               opts.GetKey(opts.Config.Commits.StartInteractiveRebase),
-			Handler:           self.quickStartInteractiveRebase,
+			Handler:           self.guardCheckedOutBranch(helpers.ProtectedBranchRebase, self.quickStartInteractiveRebase),
 			GetDisabledReason: self.require(self.notMidRebase(self.c.Tr.AlreadyRebasing), self.canFindCommitForQuickStart),
 			Description:       self.c.Tr.QuickStartInteractiveRebase,
 			Tooltip: utils.ResolvePlaceholderString(self.c.Tr.QuickStartInteractiveRebaseTooltip, map[string]string{
@@ -156,7 +157,7 @@ func (self *LocalCommitsController) GetKeybindings(opts types.KeybindingsOpts) [
 		},
 		{
 			Key:               opts.GetKey(opts.Config.Commits.CreateFixupCommit),
-			Handler:           self.withItem(self.createFixupCommit),
+			Handler:           self.guardCheckedOutBranch(helpers.ProtectedBranchCommit, self.withItem(self.createFixupCommit)),
 			GetDisabledReason: self.require(self.singleItemSelected()),
 			Description:       self.c.Tr.CreateFixupCommit,
 			Tooltip: utils.ResolvePlaceholderString(
@@ -168,7 +169,7 @@ func (self *LocalCommitsController) GetKeybindings(opts types.KeybindingsOpts) [
 		},
 		{
 			Key:     opts.GetKey(opts.Config.Commits.SquashAboveCommits),
-			Handler: self.squashFixupCommits,
+			Handler: self.guardCheckedOutBranch(helpers.ProtectedBranchRebase, self.squashFixupCommits),
 			GetDisabledReason: self.require(
 				self.notMidRebase(self.c.Tr.AlreadyRebasing),
 			),
@@ -178,7 +179,7 @@ func (self *LocalCommitsController) GetKeybindings(opts types.KeybindingsOpts) [
 		},
 		{
 			Key:     opts.GetKey(opts.Config.Commits.MoveDownCommit),
-			Handler: self.withItemsRange(self.moveDown),
+			Handler: self.guardCheckedOutBranch(helpers.ProtectedBranchRebase, self.withItemsRange(self.moveDown)),
 			GetDisabledReason: self.require(self.itemRangeSelected(
 				self.midRebaseMoveCommandEnabled,
 				self.canMoveDown,
@@ -187,7 +188,7 @@ func (self *LocalCommitsController) GetKeybindings(opts types.KeybindingsOpts) [
 		},
 		{
 			Key:     opts.GetKey(opts.Config.Commits.MoveUpCommit),
-			Handler: self.withItemsRange(self.moveUp),
+			Handler: self.guardCheckedOutBranch(helpers.ProtectedBranchRebase, self.withItemsRange(self.moveUp)),
 			GetDisabledReason: self.require(self.itemRangeSelected(
 				self.midRebaseMoveCommandEnabled,
 				self.canMoveUp,
@@ -196,7 +197,7 @@ func (self *LocalCommitsController) GetKeybindings(opts types.KeybindingsOpts) [
 		},
 		{
 			Key:               opts.GetKey(opts.Config.Commits.PasteCommits),
-			Handler:           self.paste,
+			Handler:           self.guardCheckedOutBranch(helpers.ProtectedBranchCommit, self.paste),
 			GetDisabledReason: self.require(self.canPaste),
 			Description:       self.c.Tr.PasteCommits,
 			DisplayStyle:      &style.FgCyan,
@@ -233,7 +234,7 @@ func (self *LocalCommitsController) GetKeybindings(opts types.KeybindingsOpts) [
 		},
 		{
 			Key:               opts.GetKey(opts.Config.Commits.AmendToCommit),
-			Handler:           self.withItem(self.amendTo),
+			Handler:           self.guardCheckedOutBranch(helpers.ProtectedBranchRebase, self.withItem(self.amendTo)),
 			GetDisabledReason: self.require(self.singleItemSelected(self.canAmend)),
 			Description:       self.c.Tr.Amend,
 			Tooltip:           self.c.Tr.AmendCommitTooltip,
@@ -241,7 +242,7 @@ func (self *LocalCommitsController) GetKeybindings(opts types.KeybindingsOpts) [
 		},
 		{
 			Key:               opts.GetKey(opts.Config.Commits.ResetCommitAuthor),
-			Handler:           self.withItemsRange(self.amendAttribute),
+			Handler:           self.guardCheckedOutBranch(helpers.ProtectedBranchRebase, self.withItemsRange(self.amendAttribute)),
 			GetDisabledReason: self.require(self.itemRangeSelected(self.canAmendRange)),
 			Description:       self.c.Tr.AmendCommitAttribute,
 			Tooltip:           self.c.Tr.AmendCommitAttributeTooltip,
@@ -249,7 +250,7 @@ func (self *LocalCommitsController) GetKeybindings(opts types.KeybindingsOpts) [
 		},
 		{
 			Key:               opts.GetKey(opts.Config.Commits.RevertCommit),
-			Handler:           self.withItem(self.revert),
+			Handler:           self.guardCheckedOutBranch(helpers.ProtectedBranchCommit, self.withItem(self.revert)),
 			GetDisabledReason: self.require(self.singleItemSelected()),
 			Description:       self.c.Tr.Revert,
 			Tooltip:           self.c.Tr.RevertCommitTooltip,
@@ -273,6 +274,14 @@ func (self *LocalCommitsController) GetKeybindings(opts types.KeybindingsOpts) [
 	return bindings
 }
 
+// Guards handlers that rewrite the commits of the checked-out branch, or add
+// commits to it, against doing so on a protected branch
+func (self *LocalCommitsController) guardCheckedOutBranch(operation helpers.ProtectedBranchOperation, handler func() error) func() error {
+	return func() error {
+		return self.c.Helpers().ProtectedBranches.GuardCheckedOutBranch(operation, handler)
+	}
+}
+
 func (self *LocalCommitsController) GetOnRenderToMain() func() {
 	return func() {
 		self.c.Helpers().Diff.WithDiffModeCheck(func() {
@@ -1291,10 +1300,99 @@ func (self *LocalCommitsController) handleOpenLogMenu() error {
 					})
 				},
 			},
+			{
+				Label:          self.c.Tr.FetchMoreHistory,
+				Tooltip:        self.c.Tr.FetchMoreHistoryTooltip,
+				OpensMenu:      true,
+				DisabledReason: self.notShallowDisabledReason(),
+				OnPress:        self.openFetchMoreHistoryMenu,
+			},
+		},
+	})
+}
+
+func (self *LocalCommitsController) notShallowDisabledReason() *types.DisabledReason {
+	if !self.c.Git().Status.IsShallowRepo() {
+		return &types.DisabledReason{Text: self.c.Tr.NotAShallowClone}
+	}
+
+	return nil
+}
+
+func (self *LocalCommitsController) openFetchMoreHistoryMenu() error {
+	return self.c.Menu(types.CreateMenuOptions{
+		Title: self.c.Tr.FetchMoreHistory,
+		Items: []*types.MenuItem{
+			{
+				Label:   self.c.Tr.DeepenHistoryByCommits,
+				Tooltip: self.c.Tr.DeepenHistoryByCommitsTooltip,
+				Key:     'n',
+				OnPress: func() error {
+					self.c.Prompt(types.PromptOpts{
+						Title: self.c.Tr.FetchDepthPrompt,
+						HandleConfirm: func(response string) error {
+							depth, err := strconv.Atoi(strings.TrimSpace(response))
+							if err != nil || depth < 1 {
+								return errors.New(self.c.Tr.InvalidFetchDepth)
+							}
+
+							return self.fetchMoreHistory(self.c.Tr.Actions.DeepenHistory, func(task gocui.Task) error {
+								return self.c.Git().Sync.Deepen(task, depth)
+							})
+						},
+					})
+
+					return nil
+				},
+			},
+			{
+				Label:   self.c.Tr.DeepenHistorySince,
+				Tooltip: self.c.Tr.DeepenHistorySinceTooltip,
+				Key:     'd',
+				OnPress: func() error {
+					self.c.Prompt(types.PromptOpts{
+						Title: self.c.Tr.DeepenHistorySincePrompt,
+						HandleConfirm: func(response string) error {
+							date := strings.TrimSpace(response)
+							if date == "" {
+								return nil
+							}
+
+							return self.fetchMoreHistory(self.c.Tr.Actions.DeepenHistory, func(task gocui.Task) error {
+								return self.c.Git().Sync.DeepenSince(task, date)
+							})
+						},
+					})
+
+					return nil
+				},
+			},
+			{
+				Label:   self.c.Tr.UnshallowHistory,
+				Tooltip: self.c.Tr.UnshallowHistoryTooltip,
+				Key:     'u',
+				OnPress: func() error {
+					return self.fetchMoreHistory(self.c.Tr.Actions.UnshallowHistory, self.c.Git().Sync.Unshallow)
+				},
+			},
 		},
 	})
 }
 
+func (self *LocalCommitsController) fetchMoreHistory(action string, fetch func(gocui.Task) error) error {
+	return self.c.WithWaitingStatus(self.c.Tr.FetchingHistoryStatus, func(task gocui.Task) error {
+		self.c.LogAction(action)
+		if err := fetch(task); err != nil {
+			return err
+		}
+
+		return self.c.Refresh(types.RefreshOptions{
+			Mode:  types.SYNC,
+			Scope: []types.RefreshableView{types.COMMITS, types.BRANCHES, types.REMOTES},
+		})
+	})
+}
+
 func (self *LocalCommitsController) GetOnFocus() func(types.OnFocusOpts) {
 	return func(types.OnFocusOpts) {
 		context := self.context()
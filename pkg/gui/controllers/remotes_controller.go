@@ -1,15 +1,20 @@
 package controllers
 
 import (
+	"errors"
 	"fmt"
+	"strconv"
 	"strings"
 
 	"github.com/jesseduffield/gocui"
+	"github.com/jesseduffield/lazygit/pkg/commands/git_commands"
 	"github.com/jesseduffield/lazygit/pkg/commands/models"
 	"github.com/jesseduffield/lazygit/pkg/gui/context"
+	"github.com/jesseduffield/lazygit/pkg/gui/controllers/helpers"
 	"github.com/jesseduffield/lazygit/pkg/gui/style"
 	"github.com/jesseduffield/lazygit/pkg/gui/types"
 	"github.com/jesseduffield/lazygit/pkg/utils"
+	"github.com/samber/lo"
 )
 
 type RemotesController struct {
@@ -78,6 +83,14 @@ func (self *RemotesController) GetKeybindings(opts types.KeybindingsOpts) []*typ
 			Tooltip:           self.c.Tr.FetchRemoteTooltip,
 			DisplayOnScreen:   true,
 		},
+		{
+			Key:               opts.GetKey(opts.Config.Branches.ViewFetchOptions),
+			Handler:           self.withItem(self.viewFetchOptions),
+			GetDisabledReason: self.require(self.singleItemSelected()),
+			Description:       self.c.Tr.ViewFetchOptions,
+			Tooltip:           self.c.Tr.ViewFetchOptionsTooltip,
+			OpensMenu:         true,
+		},
 	}
 
 	return bindings
@@ -256,3 +269,191 @@ func (self *RemotesController) fetch(remote *models.Remote) error {
 		})
 	})
 }
+
+// Offers to fetch the remote with different options than the ones configured
+// for it, and reports afterwards which refs were changed
+func (self *RemotesController) viewFetchOptions(remote *models.Remote) error {
+	configuredOpts := self.c.Git().Sync.FetchOptsForRemote(remote.Name)
+	fetchWith := func(modify func(*git_commands.FetchOpts)) func() error {
+		return func() error {
+			opts := configuredOpts
+			modify(&opts)
+			return self.fetchWithReport(remote, opts)
+		}
+	}
+
+	return self.c.Menu(types.CreateMenuOptions{
+		Title: utils.ResolvePlaceholderString(self.c.Tr.FetchOptionsTitle, map[string]string{
+			"remote": remote.Name,
+		}),
+		Items: []*types.MenuItem{
+			{
+				Label:   self.c.Tr.FetchWithConfiguredOptions,
+				Tooltip: self.c.Tr.FetchWithOptionsTooltip,
+				OnPress: fetchWith(func(*git_commands.FetchOpts) {}),
+				Key:     'f',
+			},
+			{
+				LabelColumns: []string{self.c.Tr.FetchAndPrune, style.FgYellow.Sprint("--prune")},
+				Tooltip:      self.c.Tr.FetchAndPruneTooltip,
+				OnPress: fetchWith(func(opts *git_commands.FetchOpts) {
+					opts.Prune = true
+				}),
+				Key: 'p',
+			},
+			{
+				LabelColumns: []string{self.c.Tr.FetchAndPruneTags, style.FgYellow.Sprint("--prune --prune-tags")},
+				Tooltip:      self.c.Tr.FetchAndPruneTagsTooltip,
+				OnPress: fetchWith(func(opts *git_commands.FetchOpts) {
+					opts.Prune = true
+					opts.PruneTags = true
+				}),
+				Key: 'P',
+			},
+			{
+				LabelColumns: []string{self.c.Tr.FetchAllTags, style.FgYellow.Sprint("--tags")},
+				OnPress: fetchWith(func(opts *git_commands.FetchOpts) {
+					opts.Tags = git_commands.FetchTagsAll
+				}),
+				Key: 't',
+			},
+			{
+				LabelColumns: []string{self.c.Tr.FetchWithoutTags, style.FgYellow.Sprint("--no-tags")},
+				OnPress: fetchWith(func(opts *git_commands.FetchOpts) {
+					opts.Tags = git_commands.FetchTagsNone
+				}),
+				Key: 'n',
+			},
+			{
+				LabelColumns: []string{self.c.Tr.FetchWithDepth, style.FgYellow.Sprint("--depth")},
+				Tooltip:      self.c.Tr.FetchWithDepthTooltip,
+				OnPress: func() error {
+					return self.promptForFetchDepth(remote, configuredOpts)
+				},
+				Key: 'd',
+			},
+			{
+				Label:   self.c.Tr.FetchRefspecs,
+				Tooltip: self.c.Tr.FetchRefspecsTooltip,
+				OnPress: func() error {
+					return self.promptForFetchRefspecs(remote, configuredOpts)
+				},
+				Key: 'r',
+			},
+		},
+	})
+}
+
+func (self *RemotesController) promptForFetchDepth(remote *models.Remote, opts git_commands.FetchOpts) error {
+	initialContent := ""
+	if opts.Depth > 0 {
+		initialContent = strconv.Itoa(opts.Depth)
+	}
+
+	self.c.Prompt(types.PromptOpts{
+		Title:          self.c.Tr.FetchDepthPrompt,
+		InitialContent: initialContent,
+		HandleConfirm: func(response string) error {
+			depth, err := strconv.Atoi(strings.TrimSpace(response))
+			if err != nil || depth < 1 {
+				return errors.New(self.c.Tr.InvalidFetchDepth)
+			}
+
+			opts.Depth = depth
+			return self.fetchWithReport(remote, opts)
+		},
+	})
+
+	return nil
+}
+
+func (self *RemotesController) promptForFetchRefspecs(remote *models.Remote, opts git_commands.FetchOpts) error {
+	self.c.Prompt(types.PromptOpts{
+		Title:          self.c.Tr.FetchRefspecsPrompt,
+		InitialContent: strings.Join(opts.Refspecs, " "),
+		HandleConfirm: func(response string) error {
+			opts.Refspecs = strings.Fields(response)
+			return self.fetchWithReport(remote, opts)
+		},
+	})
+
+	return nil
+}
+
+func (self *RemotesController) fetchWithReport(remote *models.Remote, opts git_commands.FetchOpts) error {
+	return self.c.WithInlineStatus(remote, types.ItemOperationFetching, context.REMOTES_CONTEXT_KEY, func(task gocui.Task) error {
+		self.c.LogAction(self.c.Tr.Actions.FetchRemote)
+		fetchedRefs, err := self.c.Git().Sync.FetchRemoteWithReport(task, remote.Name, opts)
+		if err != nil {
+			return err
+		}
+
+		if err := self.c.Refresh(types.RefreshOptions{
+			Scope: []types.RefreshableView{types.BRANCHES, types.REMOTES, types.TAGS},
+			Mode:  types.ASYNC,
+		}); err != nil {
+			return err
+		}
+
+		if !self.c.Git().Sync.CanReportFetchedRefs() {
+			return nil
+		}
+
+		self.c.OnUIThread(func() error {
+			self.showFetchReport(remote, fetchedRefs)
+			return nil
+		})
+		return nil
+	})
+}
+
+func (self *RemotesController) showFetchReport(remote *models.Remote, fetchedRefs []*git_commands.FetchedRef) {
+	if len(fetchedRefs) == 0 {
+		self.c.Toast(utils.ResolvePlaceholderString(self.c.Tr.RemoteAlreadyUpToDate, map[string]string{
+			"remote": remote.Name,
+		}))
+		return
+	}
+
+	lines := lo.Map(fetchedRefs, func(fetchedRef *git_commands.FetchedRef, _ int) []string {
+		return []string{
+			self.fetchedRefStatusText(fetchedRef.Status),
+			strings.TrimPrefix(helpers.ShortBranchName(fetchedRef.Ref), "refs/tags/"),
+			self.fetchedRefHashes(fetchedRef),
+		}
+	})
+	renderedLines, _ := utils.RenderDisplayStrings(lines, nil)
+
+	self.c.Alert(
+		utils.ResolvePlaceholderString(self.c.Tr.FetchReportTitle, map[string]string{
+			"remote": remote.Name,
+		}),
+		strings.Join(renderedLines, "\n"),
+	)
+}
+
+func (self *RemotesController) fetchedRefStatusText(status git_commands.FetchedRefStatus) string {
+	switch status {
+	case git_commands.FetchedRefCreated:
+		return style.FgGreen.Sprint(self.c.Tr.FetchedRefCreated)
+	case git_commands.FetchedRefUpdated:
+		return style.FgYellow.Sprint(self.c.Tr.FetchedRefUpdated)
+	case git_commands.FetchedRefForcedUpdate:
+		return style.FgMagenta.Sprint(self.c.Tr.FetchedRefForcedUpdate)
+	case git_commands.FetchedRefPruned:
+		return style.FgRed.Sprint(self.c.Tr.FetchedRefPruned)
+	default:
+		return style.FgRed.Sprint(self.c.Tr.FetchedRefRejected)
+	}
+}
+
+func (self *RemotesController) fetchedRefHashes(fetchedRef *git_commands.FetchedRef) string {
+	switch fetchedRef.Status {
+	case git_commands.FetchedRefCreated:
+		return utils.ShortHash(fetchedRef.NewHash)
+	case git_commands.FetchedRefPruned:
+		return utils.ShortHash(fetchedRef.OldHash)
+	default:
+		return utils.ShortHash(fetchedRef.OldHash) + ".." + utils.ShortHash(fetchedRef.NewHash)
+	}
+}
@@ -7,6 +7,7 @@ import (
 	"time"
 
 	"github.com/jesseduffield/gocui"
+	"github.com/jesseduffield/lazygit/pkg/commands/git_commands"
 	"github.com/jesseduffield/lazygit/pkg/commands/types/enums"
 	"github.com/jesseduffield/lazygit/pkg/constants"
 	"github.com/jesseduffield/lazygit/pkg/gui/presentation"
@@ -59,6 +60,19 @@ func (self *StatusController) GetKeybindings(opts types.KeybindingsOpts) []*type
 			Description:     self.c.Tr.SwitchRepo,
 			DisplayOnScreen: true,
 		},
+		{
+			Key:         opts.GetKey(opts.Config.Status.CloneRepository),
+			Handler:     self.c.Helpers().Repos.CloneRepository,
+			Description: self.c.Tr.CloneRepository,
+			Tooltip:     self.c.Tr.CloneRepositoryTooltip,
+		},
+		{
+			Key:         opts.GetKey(opts.Config.Status.PerformanceSettings),
+			Handler:     self.openPerformanceSettingsMenu,
+			Description: self.c.Tr.StatusPerformanceSettings,
+			Tooltip:     self.c.Tr.StatusPerformanceSettingsTooltip,
+			OpensMenu:   true,
+		},
 		{
 			Key:         opts.GetKey(opts.Config.Status.AllBranchesLogGraph),
 			Handler:     func() error { self.showAllBranchLogs(); return nil },
@@ -216,6 +230,10 @@ func (self *StatusController) showDashboard() {
 			style.FgMagenta.Sprintf("Become a sponsor: %s", constants.Links.Donate), // caffeine ain't free
 		}, "\n\n") + "\n"
 
+	if performance := self.c.Model().StatusPerformance; performance != nil {
+		dashboardString += "\n" + strings.Join(self.statusPerformanceLines(performance), "\n") + "\n"
+	}
+
 	self.c.RenderToMainViews(types.RefreshMainOpts{
 		Pair: self.c.MainViewPairs().Normal,
 		Main: &types.ViewUpdateOpts{
@@ -223,6 +241,128 @@ func (self *StatusController) showDashboard() {
 			Task:  types.NewRenderStringTask(dashboardString),
 		},
 	})
+
+	self.loadStatusPerformance()
+}
+
+// loadStatusPerformance reads the settings shown in the dashboard in the
+// background, and re-renders the dashboard if they changed
+func (self *StatusController) loadStatusPerformance() {
+	self.c.OnWorker(func(gocui.Task) error {
+		performance := self.c.Git().Status.StatusPerformance()
+		self.c.OnUIThread(func() error {
+			if !self.setStatusPerformance(performance) {
+				return nil
+			}
+
+			if self.c.Context().Current() == self.Context() {
+				self.GetOnRenderToMain()()
+			}
+			return nil
+		})
+		return nil
+	})
+}
+
+// setStatusPerformance stores the settings in the model, returning whether
+// they changed. Must be called on the UI thread, which is where they're read.
+func (self *StatusController) setStatusPerformance(performance *git_commands.StatusPerformance) bool {
+	if oldPerformance := self.c.Model().StatusPerformance; oldPerformance != nil && *oldPerformance == *performance {
+		return false
+	}
+
+	self.c.Model().StatusPerformance = performance
+	return true
+}
+
+func (self *StatusController) statusPerformanceLines(performance *git_commands.StatusPerformance) []string {
+	fsmonitorState := self.c.Tr.SettingDisabled
+	switch {
+	case performance.FsmonitorHook():
+		fsmonitorState = utils.ResolvePlaceholderString(self.c.Tr.FsmonitorUsingHook, map[string]string{
+			"hook": performance.Fsmonitor,
+		})
+	case performance.FsmonitorEnabled() && performance.FsmonitorDaemonRunning:
+		fsmonitorState = self.c.Tr.FsmonitorDaemonRunning
+	case performance.FsmonitorEnabled():
+		fsmonitorState = self.c.Tr.FsmonitorDaemonNotRunning
+	case !performance.FsmonitorDaemonSupported:
+		fsmonitorState = self.c.Tr.FsmonitorUnavailable
+	}
+
+	untrackedCacheState := self.c.Tr.SettingDisabled
+	if performance.UntrackedCacheEnabled() {
+		untrackedCacheState = self.c.Tr.SettingEnabled
+	}
+
+	return []string{
+		fmt.Sprintf("%s: %s", self.c.Tr.FsmonitorSetting, fsmonitorState),
+		fmt.Sprintf("%s: %s", self.c.Tr.UntrackedCacheSetting, untrackedCacheState),
+	}
+}
+
+func (self *StatusController) openPerformanceSettingsMenu() error {
+	// reading the settings runs a few git commands, one of which asks the
+	// fsmonitor daemon for its status, so we don't do it on the UI thread
+	return self.c.WithWaitingStatus(self.c.Tr.LoadingStatusPerformanceStatus, func(gocui.Task) error {
+		performance := self.c.Git().Status.StatusPerformance()
+		self.c.OnUIThread(func() error {
+			self.setStatusPerformance(performance)
+			return self.showPerformanceSettingsMenu(performance)
+		})
+		return nil
+	})
+}
+
+func (self *StatusController) showPerformanceSettingsMenu(performance *git_commands.StatusPerformance) error {
+	var fsmonitorDisabledReason *types.DisabledReason
+	if performance.FsmonitorHook() {
+		fsmonitorDisabledReason = &types.DisabledReason{Text: utils.ResolvePlaceholderString(self.c.Tr.FsmonitorHookConfigured, map[string]string{
+			"hook": performance.Fsmonitor,
+		})}
+	} else if !performance.FsmonitorEnabled() && !performance.FsmonitorDaemonSupported {
+		fsmonitorDisabledReason = &types.DisabledReason{Text: self.c.Tr.FsmonitorNotSupported}
+	}
+
+	return self.c.Menu(types.CreateMenuOptions{
+		Title: self.c.Tr.StatusPerformanceSettings,
+		Items: []*types.MenuItem{
+			{
+				Label:          self.c.Tr.FsmonitorSetting,
+				Widget:         types.MakeMenuCheckBox(performance.FsmonitorEnabled()),
+				Tooltip:        self.c.Tr.FsmonitorSettingTooltip,
+				DisabledReason: fsmonitorDisabledReason,
+				OnPress: func() error {
+					self.c.LogAction(self.c.Tr.Actions.SetFsmonitor)
+					return self.setStatusPerformanceSetting(self.c.Git().Status.SetFsmonitor(!performance.FsmonitorEnabled()))
+				},
+			},
+			{
+				Label:   self.c.Tr.UntrackedCacheSetting,
+				Widget:  types.MakeMenuCheckBox(performance.UntrackedCacheEnabled()),
+				Tooltip: self.c.Tr.UntrackedCacheSettingTooltip,
+				OnPress: func() error {
+					self.c.LogAction(self.c.Tr.Actions.SetUntrackedCache)
+					return self.setStatusPerformanceSetting(self.c.Git().Status.SetUntrackedCache(!performance.UntrackedCacheEnabled()))
+				},
+			},
+		},
+	})
+}
+
+func (self *StatusController) setStatusPerformanceSetting(err error) error {
+	if err != nil {
+		return err
+	}
+
+	self.c.OnWorker(func(gocui.Task) error {
+		// refreshing the files runs `git status`, which starts the daemon or
+		// populates the untracked cache, so we look at the settings afterwards
+		err := self.c.Refresh(types.RefreshOptions{Scope: []types.RefreshableView{types.FILES}})
+		self.loadStatusPerformance()
+		return err
+	})
+	return nil
 }
 
 func (self *StatusController) handleCheckForUpdate() error {
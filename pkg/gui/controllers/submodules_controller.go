@@ -6,12 +6,14 @@ import (
 	"strings"
 
 	"github.com/jesseduffield/gocui"
+	"github.com/jesseduffield/lazygit/pkg/commands/git_commands"
 	"github.com/jesseduffield/lazygit/pkg/commands/models"
 	"github.com/jesseduffield/lazygit/pkg/gui/context"
 	"github.com/jesseduffield/lazygit/pkg/gui/keybindings"
 	"github.com/jesseduffield/lazygit/pkg/gui/style"
 	"github.com/jesseduffield/lazygit/pkg/gui/types"
 	"github.com/jesseduffield/lazygit/pkg/utils"
+	"github.com/samber/lo"
 )
 
 type SubmodulesController struct {
@@ -102,6 +104,20 @@ func (self *SubmodulesController) GetKeybindings(opts types.KeybindingsOpts) []*
 	}
 }
 
+// Submodule statuses aren't loaded by file refreshes unless the submodules
+// panel is showing, so we load them when it comes into view
+func (self *SubmodulesController) GetOnFocus() func(types.OnFocusOpts) {
+	return func(types.OnFocusOpts) {
+		if !self.c.Helpers().Refresh.SubmoduleStatusesOutdated() {
+			return
+		}
+
+		self.c.OnWorker(func(_ gocui.Task) error {
+			return self.c.Refresh(types.RefreshOptions{Scope: []types.RefreshableView{types.SUBMODULES}})
+		})
+	}
+}
+
 func (self *SubmodulesController) GetOnClick() func() error {
 	return self.withItemGraceful(self.enter)
 }
@@ -111,18 +127,33 @@ func (self *SubmodulesController) GetOnRenderToMain() func() {
 		self.c.Helpers().Diff.WithDiffModeCheck(func() {
 			var task types.UpdateTask
 			submodule := self.context().GetSelected()
+			if self.c.Helpers().Submodules.RenderLastRun(submodule) {
+				return
+			}
+
 			if submodule == nil {
 				task = types.NewRenderStringTask("No submodules")
 			} else {
 				prefix := fmt.Sprintf(
-					"Name: %s\nPath: %s\nUrl:  %s\n\n",
+					"Name: %s\nPath: %s\nUrl:  %s\n",
 					style.FgGreen.Sprint(submodule.FullName()),
 					style.FgYellow.Sprint(submodule.FullPath()),
 					style.FgCyan.Sprint(submodule.Url),
 				)
+				if submodule.Branch != "" {
+					prefix += fmt.Sprintf("Branch: %s\n", style.FgCyan.Sprint(submodule.Branch))
+				}
+				prefix += self.statusDescription(submodule.Status) + "\n"
 
+				// The diff of a modified submodule already lists the commits
+				// between the recorded and the checked-out commit, but if the
+				// submodule is configured to be ignored by git status there is
+				// no file, so we show them ourselves
 				file := self.c.Helpers().WorkingTree.FileForSubmodule(submodule)
-				if file == nil {
+				if file == nil && submodule.Status != nil && submodule.Status.HasDrift() {
+					cmdObj := self.c.Git().Submodule.DriftLogCmdObj(submodule)
+					task = types.NewRunCommandTaskWithPrefix(cmdObj.GetCmd(), prefix)
+				} else if file == nil {
 					task = types.NewRenderStringTask(prefix)
 				} else {
 					cmdObj := self.c.Git().WorkingTree.WorktreeFileDiffCmdObj(file, false, !file.HasUnstagedChanges && file.HasStagedChanges)
@@ -141,6 +172,35 @@ func (self *SubmodulesController) GetOnRenderToMain() func() {
 	}
 }
 
+func (self *SubmodulesController) statusDescription(status *models.SubmoduleStatus) string {
+	if status == nil {
+		return ""
+	}
+
+	if !status.IsInitialized {
+		return fmt.Sprintf("Status: %s\n", style.FgMagenta.Sprint(self.c.Tr.SubmoduleNotInitialized))
+	}
+
+	if status.HasMergeConflicts {
+		return fmt.Sprintf("Status: %s\n", style.FgRed.Sprint(self.c.Tr.SubmoduleHasMergeConflicts))
+	}
+
+	states := []string{}
+	if status.HasDrift() {
+		states = append(states, style.FgYellow.Sprint(self.c.Tr.SubmoduleDrift))
+	} else {
+		states = append(states, style.FgGreen.Sprint(self.c.Tr.SubmoduleInSync))
+	}
+	if status.IsDirty {
+		states = append(states, style.FgRed.Sprint(self.c.Tr.SubmoduleDirty))
+	}
+
+	result := fmt.Sprintf("Status: %s\n", strings.Join(states, ", "))
+	result += fmt.Sprintf("Recorded commit:    %s\n", style.FgYellow.Sprint(utils.ShortHash(status.RecordedHash)))
+	result += fmt.Sprintf("Checked-out commit: %s\n", style.FgYellow.Sprint(utils.ShortHash(status.CheckedOutHash)))
+	return result
+}
+
 func (self *SubmodulesController) enter(submodule *models.SubmoduleConfig) error {
 	return self.c.Helpers().Repos.EnterSubmodule(submodule)
 }
@@ -261,10 +321,31 @@ func (self *SubmodulesController) openBulkActionsMenu() error {
 				},
 				Key: 'd',
 			},
+			self.parallelOperationMenuItem(git_commands.SubmoduleOperationInit, self.c.Tr.ParallelInitSubmodules, 'I'),
+			self.parallelOperationMenuItem(git_commands.SubmoduleOperationUpdate, self.c.Tr.ParallelUpdateSubmodules, 'U'),
+			self.parallelOperationMenuItem(git_commands.SubmoduleOperationSync, self.c.Tr.ParallelSyncSubmodules, 's'),
+			self.parallelOperationMenuItem(git_commands.SubmoduleOperationFetch, self.c.Tr.ParallelFetchSubmodules, 'f'),
 		},
 	})
 }
 
+func (self *SubmodulesController) parallelOperationMenuItem(operation git_commands.SubmoduleOperation, label string, key types.Key) *types.MenuItem {
+	jobs := utils.ResolvePlaceholderString(self.c.Tr.ParallelSubmoduleJobs, map[string]string{
+		"jobs": fmt.Sprint(self.c.UserConfig().Git.SubmoduleJobs),
+	})
+
+	return &types.MenuItem{
+		LabelColumns: []string{label, style.FgCyan.Sprint(jobs)},
+		OnPress: func() error {
+			topLevelSubmodules := lo.Filter(self.c.Model().Submodules, func(submodule *models.SubmoduleConfig, _ int) bool {
+				return submodule.ParentModule == nil
+			})
+			return self.c.Helpers().Submodules.RunRecursively(operation, topLevelSubmodules)
+		},
+		Key: key,
+	}
+}
+
 func (self *SubmodulesController) update(submodule *models.SubmoduleConfig) error {
 	return self.c.WithWaitingStatus(self.c.Tr.UpdatingSubmoduleStatus, func(gocui.Task) error {
 		self.c.LogAction(self.c.Tr.Actions.UpdateSubmodule)
@@ -9,8 +9,11 @@ import (
 	"github.com/jesseduffield/lazygit/pkg/commands/git_commands"
 	"github.com/jesseduffield/lazygit/pkg/commands/models"
 	"github.com/jesseduffield/lazygit/pkg/gui/context"
+	"github.com/jesseduffield/lazygit/pkg/gui/controllers/helpers"
+	"github.com/jesseduffield/lazygit/pkg/gui/style"
 	"github.com/jesseduffield/lazygit/pkg/gui/types"
 	"github.com/jesseduffield/lazygit/pkg/utils"
+	"github.com/samber/lo"
 )
 
 type SyncController struct {
@@ -45,6 +48,14 @@ func (self *SyncController) GetKeybindings(opts types.KeybindingsOpts) []*types.
 			Description:       self.c.Tr.Pull,
 			Tooltip:           self.c.Tr.PullTooltip,
 		},
+		{
+			Key:               opts.GetKey(opts.Config.Universal.ViewPushOptions),
+			Handler:           opts.Guards.NoPopupPanel(self.HandleViewPushOptions),
+			GetDisabledReason: self.getDisabledReasonForPushOrPull,
+			Description:       self.c.Tr.ViewPushOptions,
+			Tooltip:           self.c.Tr.ViewPushOptionsTooltip,
+			OpensMenu:         true,
+		},
 	}
 
 	return bindings
@@ -62,6 +73,10 @@ func (self *SyncController) HandlePull() error {
 	return self.branchCheckedOut(self.pull)()
 }
 
+func (self *SyncController) HandleViewPushOptions() error {
+	return self.branchCheckedOut(self.viewPushOptions)()
+}
+
 func (self *SyncController) getDisabledReasonForPushOrPull() *types.DisabledReason {
 	currentBranch := self.c.Helpers().Refs.GetCheckedOutRef()
 	if currentBranch != nil {
@@ -179,11 +194,13 @@ func (self *SyncController) pullWithLock(task gocui.Task, opts PullFilesOptions)
 }
 
 type pushOpts struct {
-	force          bool
-	forceWithLease bool
-	upstreamRemote string
-	upstreamBranch string
-	setUpstream    bool
+	force                      bool
+	forceWithLease             bool
+	forceWithLeaseExpectedHash string
+	upstreamRemote             string
+	upstreamBranch             string
+	setUpstream                bool
+	pushOptions                []string
 
 	// If this is false, we can't tell ahead of time whether a force-push will
 	// be necessary, so we start with a normal push and offer to force-push if
@@ -198,14 +215,19 @@ func (self *SyncController) pushAux(currentBranch *models.Branch, opts pushOpts)
 		err := self.c.Git().Sync.Push(
 			task,
 			git_commands.PushOpts{
-				Force:          opts.force,
-				ForceWithLease: opts.forceWithLease,
-				CurrentBranch:  currentBranch.Name,
-				UpstreamRemote: opts.upstreamRemote,
-				UpstreamBranch: opts.upstreamBranch,
-				SetUpstream:    opts.setUpstream,
+				Force:                      opts.force,
+				ForceWithLease:             opts.forceWithLease,
+				ForceWithLeaseExpectedHash: opts.forceWithLeaseExpectedHash,
+				CurrentBranch:              currentBranch.Name,
+				UpstreamRemote:             opts.upstreamRemote,
+				UpstreamBranch:             opts.upstreamBranch,
+				SetUpstream:                opts.setUpstream,
+				PushOptions:                opts.pushOptions,
 			})
 		if err != nil {
+			if opts.forceWithLeaseExpectedHash != "" && strings.Contains(err.Error(), "stale info") {
+				return errors.New(self.c.Tr.ForcePushWithLeaseRejected)
+			}
 			if !opts.force && !opts.forceWithLease && strings.Contains(err.Error(), "Updates were rejected") {
 				if opts.remoteBranchStoredLocally {
 					return errors.New(self.c.Tr.UpdatesRejected)
@@ -215,17 +237,19 @@ func (self *SyncController) pushAux(currentBranch *models.Branch, opts pushOpts)
 				if forcePushDisabled {
 					return errors.New(self.c.Tr.UpdatesRejectedAndForcePushDisabled)
 				}
-				self.c.Confirm(types.ConfirmOpts{
-					Title:  self.c.Tr.ForcePush,
-					Prompt: self.forcePushPrompt(),
-					HandleConfirm: func() error {
-						newOpts := opts
-						newOpts.force = true
-
-						return self.pushAux(currentBranch, newOpts)
-					},
+				return self.guardForcePush(currentBranch, func() error {
+					self.c.Confirm(types.ConfirmOpts{
+						Title:  self.c.Tr.ForcePush,
+						Prompt: self.forcePushPrompt() + "\n\n" + self.c.Tr.LossPreviewRemoteNotStoredLocally,
+						HandleConfirm: func() error {
+							newOpts := opts
+							newOpts.force = true
+
+							return self.pushAux(currentBranch, newOpts)
+						},
+					})
+					return nil
 				})
-				return nil
 			}
 			return err
 		}
@@ -239,16 +263,43 @@ func (self *SyncController) requestToForcePush(currentBranch *models.Branch, opt
 		return errors.New(self.c.Tr.ForcePushDisabled)
 	}
 
-	self.c.Confirm(types.ConfirmOpts{
-		Title:  self.c.Tr.ForcePush,
-		Prompt: self.forcePushPrompt(),
-		HandleConfirm: func() error {
-			opts.forceWithLease = true
-			return self.pushAux(currentBranch, opts)
-		},
+	return self.guardForcePush(currentBranch, func() error {
+		return self.confirmForcePush(currentBranch, opts)
 	})
+}
 
-	return nil
+func (self *SyncController) confirmForcePush(currentBranch *models.Branch, opts pushOpts) error {
+	lossPreview := self.c.Helpers().LossPreview
+	return lossPreview.WithPreview(func() (*models.LossPreview, error) {
+		// we push to the branch's push destination, which isn't necessarily its
+		// upstream (e.g. in a triangular workflow)
+		return self.c.Git().Sync.ForcePushPreview(currentBranch.FullRefName(), currentBranch.Name+"@{push}")
+	}, func(preview *models.LossPreview, err error) error {
+		prompt, _ := lossPreview.PromptWithPreview(
+			self.forcePushPrompt(), preview, err, self.c.Tr.LossPreviewOverwrittenCommits,
+		)
+
+		self.c.Confirm(types.ConfirmOpts{
+			Title:  self.c.Tr.ForcePush,
+			Prompt: prompt,
+			HandleConfirm: func() error {
+				opts.forceWithLease = true
+				return self.pushAux(currentBranch, opts)
+			},
+		})
+		return nil
+	})
+}
+
+// Force pushing overwrites the remote branch, which is usually the upstream
+// but might have the name of the local branch in a triangular workflow, so we
+// check both names against the protected branches
+func (self *SyncController) guardForcePush(currentBranch *models.Branch, f func() error) error {
+	return self.c.Helpers().ProtectedBranches.Guard(
+		helpers.ProtectedBranchForcePush,
+		[]string{currentBranch.Name, currentBranch.UpstreamBranch},
+		f,
+	)
 }
 
 func (self *SyncController) forcePushPrompt() string {
@@ -260,3 +311,217 @@ func (self *SyncController) forcePushPrompt() string {
 		},
 	)
 }
+
+func (self *SyncController) viewPushOptions(currentBranch *models.Branch) error {
+	return self.c.Menu(types.CreateMenuOptions{
+		Title: self.c.Tr.PushOptionsTitle,
+		Items: []*types.MenuItem{
+			self.forcePushWithLeaseMenuItem(currentBranch),
+			self.pushWithPushOptionsMenuItem(currentBranch),
+			self.pushCommitToRemoteRefMenuItem(currentBranch),
+			self.pushBranchesAtomicallyMenuItem(),
+		},
+	})
+}
+
+// Pins the lease to the commit our remote-tracking branch is at when the item
+// is pressed, so that a background fetch while the confirmation is shown can't
+// make us overwrite commits we haven't seen
+func (self *SyncController) forcePushWithLeaseMenuItem(currentBranch *models.Branch) *types.MenuItem {
+	menuItem := &types.MenuItem{
+		Label: utils.ResolvePlaceholderString(self.c.Tr.ForcePushWithLeaseExpecting, map[string]string{
+			"ref": currentBranch.ShortUpstreamRefName(),
+		}),
+		Tooltip: self.c.Tr.ForcePushWithLeaseExpectingTooltip,
+		Key:     'f',
+	}
+
+	if self.c.UserConfig().Git.DisableForcePushing {
+		menuItem.Label = self.c.Tr.ForcePushWithLease
+		menuItem.DisabledReason = &types.DisabledReason{Text: self.c.Tr.ForcePushingDisabled}
+		return menuItem
+	}
+
+	if !currentBranch.IsTrackingRemote() {
+		menuItem.Label = self.c.Tr.ForcePushWithLease
+		menuItem.DisabledReason = &types.DisabledReason{Text: self.c.Tr.CheckedOutBranchHasNoUpstream}
+		return menuItem
+	}
+
+	if currentBranch.RemoteBranchNotStoredLocally() {
+		menuItem.DisabledReason = &types.DisabledReason{Text: self.upstreamNotFetchedText(currentBranch)}
+		return menuItem
+	}
+
+	menuItem.OnPress = func() error {
+		return self.guardForcePush(currentBranch, func() error {
+			return self.c.WithWaitingStatus(self.c.Tr.LossPreviewStatus, func(gocui.Task) error {
+				expectedHash, err := self.c.Git().Branch.GetUpstreamHash(currentBranch)
+				if err != nil {
+					return errors.New(self.upstreamNotFetchedText(currentBranch))
+				}
+
+				preview, previewErr := self.c.Git().Sync.ForcePushPreview(currentBranch.FullRefName(), expectedHash)
+				self.c.OnUIThread(func() error {
+					return self.c.Helpers().LossPreview.ConfirmIfLosingAnything(types.ConfirmOpts{
+						Title: self.c.Tr.ForcePush,
+						Prompt: utils.ResolvePlaceholderString(self.c.Tr.ForcePushWithLeasePrompt, map[string]string{
+							"ref":  currentBranch.ShortUpstreamRefName(),
+							"hash": utils.ShortHash(expectedHash),
+						}),
+						HandleConfirm: func() error {
+							return self.pushAux(currentBranch, pushOpts{
+								forceWithLease:             true,
+								forceWithLeaseExpectedHash: expectedHash,
+								upstreamRemote:             currentBranch.UpstreamRemote,
+								upstreamBranch:             currentBranch.UpstreamBranch,
+								remoteBranchStoredLocally:  true,
+							})
+						},
+					}, preview, previewErr, self.c.Tr.LossPreviewOverwrittenCommits)
+				})
+				return nil
+			})
+		})
+	}
+	return menuItem
+}
+
+func (self *SyncController) upstreamNotFetchedText(currentBranch *models.Branch) string {
+	return utils.ResolvePlaceholderString(
+		self.c.Tr.UpstreamNotFetched,
+		map[string]string{"ref": currentBranch.ShortUpstreamRefName()},
+	)
+}
+
+func (self *SyncController) pushWithPushOptionsMenuItem(currentBranch *models.Branch) *types.MenuItem {
+	pushOptions := self.c.UserConfig().Git.PushOptions[currentBranch.UpstreamRemote]
+
+	menuItem := &types.MenuItem{
+		LabelColumns: []string{self.c.Tr.PushWithPushOptions, style.FgCyan.Sprint(strings.Join(pushOptions, " "))},
+		Tooltip:      self.c.Tr.PushWithPushOptionsTooltip,
+		Key:          'o',
+		OnPress: func() error {
+			return self.pushAux(currentBranch, pushOpts{
+				pushOptions:               pushOptions,
+				remoteBranchStoredLocally: currentBranch.RemoteBranchStoredLocally(),
+			})
+		},
+	}
+
+	if !currentBranch.IsTrackingRemote() {
+		menuItem.DisabledReason = &types.DisabledReason{Text: self.c.Tr.CheckedOutBranchHasNoUpstream}
+	} else if len(pushOptions) == 0 {
+		menuItem.DisabledReason = &types.DisabledReason{Text: utils.ResolvePlaceholderString(
+			self.c.Tr.NoPushOptionsConfigured,
+			map[string]string{"remote": currentBranch.UpstreamRemote},
+		)}
+	}
+
+	return menuItem
+}
+
+func (self *SyncController) pushCommitToRemoteRefMenuItem(currentBranch *models.Branch) *types.MenuItem {
+	commit := self.c.Contexts().LocalCommits.GetSelected()
+
+	menuItem := &types.MenuItem{
+		Label:   self.c.Tr.PushCommitToRemoteRef,
+		Tooltip: self.c.Tr.PushCommitToRemoteRefTooltip,
+		Key:     'c',
+	}
+
+	if commit == nil || commit.IsTODO() {
+		menuItem.DisabledReason = &types.DisabledReason{Text: self.c.Tr.NoCommitSelectedInCommitsPanel}
+		return menuItem
+	}
+
+	menuItem.LabelColumns = []string{self.c.Tr.PushCommitToRemoteRef, style.FgYellow.Sprint(commit.ShortHash())}
+	menuItem.OnPress = func() error {
+		initialContent := ""
+		if currentBranch.IsTrackingRemote() {
+			initialContent = currentBranch.UpstreamRemote + " " + currentBranch.UpstreamBranch
+		}
+
+		self.c.Prompt(types.PromptOpts{
+			Title: utils.ResolvePlaceholderString(self.c.Tr.PushCommitToRemoteRefPrompt, map[string]string{
+				"commit": commit.ShortHash(),
+			}),
+			InitialContent:      initialContent,
+			FindSuggestionsFunc: self.c.Helpers().Suggestions.GetRemoteBranchesSuggestionsFunc(" "),
+			HandleConfirm: func(response string) error {
+				remoteName, remoteRef, err := self.c.Helpers().Upstream.ParseUpstream(response)
+				if err != nil {
+					return err
+				}
+				if !strings.HasPrefix(remoteRef, "refs/") {
+					remoteRef = "refs/heads/" + remoteRef
+				}
+
+				return self.c.WithWaitingStatus(self.c.Tr.PushingStatus, func(task gocui.Task) error {
+					self.c.LogAction(self.c.Tr.Actions.PushCommitToRemoteRef)
+					if err := self.c.Git().Sync.Push(task, git_commands.PushOpts{
+						UpstreamRemote: remoteName,
+						Refspecs:       []string{commit.Hash + ":" + remoteRef},
+					}); err != nil {
+						return err
+					}
+					return self.c.Refresh(types.RefreshOptions{Mode: types.ASYNC})
+				})
+			},
+		})
+		return nil
+	}
+	return menuItem
+}
+
+func (self *SyncController) pushBranchesAtomicallyMenuItem() *types.MenuItem {
+	branches, _, _ := self.c.Contexts().Branches.GetSelectedItems()
+
+	menuItem := &types.MenuItem{
+		Label:   self.c.Tr.PushBranchesAtomically,
+		Tooltip: self.c.Tr.PushBranchesAtomicallyTooltip,
+		Key:     'a',
+	}
+
+	if len(branches) < 2 {
+		menuItem.DisabledReason = &types.DisabledReason{Text: self.c.Tr.SelectMultipleBranchesForAtomicPush}
+		return menuItem
+	}
+
+	if lo.SomeBy(branches, func(branch *models.Branch) bool { return !branch.IsTrackingRemote() }) {
+		menuItem.DisabledReason = &types.DisabledReason{Text: self.c.Tr.AtomicPushRequiresUpstreams}
+		return menuItem
+	}
+
+	remoteName := branches[0].UpstreamRemote
+	if lo.SomeBy(branches, func(branch *models.Branch) bool { return branch.UpstreamRemote != remoteName }) {
+		menuItem.DisabledReason = &types.DisabledReason{Text: self.c.Tr.AtomicPushRequiresSameRemote}
+		return menuItem
+	}
+
+	menuItem.LabelColumns = []string{
+		self.c.Tr.PushBranchesAtomically,
+		style.FgCyan.Sprint(utils.ResolvePlaceholderString(self.c.Tr.AtomicPushBranchCount, map[string]string{
+			"count":  fmt.Sprint(len(branches)),
+			"remote": remoteName,
+		})),
+	}
+	menuItem.OnPress = func() error {
+		refspecs := lo.Map(branches, func(branch *models.Branch, _ int) string {
+			return branch.FullRefName() + ":refs/heads/" + branch.UpstreamBranch
+		})
+
+		return self.c.WithWaitingStatus(self.c.Tr.PushingStatus, func(task gocui.Task) error {
+			self.c.LogAction(self.c.Tr.Actions.PushBranchesAtomically)
+			if err := self.c.Git().Sync.Push(task, git_commands.PushOpts{
+				UpstreamRemote: remoteName,
+				Atomic:         true,
+				Refspecs:       refspecs,
+			}); err != nil {
+				return err
+			}
+			return self.c.Refresh(types.RefreshOptions{Mode: types.ASYNC})
+		})
+	}
+	return menuItem
+}
@@ -48,6 +48,25 @@ func (self *TagsController) GetKeybindings(opts types.KeybindingsOpts) []*types.
 			Tooltip:         self.c.Tr.NewTagTooltip,
 			DisplayOnScreen: true,
 		},
+		{
+			Key:         opts.GetKey(opts.Config.Branches.CreateSignedTag),
+			Handler:     self.createSigned,
+			Description: self.c.Tr.NewSignedTag,
+			Tooltip:     self.c.Tr.NewSignedTagTooltip,
+		},
+		{
+			Key:         opts.GetKey(opts.Config.Branches.CreateReleaseTag),
+			Handler:     self.createRelease,
+			Description: self.c.Tr.NewReleaseTag,
+			Tooltip:     self.c.Tr.NewReleaseTagTooltip,
+			OpensMenu:   true,
+		},
+		{
+			Key:         opts.GetKey(opts.Config.Branches.SortOrder),
+			Handler:     self.createSortMenu,
+			Description: self.c.Tr.SortOrder,
+			OpensMenu:   true,
+		},
 		{
 			Key:               opts.GetKey(opts.Config.Universal.Remove),
 			Handler:           self.withItem(self.delete),
@@ -74,6 +93,14 @@ func (self *TagsController) GetKeybindings(opts types.KeybindingsOpts) []*types.
 			DisplayOnScreen:   true,
 			OpensMenu:         true,
 		},
+		{
+			Key:               opts.GetKey(opts.Config.Commits.GenerateChangelog),
+			Handler:           self.withItem(self.generateChangelog),
+			GetDisabledReason: self.require(self.singleItemSelected()),
+			Description:       self.c.Tr.GenerateChangelog,
+			Tooltip:           self.c.Tr.GenerateChangelogTooltip,
+			OpensMenu:         true,
+		},
 		{
 			Key: opts.GetKey(opts.Config.Universal.OpenDiffTool),
 			Handler: self.withItem(func(selectedTag *models.Tag) error {
@@ -94,6 +121,10 @@ func (self *TagsController) GetOnRenderToMain() func() {
 			tag := self.context().GetSelected()
 			if tag == nil {
 				task = types.NewRenderStringTask("No tags")
+			} else if tag.IsAnnotated {
+				// show the annotation followed by the commit that the tag points to
+				cmdObj := self.c.Git().Tag.ShowCmdObj(tag.Name)
+				task = types.NewRunCommandTask(cmdObj.GetCmd())
 			} else {
 				cmdObj := self.c.Git().Branch.GetGraphCmdObj(tag.FullRefName())
 				task = types.NewRunCommandTask(cmdObj.GetCmd())
@@ -301,6 +332,10 @@ func (self *TagsController) push(tag *models.Tag) error {
 	return nil
 }
 
+func (self *TagsController) generateChangelog(tag *models.Tag) error {
+	return self.c.Helpers().Changelog.OpenChangelogPrompt(tag.FullRefName(), tag.Name)
+}
+
 func (self *TagsController) createResetMenu(tag *models.Tag) error {
 	return self.c.Helpers().Refs.CreateGitResetMenu(tag.Name)
 }
@@ -312,6 +347,29 @@ func (self *TagsController) create() error {
 	})
 }
 
+func (self *TagsController) createSigned() error {
+	return self.c.Helpers().Tags.OpenCreateSignedTagPrompt("", func() {
+		self.context().SetSelection(0)
+	})
+}
+
+func (self *TagsController) createRelease() error {
+	return self.c.Helpers().Tags.OpenCreateReleaseTagMenu()
+}
+
+func (self *TagsController) createSortMenu() error {
+	return self.c.Helpers().Refs.CreateSortOrderMenu([]string{"date", "alphabetical", "version"}, "creatordate", func(sortOrder string) error {
+		if self.c.GetAppState().TagSortOrder != sortOrder {
+			self.c.GetAppState().TagSortOrder = sortOrder
+			self.c.SaveAppStateAndLogError()
+			self.context().SetSelection(0)
+			return self.c.Refresh(types.RefreshOptions{Mode: types.ASYNC, Scope: []types.RefreshableView{types.TAGS}})
+		}
+		return nil
+	},
+		self.c.GetAppState().TagSortOrder)
+}
+
 func (self *TagsController) context() *context.TagsContext {
 	return self.c.Contexts().Tags
 }
@@ -6,6 +6,7 @@ import (
 
 	"github.com/jesseduffield/gocui"
 	"github.com/jesseduffield/lazygit/pkg/commands/types/enums"
+	"github.com/jesseduffield/lazygit/pkg/gui/controllers/helpers"
 	"github.com/jesseduffield/lazygit/pkg/gui/types"
 	"github.com/jesseduffield/lazygit/pkg/utils"
 )
@@ -253,30 +254,34 @@ type hardResetOptions struct {
 
 // only to be used in the undo flow for now (does an autostash)
 func (self *UndoController) hardResetWithAutoStash(commitHash string, options hardResetOptions) error {
-	reset := func() error {
-		return self.c.Helpers().Refs.ResetToRef(commitHash, "hard", options.EnvVars)
-	}
+	// like any other hard reset, this discards commits on the checked-out
+	// branch, so it needs to respect the protected branches
+	return self.c.Helpers().ProtectedBranches.GuardCheckedOutBranch(helpers.ProtectedBranchReset, func() error {
+		reset := func() error {
+			return self.c.Helpers().Refs.ResetToRef(commitHash, "hard", options.EnvVars)
+		}
 
-	// if we have any modified tracked files we need to auto-stash
-	dirtyWorkingTree := self.c.Helpers().WorkingTree.IsWorkingTreeDirty()
-	if dirtyWorkingTree {
-		return self.c.WithWaitingStatus(options.WaitingStatus, func(gocui.Task) error {
-			if err := self.c.Git().Stash.Push(self.c.Tr.StashPrefix + commitHash); err != nil {
-				return err
-			}
-			if err := reset(); err != nil {
-				return err
-			}
+		// if we have any modified tracked files we need to auto-stash
+		dirtyWorkingTree := self.c.Helpers().WorkingTree.IsWorkingTreeDirty()
+		if dirtyWorkingTree {
+			return self.c.WithWaitingStatus(options.WaitingStatus, func(gocui.Task) error {
+				if err := self.c.Git().Stash.Push(self.c.Tr.StashPrefix + commitHash); err != nil {
+					return err
+				}
+				if err := reset(); err != nil {
+					return err
+				}
+
+				err := self.c.Git().Stash.Pop(0)
+				if err != nil {
+					return err
+				}
+				return self.c.Refresh(types.RefreshOptions{})
+			})
+		}
 
-			err := self.c.Git().Stash.Pop(0)
-			if err != nil {
-				return err
-			}
-			return self.c.Refresh(types.RefreshOptions{})
+		return self.c.WithWaitingStatus(options.WaitingStatus, func(gocui.Task) error {
+			return reset()
 		})
-	}
-
-	return self.c.WithWaitingStatus(options.WaitingStatus, func(gocui.Task) error {
-		return reset()
 	})
 }
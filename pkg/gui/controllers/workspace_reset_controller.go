@@ -9,8 +9,10 @@ import (
 	"time"
 
 	"github.com/jesseduffield/gocui"
+	"github.com/jesseduffield/lazygit/pkg/commands/models"
 	"github.com/jesseduffield/lazygit/pkg/gui/style"
 	"github.com/jesseduffield/lazygit/pkg/gui/types"
+	"github.com/jesseduffield/lazygit/pkg/utils"
 )
 
 // this is in its own file given that the workspace controller file is already quite long
@@ -30,18 +32,24 @@ func (self *FilesController) createResetMenu() error {
 				red.Sprint(nukeStr),
 			},
 			OnPress: func() error {
-				self.c.LogAction(self.c.Tr.Actions.NukeWorkingTree)
-				if err := self.c.Git().WorkingTree.ResetAndClean(); err != nil {
-					return err
-				}
+				return self.c.Helpers().LossPreview.ConfirmWithPreview(types.ConfirmOpts{
+					Title:  self.c.Tr.DiscardAllChangesToAllFiles,
+					Prompt: self.c.Tr.NukeWorkingTreePrompt,
+					HandleConfirm: func() error {
+						self.c.LogAction(self.c.Tr.Actions.NukeWorkingTree)
+						if err := self.c.Git().WorkingTree.ResetAndClean(); err != nil {
+							return err
+						}
 
-				if self.c.UserConfig().Gui.AnimateExplosion {
-					self.animateExplosion()
-				}
+						if self.c.UserConfig().Gui.AnimateExplosion {
+							self.animateExplosion()
+						}
 
-				return self.c.Refresh(
-					types.RefreshOptions{Mode: types.ASYNC, Scope: []types.RefreshableView{types.FILES}},
-				)
+						return self.c.Refresh(
+							types.RefreshOptions{Mode: types.ASYNC, Scope: []types.RefreshableView{types.FILES}},
+						)
+					},
+				}, self.c.Git().WorkingTree.ResetAndCleanPreview, "")
 			},
 			Key:     'x',
 			Tooltip: self.c.Tr.NukeDescription,
@@ -144,14 +152,22 @@ func (self *FilesController) createResetMenu() error {
 				red.Sprint("git reset --hard HEAD"),
 			},
 			OnPress: func() error {
-				self.c.LogAction(self.c.Tr.Actions.HardReset)
-				if err := self.c.Git().WorkingTree.ResetHard("HEAD"); err != nil {
-					return err
-				}
+				return self.c.Helpers().LossPreview.ConfirmWithPreview(types.ConfirmOpts{
+					Title:  self.c.Tr.HardReset,
+					Prompt: utils.ResolvePlaceholderString(self.c.Tr.HardResetPrompt, map[string]string{"ref": "HEAD"}),
+					HandleConfirm: func() error {
+						self.c.LogAction(self.c.Tr.Actions.HardReset)
+						if err := self.c.Git().WorkingTree.ResetHard("HEAD"); err != nil {
+							return err
+						}
 
-				return self.c.Refresh(
-					types.RefreshOptions{Mode: types.ASYNC, Scope: []types.RefreshableView{types.FILES}},
-				)
+						return self.c.Refresh(
+							types.RefreshOptions{Mode: types.ASYNC, Scope: []types.RefreshableView{types.FILES}},
+						)
+					},
+				}, func() (*models.LossPreview, error) {
+					return self.c.Git().WorkingTree.ResetHardPreview("HEAD")
+				}, "")
 			},
 			Key: 'h',
 		},
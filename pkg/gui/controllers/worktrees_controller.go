@@ -97,6 +97,9 @@ func (self *WorktreesController) GetOnRenderToMain() func() {
 			_, _ = fmt.Fprintf(w, "%s:\t%s%s\n", self.c.Tr.Name, style.FgGreen.Sprint(worktree.Name), main)
 			_, _ = fmt.Fprintf(w, "%s:\t%s\n", self.c.Tr.Branch, style.FgYellow.Sprint(worktree.Branch))
 			_, _ = fmt.Fprintf(w, "%s:\t%s%s\n", self.c.Tr.Path, style.FgCyan.Sprint(worktree.Path), missing)
+			if worktree.LockReason != "" {
+				_, _ = fmt.Fprintf(w, "%s:\t%s\n", self.c.Tr.LockReason, style.FgMagenta.Sprint(worktree.LockReason))
+			}
 			_ = w.Flush()
 
 			task = types.NewRenderStringTask(builder.String())
@@ -16,7 +16,8 @@ func (gui *Gui) handleCreateExtrasMenuPanel() error {
 				Label: gui.c.Tr.ToggleShowCommandLog,
 				OnPress: func() error {
 					currentContext := gui.c.Context().CurrentStatic()
-					if gui.c.State().GetShowExtrasWindow() && currentContext.GetKey() == context.COMMAND_LOG_CONTEXT_KEY {
+					if gui.c.State().GetShowExtrasWindow() && (currentContext.GetKey() == context.COMMAND_LOG_CONTEXT_KEY ||
+						currentContext.GetKey() == context.COMMAND_LOG_ENTRIES_CONTEXT_KEY) {
 						gui.c.Context().Pop()
 					}
 					show := !gui.c.State().GetShowExtrasWindow()
@@ -30,6 +31,17 @@ func (gui *Gui) handleCreateExtrasMenuPanel() error {
 				Label:   gui.c.Tr.FocusCommandLog,
 				OnPress: gui.handleFocusCommandLog,
 			},
+			{
+				Label:   gui.c.Tr.FocusCommandLogEntries,
+				OnPress: gui.handleFocusCommandLogEntries,
+			},
+			{
+				Label:          gui.c.Tr.ExportCommandLogScript,
+				Tooltip:        gui.c.Tr.ExportCommandLogScriptTooltip,
+				OnPress:        gui.helpers.CommandLog.OpenExportSessionScriptMenu,
+				DisabledReason: gui.helpers.CommandLog.GetExportSessionScriptDisabledReason(),
+				OpensMenu:      true,
+			},
 		},
 	})
 }
@@ -42,6 +54,12 @@ func (gui *Gui) handleFocusCommandLog() error {
 	return nil
 }
 
+func (gui *Gui) handleFocusCommandLogEntries() error {
+	gui.c.State().SetShowExtrasWindow(true)
+	gui.c.Context().Push(gui.State.Contexts.CommandLogEntries)
+	return nil
+}
+
 func (gui *Gui) scrollUpExtra() error {
 	gui.Views.Extras.Autoscroll = false
 
@@ -7,18 +7,40 @@ import (
 	"github.com/jesseduffield/lazygit/pkg/commands/models"
 )
 
-func BuildTreeFromFiles(files []*models.File) *Node[models.File] {
+// BuildTreeFromFiles builds the tree for the given files. If collapsedPaths is
+// given, we don't create nodes below collapsed directories until something
+// asks for them (see Node.GetChildren).
+func BuildTreeFromFiles(files []*models.File, collapsedPaths *CollapsedPaths) *Node[models.File] {
 	root := &Node[models.File]{}
 
-	childrenMapsByNode := make(map[*Node[models.File]]map[string]*Node[models.File])
+	addFilesToNode(root, files, func(file *models.File) string { return file.Name }, collapsedPaths)
 
-	var curr *Node[models.File]
+	root.Sort()
+	root.Compress()
+
+	return root
+}
+
+func addFilesToNode[T any](node *Node[T], files []*T, getPath func(*T) string, collapsedPaths *CollapsedPaths) {
+	childrenMapsByNode := make(map[*Node[T]]map[string]*Node[T])
+
+	depth := 0
+	if node.Path != "" {
+		depth = len(split(node.Path))
+	}
+
+	var curr *Node[T]
 	for _, file := range files {
-		splitPath := split(file.Name)
-		curr = root
+		splitPath := split(getPath(file))
+		curr = node
 	outer:
-		for i := range splitPath {
-			var setFile *models.File
+		for i := depth; i < len(splitPath); i++ {
+			if curr != node && curr.unmaterialized != nil {
+				curr.unmaterialized.files = append(curr.unmaterialized.files, file)
+				break
+			}
+
+			var setFile *T
 			isFile := i == len(splitPath)-1
 			if isFile {
 				setFile = file
@@ -26,11 +48,11 @@ func BuildTreeFromFiles(files []*models.File) *Node[models.File] {
 
 			path := join(splitPath[:i+1])
 
-			var currNodeChildrenMap map[string]*Node[models.File]
+			var currNodeChildrenMap map[string]*Node[T]
 			var isCurrNodeMapped bool
 
 			if currNodeChildrenMap, isCurrNodeMapped = childrenMapsByNode[curr]; !isCurrNodeMapped {
-				currNodeChildrenMap = make(map[string]*Node[models.File])
+				currNodeChildrenMap = make(map[string]*Node[T])
 				childrenMapsByNode[curr] = currNodeChildrenMap
 			}
 
@@ -40,10 +62,13 @@ func BuildTreeFromFiles(files []*models.File) *Node[models.File] {
 				continue outer
 			}
 
-			newChild := &Node[models.File]{
+			newChild := &Node[T]{
 				Path: path,
 				File: setFile,
 			}
+			if !isFile && collapsedPaths != nil && collapsedPaths.IsCollapsed(path) {
+				newChild.unmaterialized = newUnmaterializedChildren(newChild, getPath, collapsedPaths)
+			}
 			curr.Children = append(curr.Children, newChild)
 
 			currNodeChildrenMap[path] = newChild
@@ -51,11 +76,31 @@ func BuildTreeFromFiles(files []*models.File) *Node[models.File] {
 			curr = newChild
 		}
 	}
+}
 
-	root.Sort()
-	root.Compress()
+func newUnmaterializedChildren[T any](node *Node[T], getPath func(*T) string, collapsedPaths *CollapsedPaths) *unmaterializedChildren[T] {
+	self := &unmaterializedChildren[T]{
+		materialize: func(node *Node[T], files []*T) {
+			addFilesToNode(node, files, getPath, collapsedPaths)
+			node.Sort()
+			node.Compress()
+		},
+	}
 
-	return root
+	self.inSingleSubdirectory = func() bool {
+		prefix := node.Path + "/"
+		subdir := ""
+		for _, file := range self.files {
+			subdirOfFile, _, isInSubdir := strings.Cut(strings.TrimPrefix(getPath(file), prefix), "/")
+			if !isInSubdir || (subdir != "" && subdirOfFile != subdir) {
+				return false
+			}
+			subdir = subdirOfFile
+		}
+		return subdir != ""
+	}
+
+	return self
 }
 
 func BuildFlatTreeFromCommitFiles(files []*models.CommitFile) *Node[models.CommitFile] {
@@ -106,7 +151,7 @@ func BuildTreeFromCommitFiles(files []*models.CommitFile) *Node[models.CommitFil
 }
 
 func BuildFlatTreeFromFiles(files []*models.File) *Node[models.File] {
-	rootAux := BuildTreeFromFiles(files)
+	rootAux := BuildTreeFromFiles(files, nil)
 	sortedFiles := rootAux.GetLeaves()
 
 	// from top down we have merge conflict files, then tracked file, then untracked
@@ -4,6 +4,7 @@ import (
 	"testing"
 
 	"github.com/jesseduffield/lazygit/pkg/commands/models"
+	"github.com/samber/lo"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -148,12 +149,57 @@ func TestBuildTreeFromFiles(t *testing.T) {
 
 	for _, s := range scenarios {
 		t.Run(s.name, func(t *testing.T) {
-			result := BuildTreeFromFiles(s.files)
+			result := BuildTreeFromFiles(s.files, nil)
 			assert.EqualValues(t, s.expected, result)
 		})
 	}
 }
 
+func TestBuildTreeFromFilesWithCollapsedPaths(t *testing.T) {
+	files := []*models.File{
+		{Name: "dir1/a", HasStagedChanges: true},
+		{Name: "dir1/sub/b"},
+		{Name: "dir2/sub/c"},
+		{Name: "dir2/sub/d"},
+		{Name: "dir3/sub/e"},
+		{Name: "dir3/sub/f"},
+		{Name: "file"},
+	}
+	collapsedPaths := NewCollapsedPaths()
+	collapsedPaths.Collapse("dir1")
+	collapsedPaths.Collapse("dir2/sub")
+	// this one is going to be compressed with its only subdirectory, so it's
+	// not a directory that we display anymore
+	collapsedPaths.Collapse("dir3")
+
+	paths := func(nodes []*Node[models.File]) []string {
+		return lo.Map(nodes, func(node *Node[models.File], _ int) string { return node.Path })
+	}
+
+	root := BuildTreeFromFiles(files, collapsedPaths)
+	assert.Equal(t, []string{"dir1", "dir2/sub", "dir3/sub", "file"}, paths(root.Children))
+	assert.Equal(t, []string{"", "dir1", "dir2/sub", "dir3/sub", "dir3/sub/e", "dir3/sub/f", "file"}, paths(root.Flatten(collapsedPaths)))
+
+	dir1 := root.Children[0]
+	dir2 := root.Children[1]
+	assert.Nil(t, dir1.Children)
+	assert.Nil(t, dir2.Children)
+	assert.Equal(t, 1, dir2.CompressionLevel)
+
+	// we can get at the files without creating their nodes
+	assert.True(t, dir1.SomeFile(func(file *models.File) bool { return file.HasStagedChanges }))
+	assert.False(t, dir1.EveryFile(func(file *models.File) bool { return file.HasStagedChanges }))
+	fileCount := 0
+	assert.NoError(t, dir2.ForEachFile(func(*models.File) error { fileCount++; return nil }))
+	assert.Equal(t, 2, fileCount)
+	assert.Nil(t, dir1.Children)
+	assert.Nil(t, dir2.Children)
+
+	collapsedPaths.ToggleCollapsed("dir1")
+	assert.Equal(t, []string{"", "dir1", "dir1/sub", "dir1/sub/b", "dir1/a", "dir2/sub", "dir3/sub", "dir3/sub/e", "dir3/sub/f", "file"}, paths(root.Flatten(collapsedPaths)))
+	assert.Equal(t, []string{"dir1/sub", "dir1/a"}, paths(dir1.Children))
+}
+
 func TestBuildFlatTreeFromFiles(t *testing.T) {
 	scenarios := []struct {
 		name     string
@@ -1,9 +1,16 @@
 package filetree
 
-import "github.com/jesseduffield/generics/set"
+import (
+	"sync"
+
+	"github.com/jesseduffield/generics/set"
+)
 
 type CollapsedPaths struct {
 	collapsedPaths *set.Set[string]
+	// we read these when building the tree in the background, while the user
+	// might be collapsing or expanding directories
+	mutex sync.RWMutex
 }
 
 func NewCollapsedPaths() *CollapsedPaths {
@@ -13,6 +20,9 @@ func NewCollapsedPaths() *CollapsedPaths {
 }
 
 func (self *CollapsedPaths) ExpandToPath(path string) {
+	self.mutex.Lock()
+	defer self.mutex.Unlock()
+
 	// need every directory along the way
 	splitPath := split(path)
 	for i := range splitPath {
@@ -22,14 +32,23 @@ func (self *CollapsedPaths) ExpandToPath(path string) {
 }
 
 func (self *CollapsedPaths) IsCollapsed(path string) bool {
+	self.mutex.RLock()
+	defer self.mutex.RUnlock()
+
 	return self.collapsedPaths.Includes(path)
 }
 
 func (self *CollapsedPaths) Collapse(path string) {
+	self.mutex.Lock()
+	defer self.mutex.Unlock()
+
 	self.collapsedPaths.Add(path)
 }
 
 func (self *CollapsedPaths) ToggleCollapsed(path string) {
+	self.mutex.Lock()
+	defer self.mutex.Unlock()
+
 	if self.collapsedPaths.Includes(path) {
 		self.collapsedPaths.Remove(path)
 	} else {
@@ -38,6 +57,9 @@ func (self *CollapsedPaths) ToggleCollapsed(path string) {
 }
 
 func (self *CollapsedPaths) ExpandAll() {
+	self.mutex.Lock()
+	defer self.mutex.Unlock()
+
 	// Could be cleaner if Set had a Clear() method...
 	self.collapsedPaths.RemoveSlice(self.collapsedPaths.ToSlice())
 }
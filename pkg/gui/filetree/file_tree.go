@@ -167,7 +167,7 @@ func (self *FileTree) GetAllFiles() []*models.File {
 func (self *FileTree) SetTree() {
 	filesForDisplay := self.getFilesForDisplay()
 	if self.showTree {
-		self.tree = BuildTreeFromFiles(filesForDisplay)
+		self.tree = BuildTreeFromFiles(filesForDisplay, self.collapsedPaths)
 	} else {
 		self.tree = BuildFlatTreeFromFiles(filesForDisplay)
 	}
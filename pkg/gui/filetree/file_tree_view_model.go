@@ -7,7 +7,6 @@ import (
 	"github.com/jesseduffield/lazygit/pkg/commands/models"
 	"github.com/jesseduffield/lazygit/pkg/gui/context/traits"
 	"github.com/jesseduffield/lazygit/pkg/gui/types"
-	"github.com/jesseduffield/lazygit/pkg/utils"
 	"github.com/samber/lo"
 	"github.com/sirupsen/logrus"
 )
@@ -143,20 +142,30 @@ func (self *FileTreeViewModel) findNewSelectedIdx(prevNodes []*FileNode, currNod
 		}
 	}
 
-	for _, prevNode := range prevNodes {
-		selectedPaths := getPaths(prevNode)
-
-		for idx, node := range currNodes {
-			paths := getPaths(node)
+	// With tens of thousands of files we can't afford to compare every old node
+	// with every new one, so we look them up by path
+	currIdxsByPath := map[string][]int{}
+	for idx, node := range currNodes {
+		for _, path := range getPaths(node) {
+			currIdxsByPath[path] = append(currIdxsByPath[path], idx)
+		}
+	}
 
-			// If you started off with a rename selected, and now it's broken in two, we want you to jump to the new file, not the old file.
-			// This is because the new should be in the same position as the rename was meaning less cursor jumping
-			foundOldFileInRename := prevNode.File != nil && prevNode.File.IsRename() && node.Path == prevNode.File.PreviousName
-			foundNode := utils.StringArraysOverlap(paths, selectedPaths) && !foundOldFileInRename
-			if foundNode {
-				return idx
+	for _, prevNode := range prevNodes {
+		result := -1
+		for _, path := range getPaths(prevNode) {
+			for _, idx := range currIdxsByPath[path] {
+				// If you started off with a rename selected, and now it's broken in two, we want you to jump to the new file, not the old file.
+				// This is because the new should be in the same position as the rename was meaning less cursor jumping
+				foundOldFileInRename := prevNode.File != nil && prevNode.File.IsRename() && currNodes[idx].Path == prevNode.File.PreviousName
+				if !foundOldFileInRename && (result == -1 || idx < result) {
+					result = idx
+				}
 			}
 		}
+		if result != -1 {
+			return result
+		}
 	}
 
 	return -1
@@ -182,7 +191,7 @@ func (self *FileTreeViewModel) ToggleShowTree() {
 
 	if self.InTreeMode() {
 		self.ExpandToPath(path)
-	} else if len(selectedNode.Children) > 0 {
+	} else if len(selectedNode.GetChildren()) > 0 {
 		path = selectedNode.GetLeaves()[0].Path
 	}
 
@@ -2,6 +2,7 @@ package filetree
 
 import (
 	"path"
+	"sync"
 
 	"github.com/jesseduffield/lazygit/pkg/commands/models"
 	"github.com/jesseduffield/lazygit/pkg/gui/types"
@@ -15,7 +16,8 @@ type Node[T any] struct {
 	File *T
 
 	// If the node is a directory, Children contains the contents of the directory,
-	// otherwise it's nil.
+	// otherwise it's nil. Use GetChildren to read them, in case they haven't
+	// been created yet.
 	Children []*Node[T]
 
 	// path of the file/directory
@@ -33,6 +35,21 @@ type Node[T any] struct {
 	// number of times a 'compression' like the above has happened, where two
 	// nodes are squished into one.
 	CompressionLevel int
+
+	// If the node is a directory that was collapsed when we built the tree, we
+	// hold on to the files below it rather than creating nodes for them, which
+	// saves a lot of work for huge working trees. See GetChildren.
+	unmaterialized *unmaterializedChildren[T]
+}
+
+type unmaterializedChildren[T any] struct {
+	files []*T
+	// creates the child nodes of the given node for the given files
+	materialize func(node *Node[T], files []*T)
+	// returns true if the files are all in the same subdirectory of the node,
+	// which means that the node is going to be compressed with it
+	inSingleSubdirectory func() bool
+	once                 sync.Once
 }
 
 var _ types.ListItem = &Node[models.File]{}
@@ -49,6 +66,18 @@ func (self *Node[T]) GetPath() string {
 	return self.Path
 }
 
+// GetChildren returns the node's children, first creating them if we haven't
+// done so yet because the directory was collapsed when we built the tree.
+func (self *Node[T]) GetChildren() []*Node[T] {
+	if self.unmaterialized != nil {
+		self.unmaterialized.once.Do(func() {
+			self.unmaterialized.materialize(self, self.unmaterialized.files)
+		})
+	}
+
+	return self.Children
+}
+
 func (self *Node[T]) Sort() {
 	self.SortChildren()
 
@@ -64,6 +93,16 @@ func (self *Node[T]) ForEachFile(cb func(*T) error) error {
 		}
 	}
 
+	// no need to create the child nodes just to get at the files
+	if self.unmaterialized != nil {
+		for _, file := range self.unmaterialized.files {
+			if err := cb(file); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
 	for _, child := range self.Children {
 		if err := child.ForEachFile(cb); err != nil {
 			return err
@@ -100,7 +139,7 @@ func (self *Node[T]) Some(test func(*Node[T]) bool) bool {
 		return true
 	}
 
-	for _, child := range self.Children {
+	for _, child := range self.GetChildren() {
 		if child.Some(test) {
 			return true
 		}
@@ -114,6 +153,8 @@ func (self *Node[T]) SomeFile(test func(*T) bool) bool {
 		if test(self.File) {
 			return true
 		}
+	} else if self.unmaterialized != nil {
+		return lo.SomeBy(self.unmaterialized.files, test)
 	} else {
 		for _, child := range self.Children {
 			if child.SomeFile(test) {
@@ -130,7 +171,7 @@ func (self *Node[T]) Every(test func(*Node[T]) bool) bool {
 		return false
 	}
 
-	for _, child := range self.Children {
+	for _, child := range self.GetChildren() {
 		if !child.Every(test) {
 			return false
 		}
@@ -144,6 +185,8 @@ func (self *Node[T]) EveryFile(test func(*T) bool) bool {
 		if !test(self.File) {
 			return false
 		}
+	} else if self.unmaterialized != nil {
+		return lo.EveryBy(self.unmaterialized.files, test)
 	} else {
 		for _, child := range self.Children {
 			if !child.EveryFile(test) {
@@ -158,8 +201,8 @@ func (self *Node[T]) EveryFile(test func(*T) bool) bool {
 func (self *Node[T]) Flatten(collapsedPaths *CollapsedPaths) []*Node[T] {
 	result := []*Node[T]{self}
 
-	if len(self.Children) > 0 && !collapsedPaths.IsCollapsed(self.GetPath()) {
-		result = append(result, lo.FlatMap(self.Children, func(child *Node[T], _ int) []*Node[T] {
+	if !self.IsFile() && !collapsedPaths.IsCollapsed(self.GetPath()) {
+		result = append(result, lo.FlatMap(self.GetChildren(), func(child *Node[T], _ int) []*Node[T] {
 			return child.Flatten(collapsedPaths)
 		})...)
 	}
@@ -185,7 +228,7 @@ func (self *Node[T]) getNodeAtIndexAux(index int, collapsedPaths *CollapsedPaths
 	}
 
 	if !collapsedPaths.IsCollapsed(self.GetPath()) {
-		for _, child := range self.Children {
+		for _, child := range self.GetChildren() {
 			foundNode, offsetChange := child.getNodeAtIndexAux(index-offset, collapsedPaths)
 			offset += offsetChange
 			if foundNode != nil {
@@ -205,7 +248,7 @@ func (self *Node[T]) GetIndexForPath(path string, collapsedPaths *CollapsedPaths
 	}
 
 	if !collapsedPaths.IsCollapsed(self.GetPath()) {
-		for _, child := range self.Children {
+		for _, child := range self.GetChildren() {
 			offsetChange, found := child.GetIndexForPath(path, collapsedPaths)
 			offset += offsetChange + 1
 			if found {
@@ -225,7 +268,7 @@ func (self *Node[T]) Size(collapsedPaths *CollapsedPaths) int {
 	output := 1
 
 	if !collapsedPaths.IsCollapsed(self.GetPath()) {
-		for _, child := range self.Children {
+		for _, child := range self.GetChildren() {
 			output += child.Size(collapsedPaths)
 		}
 	}
@@ -248,11 +291,11 @@ func (self *Node[T]) compressAux() *Node[T] {
 
 	children := self.Children
 	for i := range children {
-		grandchildren := children[i].Children
+		grandchildren := children[i].childrenForCompression()
 		for len(grandchildren) == 1 && !grandchildren[0].IsFile() {
 			grandchildren[0].CompressionLevel = children[i].CompressionLevel + 1
 			children[i] = grandchildren[0]
-			grandchildren = children[i].Children
+			grandchildren = children[i].childrenForCompression()
 		}
 	}
 
@@ -265,6 +308,16 @@ func (self *Node[T]) compressAux() *Node[T] {
 	return self
 }
 
+// Like GetChildren, except that we only create the children of a collapsed
+// directory if it's going to be compressed with its single subdirectory
+func (self *Node[T]) childrenForCompression() []*Node[T] {
+	if self.unmaterialized != nil && self.unmaterialized.inSingleSubdirectory() {
+		return self.GetChildren()
+	}
+
+	return self.Children
+}
+
 func (self *Node[T]) GetPathsMatching(test func(*Node[T]) bool) []string {
 	paths := []string{}
 
@@ -272,7 +325,7 @@ func (self *Node[T]) GetPathsMatching(test func(*Node[T]) bool) []string {
 		paths = append(paths, self.GetPath())
 	}
 
-	for _, child := range self.Children {
+	for _, child := range self.GetChildren() {
 		paths = append(paths, child.GetPathsMatching(test)...)
 	}
 
@@ -294,7 +347,7 @@ func (self *Node[T]) GetLeaves() []*Node[T] {
 		return []*Node[T]{self}
 	}
 
-	return lo.FlatMap(self.Children, func(child *Node[T], _ int) []*Node[T] {
+	return lo.FlatMap(self.GetChildren(), func(child *Node[T], _ int) []*Node[T] {
 		return child.GetLeaves()
 	})
 }
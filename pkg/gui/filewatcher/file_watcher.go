@@ -0,0 +1,483 @@
+package filewatcher
+
+import (
+	"errors"
+	"io/fs"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/jesseduffield/lazygit/pkg/gui/types"
+	"github.com/samber/lo"
+	"github.com/sirupsen/logrus"
+)
+
+// The file watcher tells us when something changed in the repo, so that we
+// only refresh what's affected rather than polling with `git status`. It
+// watches all directories of the worktree that aren't gitignored, and of the
+// git dir only HEAD, the index and the refs (loose or packed); everything else
+// in there either changes along with one of those, or isn't something we
+// display.
+
+var (
+	ErrUnsupported        = errors.New("watching files is not supported on this platform")
+	ErrWatchLimitExceeded = errors.New("the system's limit for watched directories was exceeded")
+)
+
+const (
+	// how long we wait for more changes before reporting them, so that a
+	// burst of changes (e.g. a checkout) only results in a single refresh
+	debounceDelay = 200 * time.Millisecond
+	// how long we're willing to put off reporting changes if they keep coming
+	maxDebounceDelay = time.Second
+)
+
+type Paths struct {
+	// The root of the worktree
+	Worktree string
+	// The git dir of the worktree, which has its HEAD and index
+	WorktreeGitDir string
+	// The git dir of the repo, which has the refs (shared by all worktrees)
+	RepoGitDir string
+}
+
+type event struct {
+	path  string
+	isDir bool
+	// a directory was created (or moved in), so we need to watch it too
+	created bool
+	// the kernel dropped events, so we don't know what changed
+	overflow bool
+}
+
+// ListIgnoredFunc returns the gitignored untracked paths in the given
+// directories of the worktree (or in all of it, if none are given), relative
+// to the worktree and with a trailing slash for directories. We ask git rather
+// than matching gitignore patterns ourselves, so that tracked files are never
+// treated as ignored.
+type ListIgnoredFunc func(dirs ...string) ([]string, error)
+
+// implemented per platform
+type backend interface {
+	Add(dir string) error
+	Events() <-chan event
+	Errors() <-chan error
+	Close() error
+}
+
+type Watcher struct {
+	paths       Paths
+	log         *logrus.Entry
+	backend     backend
+	listIgnored ListIgnoredFunc
+	ignored     *ignoredPaths
+
+	// Asking git what's ignored takes a while, so we do it on a goroutine of
+	// its own (see watchNewDirs) rather than holding up the event loop.
+	// These are the requests for it, guarded by the mutex.
+	mutex sync.Mutex
+	// directories that were created in the worktree and need watching
+	pendingDirs []string
+	// true if a .gitignore changed, so we need to ask git again
+	pendingReload bool
+	wake          chan struct{}
+
+	changes chan []types.RefreshableView
+	errors  chan error
+	done    chan struct{}
+}
+
+func New(paths Paths, listIgnored ListIgnoredFunc, log *logrus.Entry) (*Watcher, error) {
+	backend, err := newBackend()
+	if err != nil {
+		return nil, err
+	}
+
+	self := &Watcher{
+		paths:       paths,
+		log:         log,
+		backend:     backend,
+		listIgnored: listIgnored,
+		ignored:     newIgnoredPaths(nil),
+		wake:        make(chan struct{}, 1),
+		changes:     make(chan []types.RefreshableView),
+		errors:      make(chan error, 1),
+		done:        make(chan struct{}),
+	}
+
+	if err := self.watchAll(); err != nil {
+		_ = backend.Close()
+		return nil, err
+	}
+
+	go self.loop()
+	go self.watchNewDirs()
+
+	return self, nil
+}
+
+// Changes reports what needs refreshing, after debouncing
+func (self *Watcher) Changes() <-chan []types.RefreshableView {
+	return self.changes
+}
+
+// Errors reports errors that mean the watcher stopped working, e.g. because
+// we ran out of watches for newly created directories
+func (self *Watcher) Errors() <-chan error {
+	return self.errors
+}
+
+func (self *Watcher) Close() {
+	close(self.done)
+	_ = self.backend.Close()
+}
+
+func (self *Watcher) watchAll() error {
+	ignored, err := self.listIgnored()
+	if err != nil {
+		return err
+	}
+	self.ignored.set(ignored)
+
+	if err := self.watchWorktreeDir(self.paths.Worktree); err != nil {
+		return err
+	}
+
+	if err := self.backend.Add(self.paths.WorktreeGitDir); err != nil {
+		return err
+	}
+
+	// in a linked worktree we need to watch the repo's git dir as well, for
+	// its packed-refs
+	if self.paths.RepoGitDir != self.paths.WorktreeGitDir {
+		if err := self.backend.Add(self.paths.RepoGitDir); err != nil {
+			return err
+		}
+	}
+
+	return self.watchRefsDir(filepath.Join(self.paths.RepoGitDir, "refs"))
+}
+
+// watches the given directory of the worktree and all its subdirectories,
+// except for the ignored ones and git dirs (including those of submodules)
+func (self *Watcher) watchWorktreeDir(dir string) error {
+	return filepath.WalkDir(dir, func(path string, entry fs.DirEntry, err error) error {
+		if err != nil || !entry.IsDir() {
+			return err
+		}
+
+		if entry.Name() == ".git" {
+			return filepath.SkipDir
+		}
+		if relPath, err := filepath.Rel(self.paths.Worktree, path); err == nil && self.ignored.match(relPath) {
+			return filepath.SkipDir
+		}
+
+		return self.backend.Add(path)
+	})
+}
+
+func (self *Watcher) watchRefsDir(dir string) error {
+	return filepath.WalkDir(dir, func(path string, entry fs.DirEntry, err error) error {
+		if err != nil || !entry.IsDir() {
+			return err
+		}
+
+		return self.backend.Add(path)
+	})
+}
+
+func (self *Watcher) loop() {
+	var pending []types.RefreshableView
+	var pendingSince time.Time
+	timer := time.NewTimer(debounceDelay)
+	timer.Stop()
+
+	for {
+		select {
+		case event, ok := <-self.backend.Events():
+			if !ok {
+				return
+			}
+
+			scopes := self.handleEvent(event)
+			if len(scopes) == 0 {
+				continue
+			}
+
+			now := time.Now()
+			if len(pending) == 0 {
+				pendingSince = now
+			}
+			pending = lo.Uniq(append(pending, scopes...))
+			// Before go 1.23 a stopped timer can still deliver a stale tick
+			// after Reset, so drain it first
+			if !timer.Stop() {
+				select {
+				case <-timer.C:
+				default:
+				}
+			}
+			timer.Reset(min(debounceDelay, pendingSince.Add(maxDebounceDelay).Sub(now)))
+		case <-timer.C:
+			// A nil scope would refresh everything, so never send an empty
+			// batch
+			if len(pending) == 0 {
+				continue
+			}
+			select {
+			case self.changes <- pending:
+			case <-self.done:
+				return
+			}
+			pending = nil
+		case err := <-self.backend.Errors():
+			self.reportError(err)
+		case <-self.done:
+			return
+		}
+	}
+}
+
+func (self *Watcher) handleEvent(event event) []types.RefreshableView {
+	if event.overflow {
+		return allScopes
+	}
+
+	scopes := scopesForPath(self.paths, event.path, self.ignored.match)
+
+	if event.created && event.isDir {
+		if isWithin(filepath.Join(self.paths.RepoGitDir, "refs"), event.path) {
+			if err := self.watchRefsDir(event.path); err != nil && !errors.Is(err, fs.ErrNotExist) {
+				self.reportError(err)
+			}
+		} else if lo.Contains(scopes, types.FILES) {
+			// a directory in the worktree that isn't ignored (as far as we
+			// know yet)
+			self.request(func() {
+				self.pendingDirs = append(self.pendingDirs, event.path)
+			})
+		}
+	}
+
+	if filepath.Base(event.path) == ".gitignore" {
+		self.request(func() {
+			self.pendingReload = true
+		})
+	}
+
+	return scopes
+}
+
+// request records a request for watchNewDirs and wakes it up
+func (self *Watcher) request(f func()) {
+	self.mutex.Lock()
+	f()
+	self.mutex.Unlock()
+
+	select {
+	case self.wake <- struct{}{}:
+	default:
+		// it's awake already
+	}
+}
+
+func (self *Watcher) watchNewDirs() {
+	for {
+		select {
+		case <-self.wake:
+		case <-self.done:
+			return
+		}
+
+		self.mutex.Lock()
+		dirs := self.pendingDirs
+		reload := self.pendingReload
+		self.pendingDirs = nil
+		self.pendingReload = false
+		self.mutex.Unlock()
+
+		if reload {
+			self.reloadIgnored()
+		}
+		if err := self.watchCreatedDirs(dirs); err != nil && !errors.Is(err, fs.ErrNotExist) {
+			self.reportError(err)
+		}
+	}
+}
+
+// Asks git for all ignored paths again, and starts watching the directories
+// that aren't ignored any more. Directories that became ignored stay watched;
+// their changes are filtered out by scopesForPath.
+func (self *Watcher) reloadIgnored() {
+	ignored, err := self.listIgnored()
+	if err != nil {
+		// we'll try again when a .gitignore changes next
+		self.log.Error(err)
+		return
+	}
+
+	for _, dir := range self.ignored.set(ignored) {
+		err := self.watchWorktreeDir(filepath.Join(self.paths.Worktree, dir))
+		if err != nil && !errors.Is(err, fs.ErrNotExist) {
+			self.reportError(err)
+			return
+		}
+	}
+}
+
+// Watches the given new directories of the worktree, after asking git which
+// of them (or which of the things inside of them) are ignored
+func (self *Watcher) watchCreatedDirs(dirs []string) error {
+	// a directory inside of another new one gets watched along with it
+	dirs = lo.Uniq(dirs)
+	dirs = lo.Reject(dirs, func(dir string, _ int) bool {
+		return lo.SomeBy(dirs, func(other string) bool {
+			return other != dir && isWithin(other, dir)
+		})
+	})
+	if len(dirs) == 0 {
+		return nil
+	}
+
+	relDirs := lo.FilterMap(dirs, func(dir string, _ int) (string, bool) {
+		relPath, err := filepath.Rel(self.paths.Worktree, dir)
+		return filepath.ToSlash(relPath), err == nil
+	})
+	ignored, err := self.listIgnored(relDirs...)
+	if err != nil {
+		// better to watch too much than to miss changes
+		self.log.Error(err)
+	}
+	self.ignored.add(ignored)
+
+	for _, dir := range dirs {
+		if err := self.watchWorktreeDir(dir); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (self *Watcher) reportError(err error) {
+	select {
+	case self.errors <- err:
+	default:
+		// there's already an error waiting to be picked up
+	}
+}
+
+var allScopes = []types.RefreshableView{
+	types.FILES, types.BRANCHES, types.COMMITS, types.REFLOG, types.REMOTES, types.TAGS, types.STASH,
+}
+
+// scopesForPath returns what we need to refresh when the file or directory
+// at the given (absolute) path changed. isIgnored is called with paths
+// relative to the worktree.
+func scopesForPath(paths Paths, path string, isIgnored func(string) bool) []types.RefreshableView {
+	// git writes to a lock file first and then renames it, so we'll get an
+	// event for the real file anyway
+	if strings.HasSuffix(path, ".lock") {
+		return nil
+	}
+
+	switch path {
+	case filepath.Join(paths.WorktreeGitDir, "HEAD"):
+		return []types.RefreshableView{types.FILES, types.BRANCHES, types.COMMITS, types.REFLOG}
+	case filepath.Join(paths.WorktreeGitDir, "index"):
+		return []types.RefreshableView{types.FILES}
+	case filepath.Join(paths.RepoGitDir, "refs", "stash"):
+		return []types.RefreshableView{types.STASH}
+	case filepath.Join(paths.RepoGitDir, "packed-refs"):
+		// rewritten e.g. when deleting a branch or tag that was packed
+		return []types.RefreshableView{types.BRANCHES, types.REMOTES, types.TAGS}
+	}
+
+	refsDir := filepath.Join(paths.RepoGitDir, "refs")
+	switch {
+	case isWithin(filepath.Join(refsDir, "heads"), path):
+		return []types.RefreshableView{types.BRANCHES, types.COMMITS}
+	case isWithin(filepath.Join(refsDir, "remotes"), path):
+		return []types.RefreshableView{types.BRANCHES, types.REMOTES}
+	case isWithin(filepath.Join(refsDir, "tags"), path):
+		return []types.RefreshableView{types.TAGS}
+	case isWithin(paths.WorktreeGitDir, path) || isWithin(paths.RepoGitDir, path):
+		// any other git internals
+		return nil
+	case isWithin(paths.Worktree, path):
+		relPath, err := filepath.Rel(paths.Worktree, path)
+		if err != nil || isIgnored(relPath) {
+			return nil
+		}
+		return []types.RefreshableView{types.FILES}
+	}
+
+	return nil
+}
+
+// isWithin returns true if path is dir or is inside of it
+func isWithin(dir string, path string) bool {
+	return path == dir || strings.HasPrefix(path, dir+string(filepath.Separator))
+}
+
+// The gitignored paths of the worktree, as told by git. Anything inside of an
+// ignored directory is ignored too.
+type ignoredPaths struct {
+	mutex sync.RWMutex
+	// relative paths (using the OS's separator) -> whether it's a directory
+	paths map[string]bool
+}
+
+func newIgnoredPaths(paths []string) *ignoredPaths {
+	self := &ignoredPaths{}
+	self.set(paths)
+	return self
+}
+
+// set replaces the ignored paths with the given ones (as returned by a
+// ListIgnoredFunc), and returns the directories that aren't ignored any more
+func (self *ignoredPaths) set(paths []string) []string {
+	newPaths := map[string]bool{}
+	for _, path := range paths {
+		newPaths[filepath.FromSlash(strings.TrimSuffix(path, "/"))] = strings.HasSuffix(path, "/")
+	}
+
+	self.mutex.Lock()
+	defer self.mutex.Unlock()
+
+	var unignoredDirs []string
+	for path, isDir := range self.paths {
+		if _, ok := newPaths[path]; isDir && !ok {
+			unignoredDirs = append(unignoredDirs, path)
+		}
+	}
+	self.paths = newPaths
+
+	return unignoredDirs
+}
+
+func (self *ignoredPaths) add(paths []string) {
+	self.mutex.Lock()
+	defer self.mutex.Unlock()
+
+	for _, path := range paths {
+		self.paths[filepath.FromSlash(strings.TrimSuffix(path, "/"))] = strings.HasSuffix(path, "/")
+	}
+}
+
+// match returns true if the given path (relative to the worktree) or one of
+// its parent directories is ignored
+func (self *ignoredPaths) match(relPath string) bool {
+	self.mutex.RLock()
+	defer self.mutex.RUnlock()
+
+	for path := relPath; path != "."; path = filepath.Dir(path) {
+		if _, ok := self.paths[path]; ok {
+			return true
+		}
+	}
+
+	return false
+}
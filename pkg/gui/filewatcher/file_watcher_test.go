@@ -0,0 +1,246 @@
+package filewatcher
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/jesseduffield/lazygit/pkg/gui/types"
+	"github.com/jesseduffield/lazygit/pkg/utils"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestScopesForPath(t *testing.T) {
+	mainPaths := Paths{
+		Worktree:       "/repo",
+		WorktreeGitDir: "/repo/.git",
+		RepoGitDir:     "/repo/.git",
+	}
+	linkedWorktreePaths := Paths{
+		Worktree:       "/linked",
+		WorktreeGitDir: "/repo/.git/worktrees/linked",
+		RepoGitDir:     "/repo/.git",
+	}
+	isIgnored := func(path string) bool {
+		return path == "build" || filepath.Dir(path) == "build"
+	}
+
+	scenarios := []struct {
+		name     string
+		paths    Paths
+		path     string
+		expected []types.RefreshableView
+	}{
+		{
+			name:     "file in worktree",
+			paths:    mainPaths,
+			path:     "/repo/dir/file.go",
+			expected: []types.RefreshableView{types.FILES},
+		},
+		{
+			name:     "ignored file in worktree",
+			paths:    mainPaths,
+			path:     "/repo/build/output",
+			expected: nil,
+		},
+		{
+			name:     "HEAD",
+			paths:    mainPaths,
+			path:     "/repo/.git/HEAD",
+			expected: []types.RefreshableView{types.FILES, types.BRANCHES, types.COMMITS, types.REFLOG},
+		},
+		{
+			name:     "index",
+			paths:    mainPaths,
+			path:     "/repo/.git/index",
+			expected: []types.RefreshableView{types.FILES},
+		},
+		{
+			name:     "index lock",
+			paths:    mainPaths,
+			path:     "/repo/.git/index.lock",
+			expected: nil,
+		},
+		{
+			name:     "local branch",
+			paths:    mainPaths,
+			path:     "/repo/.git/refs/heads/feature/one",
+			expected: []types.RefreshableView{types.BRANCHES, types.COMMITS},
+		},
+		{
+			name:     "remote branch",
+			paths:    mainPaths,
+			path:     "/repo/.git/refs/remotes/origin/main",
+			expected: []types.RefreshableView{types.BRANCHES, types.REMOTES},
+		},
+		{
+			name:     "tag",
+			paths:    mainPaths,
+			path:     "/repo/.git/refs/tags/v1.0",
+			expected: []types.RefreshableView{types.TAGS},
+		},
+		{
+			name:     "stash",
+			paths:    mainPaths,
+			path:     "/repo/.git/refs/stash",
+			expected: []types.RefreshableView{types.STASH},
+		},
+		{
+			name:     "packed refs",
+			paths:    mainPaths,
+			path:     "/repo/.git/packed-refs",
+			expected: []types.RefreshableView{types.BRANCHES, types.REMOTES, types.TAGS},
+		},
+		{
+			name:     "other git internals",
+			paths:    mainPaths,
+			path:     "/repo/.git/ORIG_HEAD",
+			expected: nil,
+		},
+		{
+			name:     "HEAD of linked worktree",
+			paths:    linkedWorktreePaths,
+			path:     "/repo/.git/worktrees/linked/HEAD",
+			expected: []types.RefreshableView{types.FILES, types.BRANCHES, types.COMMITS, types.REFLOG},
+		},
+		{
+			name:     "HEAD of main worktree seen from linked worktree",
+			paths:    linkedWorktreePaths,
+			path:     "/repo/.git/HEAD",
+			expected: nil,
+		},
+		{
+			name:     "packed refs seen from linked worktree",
+			paths:    linkedWorktreePaths,
+			path:     "/repo/.git/packed-refs",
+			expected: []types.RefreshableView{types.BRANCHES, types.REMOTES, types.TAGS},
+		},
+		{
+			name:     "file in linked worktree",
+			paths:    linkedWorktreePaths,
+			path:     "/linked/file.go",
+			expected: []types.RefreshableView{types.FILES},
+		},
+		{
+			name:     "path outside of the repo",
+			paths:    mainPaths,
+			path:     "/repository/file.go",
+			expected: nil,
+		},
+	}
+
+	for _, s := range scenarios {
+		t.Run(s.name, func(t *testing.T) {
+			paths := Paths{
+				Worktree:       filepath.FromSlash(s.paths.Worktree),
+				WorktreeGitDir: filepath.FromSlash(s.paths.WorktreeGitDir),
+				RepoGitDir:     filepath.FromSlash(s.paths.RepoGitDir),
+			}
+			assert.Equal(t, s.expected, scopesForPath(paths, filepath.FromSlash(s.path), isIgnored))
+		})
+	}
+}
+
+func TestIgnoredPaths(t *testing.T) {
+	ignored := newIgnoredPaths([]string{"build/", "debug.log", "src/generated/"})
+
+	assert.True(t, ignored.match("build"))
+	assert.True(t, ignored.match(filepath.FromSlash("build/bin/app")))
+	assert.True(t, ignored.match("debug.log"))
+	assert.True(t, ignored.match(filepath.FromSlash("src/generated/file.go")))
+	assert.False(t, ignored.match("src"))
+	assert.False(t, ignored.match(filepath.FromSlash("src/file.go")))
+	assert.False(t, ignored.match("builder"))
+
+	ignored.add([]string{"src/new/node_modules/"})
+	assert.True(t, ignored.match(filepath.FromSlash("src/new/node_modules/pkg")))
+
+	unignored := ignored.set([]string{"debug.log", "src/generated/"})
+	assert.ElementsMatch(t, []string{"build", filepath.FromSlash("src/new/node_modules")}, unignored)
+	assert.False(t, ignored.match(filepath.FromSlash("build/bin/app")))
+}
+
+func TestWatcher(t *testing.T) {
+	dir, err := filepath.EvalSymlinks(t.TempDir())
+	assert.NoError(t, err)
+
+	for _, subDir := range []string{".git/refs/heads", "src", "ignored"} {
+		assert.NoError(t, os.MkdirAll(filepath.Join(dir, subDir), 0o755))
+	}
+	writeFile := func(path string) {
+		assert.NoError(t, os.WriteFile(filepath.Join(dir, path), []byte("content"), 0o644))
+	}
+
+	// stands in for git, with 'ignored/' and any 'tmp/' directory being
+	// gitignored
+	var mutex sync.Mutex
+	var listedDirs [][]string
+	listIgnored := func(dirs ...string) ([]string, error) {
+		mutex.Lock()
+		defer mutex.Unlock()
+		listedDirs = append(listedDirs, dirs)
+
+		if len(dirs) == 0 {
+			return []string{"ignored/"}, nil
+		}
+		return lo.Map(dirs, func(dir string, _ int) string {
+			return dir + "/tmp/"
+		}), nil
+	}
+
+	watcher, err := New(Paths{
+		Worktree:       dir,
+		WorktreeGitDir: filepath.Join(dir, ".git"),
+		RepoGitDir:     filepath.Join(dir, ".git"),
+	}, listIgnored, utils.NewDummyLog())
+	if errors.Is(err, ErrUnsupported) {
+		t.Skip(err)
+	}
+	assert.NoError(t, err)
+	defer watcher.Close()
+
+	expectChanges := func(expected ...types.RefreshableView) {
+		t.Helper()
+		select {
+		case scopes := <-watcher.Changes():
+			assert.Equal(t, expected, scopes)
+		case err := <-watcher.Errors():
+			t.Fatal(err)
+		case <-time.After(5 * time.Second):
+			t.Fatal("timed out waiting for changes")
+		}
+	}
+
+	writeFile("src/file.go")
+	expectChanges(types.FILES)
+
+	// changes in ignored directories aren't reported, so the next thing we
+	// hear about is the branch
+	writeFile("ignored/file")
+	writeFile(".git/refs/heads/feature")
+	expectChanges(types.BRANCHES, types.COMMITS)
+
+	writeFile(".git/packed-refs")
+	expectChanges(types.BRANCHES, types.REMOTES, types.TAGS)
+
+	// new directories get watched too, except for what git says is ignored
+	// in them
+	assert.NoError(t, os.MkdirAll(filepath.Join(dir, "src", "new", "tmp"), 0o755))
+	expectChanges(types.FILES)
+	assert.Eventually(t, func() bool {
+		mutex.Lock()
+		defer mutex.Unlock()
+		return len(listedDirs) == 2
+	}, 5*time.Second, 10*time.Millisecond)
+	assert.Equal(t, []string{"src/new"}, listedDirs[1])
+
+	writeFile("src/new/tmp/file")
+	writeFile("src/new/file.go")
+	expectChanges(types.FILES)
+	writeFile(".git/refs/heads/other")
+	expectChanges(types.BRANCHES, types.COMMITS)
+}
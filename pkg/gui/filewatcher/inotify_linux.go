@@ -0,0 +1,146 @@
+//go:build linux
+// +build linux
+
+package filewatcher
+
+import (
+	"bytes"
+	"errors"
+	"os"
+	"path/filepath"
+	"sync"
+	"unsafe"
+
+	"golang.org/x/sys/unix"
+)
+
+const inotifyMask = unix.IN_CREATE | unix.IN_DELETE | unix.IN_CLOSE_WRITE | unix.IN_ATTRIB |
+	unix.IN_MOVED_FROM | unix.IN_MOVED_TO | unix.IN_ONLYDIR
+
+type inotifyBackend struct {
+	// we read from the inotify fd through an *os.File so that the read goes
+	// through the runtime's poller, which lets Close unblock it
+	file *os.File
+	fd   int
+
+	mutex sync.Mutex
+	// watch descriptor -> watched directory
+	dirs map[int]string
+
+	events chan event
+	errors chan error
+	done   chan struct{}
+}
+
+func newBackend() (backend, error) {
+	fd, err := unix.InotifyInit1(unix.IN_CLOEXEC | unix.IN_NONBLOCK)
+	if err != nil {
+		if errors.Is(err, unix.EMFILE) {
+			return nil, ErrWatchLimitExceeded
+		}
+		return nil, err
+	}
+
+	self := &inotifyBackend{
+		file:   os.NewFile(uintptr(fd), "inotify"),
+		fd:     fd,
+		dirs:   map[int]string{},
+		events: make(chan event),
+		errors: make(chan error, 1),
+		done:   make(chan struct{}),
+	}
+
+	go self.read()
+
+	return self, nil
+}
+
+func (self *inotifyBackend) Add(dir string) error {
+	wd, err := unix.InotifyAddWatch(self.fd, dir, inotifyMask)
+	if err != nil {
+		switch {
+		case errors.Is(err, unix.ENOSPC):
+			return ErrWatchLimitExceeded
+		case errors.Is(err, unix.ENOENT), errors.Is(err, unix.ENOTDIR):
+			// the directory is gone already
+			return nil
+		}
+		return &os.PathError{Op: "inotify_add_watch", Path: dir, Err: err}
+	}
+
+	self.mutex.Lock()
+	defer self.mutex.Unlock()
+	self.dirs[wd] = dir
+	return nil
+}
+
+func (self *inotifyBackend) Events() <-chan event {
+	return self.events
+}
+
+func (self *inotifyBackend) Errors() <-chan error {
+	return self.errors
+}
+
+func (self *inotifyBackend) Close() error {
+	close(self.done)
+	return self.file.Close()
+}
+
+func (self *inotifyBackend) read() {
+	defer close(self.events)
+
+	buf := make([]byte, 64*(unix.SizeofInotifyEvent+unix.NAME_MAX+1))
+	for {
+		n, err := self.file.Read(buf)
+		if err != nil {
+			if !errors.Is(err, os.ErrClosed) {
+				self.errors <- err
+			}
+			return
+		}
+
+		for offset := 0; offset+unix.SizeofInotifyEvent <= n; {
+			raw := (*unix.InotifyEvent)(unsafe.Pointer(&buf[offset]))
+			nameStart := offset + unix.SizeofInotifyEvent
+			name := string(bytes.TrimRight(buf[nameStart:nameStart+int(raw.Len)], "\x00"))
+			offset = nameStart + int(raw.Len)
+
+			if raw.Mask&unix.IN_Q_OVERFLOW != 0 {
+				if !self.send(event{overflow: true}) {
+					return
+				}
+				continue
+			}
+
+			self.mutex.Lock()
+			dir, ok := self.dirs[int(raw.Wd)]
+			if raw.Mask&unix.IN_IGNORED != 0 {
+				// the watch was removed, typically because the directory was deleted
+				delete(self.dirs, int(raw.Wd))
+			}
+			self.mutex.Unlock()
+			if !ok || raw.Mask&unix.IN_IGNORED != 0 {
+				continue
+			}
+
+			if !self.send(event{
+				path:    filepath.Join(dir, name),
+				isDir:   raw.Mask&unix.IN_ISDIR != 0,
+				created: raw.Mask&(unix.IN_CREATE|unix.IN_MOVED_TO) != 0,
+			}) {
+				return
+			}
+		}
+	}
+}
+
+// returns false if we've been closed in the meantime
+func (self *inotifyBackend) send(event event) bool {
+	select {
+	case self.events <- event:
+		return true
+	case <-self.done:
+		return false
+	}
+}
@@ -0,0 +1,9 @@
+//go:build !linux
+// +build !linux
+
+package filewatcher
+
+// Only Linux (inotify) is supported for now; elsewhere we keep polling
+func newBackend() (backend, error) {
+	return nil, ErrUnsupported
+}
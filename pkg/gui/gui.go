@@ -11,6 +11,7 @@ import (
 	"sort"
 	"strings"
 	"sync"
+	"time"
 
 	"github.com/jesseduffield/gocui"
 	"github.com/jesseduffield/lazycore/pkg/boxlayout"
@@ -103,6 +104,19 @@ type Gui struct {
 	// Log of the commands/actions logged in the Command Log panel.
 	GuiLog []string
 
+	// The action that we most recently logged, which the commands that we log
+	// afterwards are grouped under in the structured command log
+	commandLogAction string
+	// the RunID of the most recent command log entry; only accessed while
+	// holding the CommandLogMutex
+	lastCommandLogRunID int
+	// writes the command log to disk
+	commandLogWriter *commandLogWriter
+
+	// when lazygit was started; the commands we ran since then make up the
+	// session that the command log can be exported for
+	sessionStartTime time.Time
+
 	// the extras window contains things like the command log
 	ShowExtrasWindow bool
 
@@ -192,6 +206,10 @@ func (self *StateAccessor) SetRetainOriginalDir(value bool) {
 	self.gui.RetainOriginalDir = value
 }
 
+func (self *StateAccessor) GetSessionStartTime() time.Time {
+	return self.gui.sessionStartTime
+}
+
 func (self *StateAccessor) GetItemOperation(item types.HasUrn) types.ItemOperation {
 	self.gui.itemOperationsMutex.Lock()
 	defer self.gui.itemOperationsMutex.Unlock()
@@ -330,6 +348,8 @@ func (gui *Gui) onNewRepo(startArgs appTypes.StartArgs, contextKey types.Context
 	}
 
 	gui.g.SetFocusHandler(func(Focused bool) error {
+		gui.BackgroundRoutineMgr.SetTerminalFocused(Focused)
+
 		if Focused {
 			oldConfig := gui.Config.GetUserConfig()
 			reloadErr, didChange := gui.Config.ReloadChangedUserConfigFiles()
@@ -471,6 +491,7 @@ func (gui *Gui) checkForChangedConfigsThatDontAutoReload(oldConfig *config.UserC
 		"Git.AutoFetch",
 		"Git.AutoRefresh",
 		"Refresher.RefreshInterval",
+		"Refresher.WatchFiles",
 		"Refresher.FetchInterval",
 		"Update.Method",
 		"Update.Days",
@@ -556,6 +577,7 @@ func (gui *Gui) resetState(startArgs appTypes.StartArgs) types.Context {
 			FilesTrie:             patricia.NewTrie(),
 			Authors:               map[string]*models.Author{},
 			MainBranches:          git_commands.NewMainBranches(gui.c.Common, gui.os.Cmd),
+			CommandLogEntries:     gui.loadCommandLog(worktreePath),
 		},
 		Modes: &types.Modes{
 			Filtering:        filtering.New(startArgs.FilterPath, ""),
@@ -657,6 +679,8 @@ func NewGui(
 		RepoPathStack:        &utils.StringStack{},
 		RepoStateMap:         map[Repo]*GuiRepoState{},
 		GuiLog:               []string{},
+		sessionStartTime:     time.Now(),
+		commandLogWriter:     newCommandLogWriter(cmn.Log),
 
 		// initializing this to true for the time being; it will be reset to the
 		// real value after loading the user config:
@@ -672,6 +696,7 @@ func NewGui(
 			SubprocessMutex:         &deadlock.Mutex{},
 			PopupMutex:              &deadlock.Mutex{},
 			PtyMutex:                &deadlock.Mutex{},
+			CommandLogMutex:         &deadlock.Mutex{},
 		},
 		InitialDir:       initialDir,
 		afterLayoutFuncs: make(chan func() error, 1000),
@@ -705,7 +730,8 @@ func NewGui(
 
 	guiIO := oscommands.NewGuiIO(
 		cmn.Log,
-		gui.LogCommand,
+		gui.logCommand,
+		gui.LogCommandResult,
 		gui.getCmdWriter,
 		credentialsHelper.PromptUserForCredential,
 	)
@@ -801,6 +827,16 @@ func (gui *Gui) viewTabMap() map[string][]context.TabView {
 				ViewName: "submodules",
 			},
 		},
+		"extras": {
+			{
+				Tab:      gui.c.Tr.CommandLog,
+				ViewName: "extras",
+			},
+			{
+				Tab:      gui.c.Tr.CommandLogEntriesTitle,
+				ViewName: "commandLogEntries",
+			},
+		},
 	}
 
 	return result
@@ -818,6 +854,9 @@ func (gui *Gui) Run(startArgs appTypes.StartArgs) error {
 	gui.g = g
 	defer gui.g.Close()
 
+	// don't lose the commands we logged just before quitting
+	defer gui.commandLogWriter.flush()
+
 	g.ErrorHandler = gui.PopupHandler.ErrorHandler
 
 	// if the deadlock package wants to report a deadlock, we first need to
@@ -959,7 +998,8 @@ func (gui *Gui) runSubprocessWithSuspense(subprocess oscommands.ICmdObj) (bool,
 }
 
 func (gui *Gui) runSubprocess(cmdObj oscommands.ICmdObj) error { //nolint:unparam
-	gui.LogCommand(cmdObj.ToString(), true)
+	logID := gui.logCommand(cmdObj.ToString(), true)
+	t := time.Now()
 
 	subprocess := cmdObj.GetCmd()
 	subprocess.Stdout = os.Stdout
@@ -970,6 +1010,9 @@ func (gui *Gui) runSubprocess(cmdObj oscommands.ICmdObj) error { //nolint:unpara
 
 	err := subprocess.Run()
 
+	// the output went to the terminal, so we can't show it in the log
+	gui.LogCommandResult(oscommands.NewCommandResult(cmdObj, logID, "", err, time.Since(t)))
+
 	subprocess.Stdout = io.Discard
 	subprocess.Stderr = io.Discard
 	subprocess.Stdin = nil
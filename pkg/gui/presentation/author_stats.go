@@ -0,0 +1,119 @@
+package presentation
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/jesseduffield/lazygit/pkg/commands/models"
+	"github.com/jesseduffield/lazygit/pkg/gui/presentation/authors"
+	"github.com/jesseduffield/lazygit/pkg/gui/style"
+	"github.com/jesseduffield/lazygit/pkg/i18n"
+	"github.com/jesseduffield/lazygit/pkg/utils"
+	"github.com/samber/lo"
+)
+
+// The number of top-level directories we show per author
+const maxAuthorStatsDirectories = 3
+
+// GetAuthorStats aggregates the given commits per author, most active author
+// first. Line counts and directories come from numstats, which may be missing
+// entries (e.g. for merge commits).
+func GetAuthorStats(commits []*models.Commit, numstats map[string]*models.CommitNumstat) []*models.AuthorStats {
+	statsByAuthor := map[string]*models.AuthorStats{}
+	directoryCounts := map[string]map[string]int{}
+
+	for _, commit := range commits {
+		if commit.IsTODO() {
+			continue
+		}
+
+		author := models.Author{Name: commit.AuthorName, Email: commit.AuthorEmail}
+		key := author.Combined()
+		stats, ok := statsByAuthor[key]
+		if !ok {
+			stats = &models.AuthorStats{
+				Author:               author,
+				FirstCommitTimestamp: commit.UnixTimestamp,
+				LastCommitTimestamp:  commit.UnixTimestamp,
+			}
+			statsByAuthor[key] = stats
+			directoryCounts[key] = map[string]int{}
+		}
+
+		stats.Commits++
+		stats.FirstCommitTimestamp = min(stats.FirstCommitTimestamp, commit.UnixTimestamp)
+		stats.LastCommitTimestamp = max(stats.LastCommitTimestamp, commit.UnixTimestamp)
+
+		if numstat, ok := numstats[commit.Hash]; ok {
+			stats.LinesAdded += numstat.LinesAdded
+			stats.LinesRemoved += numstat.LinesRemoved
+			for _, path := range numstat.Paths {
+				directoryCounts[key][topLevelDirectory(path)]++
+			}
+		}
+	}
+
+	for key, stats := range statsByAuthor {
+		counts := directoryCounts[key]
+		stats.Directories = lo.Keys(counts)
+		sort.Slice(stats.Directories, func(i, j int) bool {
+			a, b := stats.Directories[i], stats.Directories[j]
+			if counts[a] != counts[b] {
+				return counts[a] > counts[b]
+			}
+			return a < b
+		})
+	}
+
+	result := lo.Values(statsByAuthor)
+	sort.Slice(result, func(i, j int) bool {
+		if result[i].Commits != result[j].Commits {
+			return result[i].Commits > result[j].Commits
+		}
+		return result[i].Combined() < result[j].Combined()
+	})
+
+	return result
+}
+
+func topLevelDirectory(path string) string {
+	dir, _, found := strings.Cut(path, "/")
+	if !found {
+		return "/"
+	}
+	return dir + "/"
+}
+
+func GetAuthorStatsDisplayStrings(
+	stats *models.AuthorStats,
+	now time.Time,
+	timeFormat string,
+	shortTimeFormat string,
+	tr *i18n.TranslationSet,
+) []string {
+	directories := stats.Directories
+	if len(directories) > maxAuthorStatsDirectories {
+		directories = append(directories[:maxAuthorStatsDirectories:maxAuthorStatsDirectories], "…")
+	}
+
+	commitCount := tr.AuthorStatsOneCommit
+	if stats.Commits != 1 {
+		commitCount = utils.ResolvePlaceholderString(tr.AuthorStatsCommitCount, map[string]string{
+			"count": fmt.Sprint(stats.Commits),
+		})
+	}
+
+	return []string{
+		authors.AuthorStyle(stats.Name).Sprint(stats.Name),
+		commitCount,
+		style.FgGreen.Sprintf("+%d", stats.LinesAdded),
+		style.FgRed.Sprintf("-%d", stats.LinesRemoved),
+		style.FgBlue.Sprintf("%s – %s",
+			utils.UnixToDateSmart(now, stats.FirstCommitTimestamp, timeFormat, shortTimeFormat),
+			utils.UnixToDateSmart(now, stats.LastCommitTimestamp, timeFormat, shortTimeFormat),
+		),
+		style.FgCyan.Sprint(strings.Join(directories, " ")),
+	}
+}
@@ -0,0 +1,58 @@
+package presentation
+
+import (
+	"testing"
+	"time"
+
+	"github.com/jesseduffield/lazygit/pkg/commands/models"
+	"github.com/jesseduffield/lazygit/pkg/i18n"
+	"github.com/stefanhaller/git-todo-parser/todo"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetAuthorStats(t *testing.T) {
+	commits := []*models.Commit{
+		{Hash: "c4", AuthorName: "Jane", AuthorEmail: "jane@example.com", UnixTimestamp: 400},
+		{Hash: "c3", AuthorName: "John", AuthorEmail: "john@example.com", UnixTimestamp: 300},
+		{Hash: "c2", AuthorName: "Jane", AuthorEmail: "jane@example.com", UnixTimestamp: 200},
+		{Hash: "c1", AuthorName: "Jane", AuthorEmail: "jane@example.com", UnixTimestamp: 100},
+		{Hash: "todo", AuthorName: "John", AuthorEmail: "john@example.com", Action: todo.Pick},
+	}
+
+	numstats := map[string]*models.CommitNumstat{
+		"c4": {LinesAdded: 10, LinesRemoved: 2, Paths: []string{"pkg/a.go", "README.md"}},
+		"c3": {LinesAdded: 1, LinesRemoved: 1, Paths: []string{"docs/x.md"}},
+		"c2": {LinesAdded: 5, LinesRemoved: 0, Paths: []string{"pkg/b.go"}},
+	}
+
+	assert.Equal(t, []*models.AuthorStats{
+		{
+			Author:               models.Author{Name: "Jane", Email: "jane@example.com"},
+			Commits:              3,
+			LinesAdded:           15,
+			LinesRemoved:         2,
+			FirstCommitTimestamp: 100,
+			LastCommitTimestamp:  400,
+			Directories:          []string{"pkg/", "/"},
+		},
+		{
+			Author:               models.Author{Name: "John", Email: "john@example.com"},
+			Commits:              1,
+			LinesAdded:           1,
+			LinesRemoved:         1,
+			FirstCommitTimestamp: 300,
+			LastCommitTimestamp:  300,
+			Directories:          []string{"docs/"},
+		},
+	}, GetAuthorStats(commits, numstats))
+}
+
+func TestGetAuthorStatsDisplayStringsCommitCount(t *testing.T) {
+	tr := i18n.EnglishTranslationSet()
+	now := time.Unix(1000, 0)
+
+	for commits, expected := range map[int]string{1: "1 commit", 2: "2 commits"} {
+		stats := &models.AuthorStats{Author: models.Author{Name: "Jane"}, Commits: commits}
+		assert.Equal(t, expected, GetAuthorStatsDisplayStrings(stats, now, "", "", tr)[1])
+	}
+}
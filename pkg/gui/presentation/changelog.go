@@ -0,0 +1,354 @@
+package presentation
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/jesseduffield/lazygit/pkg/commands/models"
+	"github.com/jesseduffield/lazygit/pkg/i18n"
+	"github.com/jesseduffield/lazygit/pkg/utils"
+	"github.com/samber/lo"
+)
+
+// ConventionalCommit is a commit subject parsed according to
+// https://www.conventionalcommits.org, e.g. "feat(parser)!: support arrays"
+type ConventionalCommit struct {
+	Type     string
+	Scope    string
+	Breaking bool
+	Subject  string
+}
+
+var conventionalCommitRegex = regexp.MustCompile(`^(\w+)(?:\(([^)]*)\))?(!)?:\s*(.+)$`)
+
+// ParseConventionalCommit returns false if the message doesn't follow the
+// conventional commit format
+func ParseConventionalCommit(message string) (ConventionalCommit, bool) {
+	matches := conventionalCommitRegex.FindStringSubmatch(message)
+	if matches == nil {
+		return ConventionalCommit{}, false
+	}
+
+	return ConventionalCommit{
+		Type:     strings.ToLower(matches[1]),
+		Scope:    matches[2],
+		Breaking: matches[3] != "",
+		Subject:  matches[4],
+	}, true
+}
+
+type ChangelogGroup struct {
+	Title   string
+	Commits []*models.Commit
+}
+
+type changelogSection struct {
+	title func(tr *i18n.TranslationSet) string
+	types []string
+}
+
+// The order in which sections appear in a changelog. Commits with a type that
+// isn't listed here (or without a conventional type at all) go in the last one.
+var changelogSections = []changelogSection{
+	{title: func(tr *i18n.TranslationSet) string { return tr.ChangelogBreakingChanges }},
+	{title: func(tr *i18n.TranslationSet) string { return tr.ChangelogFeatures }, types: []string{"feat", "feature"}},
+	{title: func(tr *i18n.TranslationSet) string { return tr.ChangelogBugFixes }, types: []string{"fix", "bugfix"}},
+	{title: func(tr *i18n.TranslationSet) string { return tr.ChangelogPerformance }, types: []string{"perf"}},
+	{title: func(tr *i18n.TranslationSet) string { return tr.ChangelogRefactoring }, types: []string{"refactor"}},
+	{title: func(tr *i18n.TranslationSet) string { return tr.ChangelogDocumentation }, types: []string{"docs"}},
+	{title: func(tr *i18n.TranslationSet) string { return tr.ChangelogTests }, types: []string{"test", "tests"}},
+	{title: func(tr *i18n.TranslationSet) string { return tr.ChangelogBuild }, types: []string{"build", "ci"}},
+	{title: func(tr *i18n.TranslationSet) string { return tr.ChangelogChores }, types: []string{"chore", "style", "revert"}},
+	{title: func(tr *i18n.TranslationSet) string { return tr.ChangelogOtherChanges }},
+}
+
+// GroupCommitsByConventionalType groups commits by their conventional commit
+// type, in changelog order. Merge commits are left out, and empty groups are
+// omitted. Within a group, commits keep the order they were passed in.
+func GroupCommitsByConventionalType(commits []*models.Commit, tr *i18n.TranslationSet) []ChangelogGroup {
+	breakingIndex := 0
+	otherIndex := len(changelogSections) - 1
+
+	buckets := make([][]*models.Commit, len(changelogSections))
+	for _, commit := range commits {
+		if commit.IsMerge() {
+			continue
+		}
+
+		index := otherIndex
+		if conventional, ok := ParseConventionalCommit(commit.Name); ok {
+			if conventional.Breaking {
+				index = breakingIndex
+			} else {
+				_, i, found := lo.FindIndexOf(changelogSections, func(section changelogSection) bool {
+					return lo.Contains(section.types, conventional.Type)
+				})
+				if found {
+					index = i
+				}
+			}
+		}
+		buckets[index] = append(buckets[index], commit)
+	}
+
+	groups := []ChangelogGroup{}
+	for i, section := range changelogSections {
+		if len(buckets[i]) == 0 {
+			continue
+		}
+		groups = append(groups, ChangelogGroup{Title: section.title(tr), Commits: buckets[i]})
+	}
+
+	return groups
+}
+
+// ChangelogEntry returns the text of a changelog line for the given commit,
+// i.e. its subject with the conventional commit type stripped off
+func ChangelogEntry(commit *models.Commit) string {
+	conventional, ok := ParseConventionalCommit(commit.Name)
+	if !ok {
+		return commit.Name
+	}
+
+	if conventional.Scope != "" {
+		return fmt.Sprintf("%s: %s", conventional.Scope, conventional.Subject)
+	}
+	return conventional.Subject
+}
+
+// GetPlainChangelog renders the commits as a plain-text changelog suitable for
+// a tag annotation. We avoid markdown headings because git strips lines
+// starting with '#' from tag messages.
+func GetPlainChangelog(commits []*models.Commit, tr *i18n.TranslationSet) string {
+	groups := GroupCommitsByConventionalType(commits, tr)
+
+	return strings.Join(lo.Map(groups, func(group ChangelogGroup, _ int) string {
+		lines := lo.Map(group.Commits, func(commit *models.Commit, _ int) string {
+			return "- " + ChangelogEntry(commit)
+		})
+		return group.Title + ":\n" + strings.Join(lines, "\n")
+	}), "\n\n")
+}
+
+type ChangelogGrouping int
+
+const (
+	ChangelogGroupByType ChangelogGrouping = iota
+	ChangelogGroupByPullRequest
+)
+
+type ChangelogOpts struct {
+	FromRef  string
+	ToRef    string
+	Grouping ChangelogGrouping
+	// Returns the URL of the commit on the hosting service, or "" if there is
+	// none, in which case the commit isn't linked
+	GetCommitURL func(hash string) string
+}
+
+var pullRequestMergeRegexes = []*regexp.Regexp{
+	// GitHub
+	regexp.MustCompile(`^Merge pull request (#\d+) from (\S+)`),
+	// Bitbucket
+	regexp.MustCompile(`^Merged in (\S+) \(pull request (#\d+)\)`),
+	// GitLab (the merge request number is only mentioned in the body)
+	regexp.MustCompile(`^Merge branch '([^']+)' into '[^']+'`),
+}
+
+// e.g. "Add widget (#123)", as created by GitHub's squash merge
+var squashedPullRequestRegex = regexp.MustCompile(`\((#\d+)\)$`)
+
+// GroupCommitsByPullRequest groups the commits of a range by the pull request
+// that brought them in. Pull requests are recognised by merge commits on the
+// first-parent history (and by squash merges with a "(#123)" suffix); all
+// other commits go into a final group. Merge commits themselves are left out.
+func GroupCommitsByPullRequest(commits []*models.Commit, tr *i18n.TranslationSet) []ChangelogGroup {
+	commitsByHash := lo.SliceToMap(commits, func(commit *models.Commit) (string, *models.Commit) {
+		return commit.Hash, commit
+	})
+	indexByHash := lo.SliceToMap(lo.Range(len(commits)), func(i int) (string, int) {
+		return commits[i].Hash, i
+	})
+
+	// The tip is the one commit in the range that isn't a parent of another
+	allParents := lo.SliceToMap(lo.FlatMap(commits, func(commit *models.Commit, _ int) []string {
+		return commit.Parents
+	}), func(hash string) (string, bool) { return hash, true })
+	tip, found := lo.Find(commits, func(commit *models.Commit) bool {
+		return !allParents[commit.Hash]
+	})
+	if !found {
+		return nil
+	}
+
+	firstParentChain := []*models.Commit{}
+	onFirstParentChain := map[string]bool{}
+	for commit := tip; commit != nil; {
+		firstParentChain = append(firstParentChain, commit)
+		onFirstParentChain[commit.Hash] = true
+		if len(commit.Parents) == 0 {
+			break
+		}
+		commit = commitsByHash[commit.Parents[0]]
+	}
+
+	assigned := map[string]bool{}
+	groups := []ChangelogGroup{}
+	directCommits := []*models.Commit{}
+
+	for _, commit := range firstParentChain {
+		if !commit.IsMerge() {
+			if matches := squashedPullRequestRegex.FindStringSubmatch(commit.Name); matches != nil {
+				groups = append(groups, ChangelogGroup{Title: matches[1], Commits: []*models.Commit{commit}})
+			} else {
+				directCommits = append(directCommits, commit)
+			}
+			assigned[commit.Hash] = true
+			continue
+		}
+
+		// Collect everything the merge brought in that isn't part of the
+		// first-parent history
+		members := []*models.Commit{}
+		queue := commit.Parents[1:]
+		for len(queue) > 0 {
+			hash := queue[0]
+			queue = queue[1:]
+			member, ok := commitsByHash[hash]
+			if !ok || onFirstParentChain[hash] || assigned[hash] {
+				continue
+			}
+			assigned[hash] = true
+			if !member.IsMerge() {
+				members = append(members, member)
+			}
+			queue = append(queue, member.Parents...)
+		}
+		sort.SliceStable(members, func(i, j int) bool {
+			return indexByHash[members[i].Hash] < indexByHash[members[j].Hash]
+		})
+
+		if len(members) > 0 {
+			groups = append(groups, ChangelogGroup{Title: pullRequestTitle(commit.Name), Commits: members})
+		}
+	}
+
+	// Commits that we couldn't attribute to anything, e.g. because the range
+	// starts in the middle of a merged branch
+	for _, commit := range commits {
+		if !assigned[commit.Hash] && !onFirstParentChain[commit.Hash] && !commit.IsMerge() {
+			directCommits = append(directCommits, commit)
+		}
+	}
+
+	if len(directCommits) > 0 {
+		groups = append(groups, ChangelogGroup{Title: tr.ChangelogDirectCommits, Commits: directCommits})
+	}
+
+	return groups
+}
+
+func pullRequestTitle(mergeMessage string) string {
+	for _, regex := range pullRequestMergeRegexes {
+		matches := regex.FindStringSubmatch(mergeMessage)
+		if matches == nil {
+			continue
+		}
+		if len(matches) == 3 {
+			// put the number first, whichever way round the message has it
+			if strings.HasPrefix(matches[1], "#") {
+				return fmt.Sprintf("%s (%s)", matches[1], matches[2])
+			}
+			return fmt.Sprintf("%s (%s)", matches[2], matches[1])
+		}
+		return matches[1]
+	}
+
+	return mergeMessage
+}
+
+type ChangelogAuthor struct {
+	Name    string
+	Commits int
+}
+
+// GetChangelogAuthors aggregates commits by author like `git shortlog -sn`
+// does: the authors with the most commits come first. Merge commits are not
+// counted.
+func GetChangelogAuthors(commits []*models.Commit) []ChangelogAuthor {
+	counts := map[string]int{}
+	for _, commit := range commits {
+		if !commit.IsMerge() {
+			counts[commit.AuthorName]++
+		}
+	}
+
+	authors := lo.MapToSlice(counts, func(name string, count int) ChangelogAuthor {
+		return ChangelogAuthor{Name: name, Commits: count}
+	})
+	sort.Slice(authors, func(i, j int) bool {
+		if authors[i].Commits != authors[j].Commits {
+			return authors[i].Commits > authors[j].Commits
+		}
+		return authors[i].Name < authors[j].Name
+	})
+
+	return authors
+}
+
+// GetMarkdownChangelog renders release notes for the given range of commits
+func GetMarkdownChangelog(commits []*models.Commit, opts ChangelogOpts, tr *i18n.TranslationSet) string {
+	var groups []ChangelogGroup
+	entry := ChangelogEntry
+	if opts.Grouping == ChangelogGroupByPullRequest {
+		groups = GroupCommitsByPullRequest(commits, tr)
+		// the type is useful information when the group doesn't tell us
+		entry = func(commit *models.Commit) string { return commit.Name }
+	} else {
+		groups = GroupCommitsByConventionalType(commits, tr)
+	}
+
+	title := utils.ResolvePlaceholderString(tr.ChangelogTitle, map[string]string{
+		"from": opts.FromRef,
+		"to":   opts.ToRef,
+	})
+	if opts.FromRef == "" {
+		title = utils.ResolvePlaceholderString(tr.ChangelogTitleWithoutStart, map[string]string{
+			"to": opts.ToRef,
+		})
+	}
+
+	sections := []string{"# " + title}
+	for _, group := range groups {
+		lines := lo.Map(group.Commits, func(commit *models.Commit, _ int) string {
+			return fmt.Sprintf("- %s (%s)", entry(commit), commitLink(commit, opts.GetCommitURL))
+		})
+		sections = append(sections, "## "+group.Title+"\n\n"+strings.Join(lines, "\n"))
+	}
+
+	authors := GetChangelogAuthors(commits)
+	if len(authors) > 0 {
+		lines := lo.Map(authors, func(author ChangelogAuthor, _ int) string {
+			return fmt.Sprintf("- %s (%d)", author.Name, author.Commits)
+		})
+		sections = append(sections, "## "+tr.ChangelogContributors+"\n\n"+strings.Join(lines, "\n"))
+	}
+
+	return strings.Join(sections, "\n\n") + "\n"
+}
+
+func commitLink(commit *models.Commit, getCommitURL func(hash string) string) string {
+	shortHash := commit.ShortHash()
+	if getCommitURL == nil {
+		return shortHash
+	}
+
+	url := getCommitURL(commit.Hash)
+	if url == "" {
+		return shortHash
+	}
+
+	return fmt.Sprintf("[%s](%s)", shortHash, url)
+}
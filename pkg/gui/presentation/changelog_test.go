@@ -0,0 +1,137 @@
+package presentation
+
+import (
+	"testing"
+
+	"github.com/jesseduffield/lazygit/pkg/commands/models"
+	"github.com/jesseduffield/lazygit/pkg/utils"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseConventionalCommit(t *testing.T) {
+	scenarios := []struct {
+		message    string
+		expected   ConventionalCommit
+		expectedOk bool
+	}{
+		{"feat: add thing", ConventionalCommit{Type: "feat", Subject: "add thing"}, true},
+		{"fix(parser): handle arrays", ConventionalCommit{Type: "fix", Scope: "parser", Subject: "handle arrays"}, true},
+		{"Refactor!: drop old API", ConventionalCommit{Type: "refactor", Breaking: true, Subject: "drop old API"}, true},
+		{"Add thing", ConventionalCommit{}, false},
+		{"Merge branch 'feature: x'", ConventionalCommit{}, false},
+	}
+
+	for _, s := range scenarios {
+		t.Run(s.message, func(t *testing.T) {
+			actual, ok := ParseConventionalCommit(s.message)
+			assert.Equal(t, s.expectedOk, ok)
+			assert.Equal(t, s.expected, actual)
+		})
+	}
+}
+
+func TestGetPlainChangelog(t *testing.T) {
+	commits := []*models.Commit{
+		{Name: "fix(ui): crash on resize", Parents: []string{"a"}},
+		{Name: "Merge branch 'feature'", Parents: []string{"a", "b"}},
+		{Name: "feat: dark mode", Parents: []string{"a"}},
+		{Name: "Update readme", Parents: []string{"a"}},
+		{Name: "feat!: new config format", Parents: []string{"a"}},
+		{Name: "fix: typo", Parents: []string{"a"}},
+	}
+
+	expected := `Breaking changes:
+- new config format
+
+Features:
+- dark mode
+
+Bug fixes:
+- ui: crash on resize
+- typo
+
+Other changes:
+- Update readme`
+
+	assert.Equal(t, expected, GetPlainChangelog(commits, utils.NewDummyCommon().Tr))
+}
+
+func TestGroupCommitsByPullRequest(t *testing.T) {
+	// History (newest first):
+	//   m2  Merge pull request #2 from owner/fix  (parents: s1, f1)
+	//   f1  fix: crash                              (branch of #2)
+	//   s1  Add docs (#3)                           (squash merge)
+	//   m1  Merge pull request #1 from owner/feat  (parents: d1, a2)
+	//   a2  feat: part two                          (branch of #1)
+	//   a1  feat: part one                          (branch of #1)
+	//   d1  Direct commit
+	commits := []*models.Commit{
+		{Hash: "m2", Name: "Merge pull request #2 from owner/fix", Parents: []string{"s1", "f1"}},
+		{Hash: "f1", Name: "fix: crash", Parents: []string{"s1"}},
+		{Hash: "s1", Name: "Add docs (#3)", Parents: []string{"m1"}},
+		{Hash: "m1", Name: "Merge pull request #1 from owner/feat", Parents: []string{"d1", "a2"}},
+		{Hash: "a2", Name: "feat: part two", Parents: []string{"a1"}},
+		{Hash: "a1", Name: "feat: part one", Parents: []string{"d1"}},
+		{Hash: "d1", Name: "Direct commit", Parents: []string{"base"}},
+	}
+
+	groups := GroupCommitsByPullRequest(commits, utils.NewDummyCommon().Tr)
+
+	type simpleGroup struct {
+		title  string
+		hashes []string
+	}
+	actual := lo.Map(groups, func(group ChangelogGroup, _ int) simpleGroup {
+		return simpleGroup{
+			title:  group.Title,
+			hashes: lo.Map(group.Commits, func(commit *models.Commit, _ int) string { return commit.Hash }),
+		}
+	})
+
+	assert.Equal(t, []simpleGroup{
+		{title: "#2 (owner/fix)", hashes: []string{"f1"}},
+		{title: "#3", hashes: []string{"s1"}},
+		{title: "#1 (owner/feat)", hashes: []string{"a2", "a1"}},
+		{title: "Other commits", hashes: []string{"d1"}},
+	}, actual)
+}
+
+func TestGetMarkdownChangelog(t *testing.T) {
+	commits := []*models.Commit{
+		{Hash: "1234567890", Name: "feat: dark mode", AuthorName: "Jane", Parents: []string{"a"}},
+		{Hash: "abcdefabcd", Name: "fix: typo", AuthorName: "John", Parents: []string{"a"}},
+		{Hash: "9876543210", Name: "fix: crash", AuthorName: "Jane", Parents: []string{"a"}},
+	}
+
+	opts := ChangelogOpts{
+		FromRef:  "v1.0.0",
+		ToRef:    "v1.1.0",
+		Grouping: ChangelogGroupByType,
+		GetCommitURL: func(hash string) string {
+			if hash == "abcdefabcd" {
+				return ""
+			}
+			return "https://example.com/commit/" + hash
+		},
+	}
+
+	expected := `# Changes from v1.0.0 to v1.1.0
+
+## Features
+
+- dark mode ([12345678](https://example.com/commit/1234567890))
+
+## Bug fixes
+
+- typo (abcdefab)
+- crash ([98765432](https://example.com/commit/9876543210))
+
+## Contributors
+
+- Jane (2)
+- John (1)
+`
+
+	assert.Equal(t, expected, GetMarkdownChangelog(commits, opts, utils.NewDummyCommon().Tr))
+}
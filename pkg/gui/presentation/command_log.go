@@ -0,0 +1,70 @@
+package presentation
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/jesseduffield/lazygit/pkg/gui/style"
+	"github.com/jesseduffield/lazygit/pkg/gui/types"
+	"github.com/jesseduffield/lazygit/pkg/theme"
+	"github.com/jesseduffield/lazygit/pkg/utils"
+	"github.com/samber/lo"
+)
+
+func GetCommandLogEntryListDisplayStrings(
+	entries []*types.CommandLogEntry,
+	now time.Time,
+	timeFormat string,
+	shortTimeFormat string,
+) [][]string {
+	return lo.Map(entries, func(entry *types.CommandLogEntry, _ int) []string {
+		return getCommandLogEntryDisplayStrings(entry, now, timeFormat, shortTimeFormat)
+	})
+}
+
+func getCommandLogEntryDisplayStrings(
+	entry *types.CommandLogEntry,
+	now time.Time,
+	timeFormat string,
+	shortTimeFormat string,
+) []string {
+	commandStyle := theme.DefaultTextColor
+	if !entry.IsCommandLine {
+		// same as in the command log: things that aren't commands are shown differently
+		commandStyle = style.FgMagenta
+	}
+
+	duration := ""
+	if entry.IsCommandLine && entry.Finished {
+		duration = style.FgCyan.Sprint(FormatCommandDuration(entry.Duration))
+	}
+
+	return []string{
+		commandLogEntryStatus(entry),
+		style.FgBlue.Sprint(utils.UnixToDateSmart(now, entry.Time.Unix(), timeFormat, shortTimeFormat)),
+		style.FgYellow.Sprint(entry.Action),
+		commandStyle.Sprint(utils.TruncateWithEllipsis(entry.Command, 200)),
+		duration,
+	}
+}
+
+func commandLogEntryStatus(entry *types.CommandLogEntry) string {
+	switch {
+	case !entry.IsCommandLine:
+		return ""
+	case !entry.Finished:
+		return style.FgYellow.Sprint("…")
+	case entry.ExitCode != 0:
+		return style.FgRed.Sprint("✗ " + strconv.Itoa(entry.ExitCode))
+	default:
+		return style.FgGreen.Sprint("✓")
+	}
+}
+
+func FormatCommandDuration(duration time.Duration) string {
+	if duration < time.Second {
+		return duration.Round(time.Millisecond).String()
+	}
+
+	return duration.Round(10 * time.Millisecond).String()
+}
@@ -0,0 +1,160 @@
+package presentation
+
+import (
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/jesseduffield/lazygit/pkg/gui/types"
+	"github.com/jesseduffield/lazygit/pkg/i18n"
+	"github.com/jesseduffield/lazygit/pkg/utils"
+	"github.com/samber/lo"
+)
+
+type CommandLogScriptOpts struct {
+	// The repo that the commands were run in
+	RepoPath string
+	// When the session started whose commands the script contains
+	SessionStart time.Time
+}
+
+// GetCommandLogScript returns a shell script that runs the commands of the
+// given command log entries in the order in which lazygit ran them, with the
+// actions they were run for as comments. The entries are expected newest
+// first, as in the model. Read-only commands are left out. The commands that
+// failed or didn't finish, and those that lazygit drove itself (e.g.
+// interactive rebases), are only mentioned in comments.
+func GetCommandLogScript(entries []*types.CommandLogEntry, opts CommandLogScriptOpts, tr *i18n.TranslationSet) string {
+	header := utils.ResolvePlaceholderString(tr.CommandLogScriptHeader, map[string]string{
+		"repo": opts.RepoPath,
+		"time": opts.SessionStart.Format(time.DateTime),
+	})
+
+	lines := []string{"#!/bin/sh"}
+	lines = append(lines, scriptComment(header)...)
+	lines = append(lines, "#")
+	lines = append(lines, scriptComment(tr.CommandLogScriptWarning)...)
+	lines = append(lines, "", "set -e", "", "cd "+shellQuote(opts.RepoPath))
+
+	chronological := slices.Clone(entries)
+	slices.Reverse(chronological)
+
+	first := true
+	lastAction := ""
+	for _, entry := range chronological {
+		if entry.IsCommandLine && entry.Finished && IsReadOnlyCommand(entry.Args) {
+			continue
+		}
+
+		if first || entry.Action != lastAction {
+			lines = append(lines, "")
+			if entry.Action != "" {
+				lines = append(lines, scriptComment(entry.Action)...)
+			}
+			first = false
+			lastAction = entry.Action
+		}
+
+		switch {
+		case !entry.IsCommandLine:
+			lines = append(lines, scriptComment(utils.ResolvePlaceholderString(
+				tr.CommandLogScriptNotACommand, map[string]string{"description": entry.Command},
+			))...)
+		case !entry.CanRerun():
+			lines = append(lines, scriptComment(tr.CommandLogScriptUnfinished)...)
+			lines = append(lines, scriptComment(entry.Command)...)
+		case entry.Failed():
+			lines = append(lines, scriptComment(utils.ResolvePlaceholderString(
+				tr.CommandLogScriptFailed, map[string]string{"exitCode": strconv.Itoa(entry.ExitCode)},
+			))...)
+			lines = append(lines, scriptComment(shellCommand(entry.Args))...)
+		case entry.UsedDaemon:
+			lines = append(lines, scriptComment(tr.CommandLogScriptUsedDaemon)...)
+			lines = append(lines, scriptComment(shellCommand(entry.Args))...)
+		default:
+			lines = append(lines, shellCommand(entry.Args))
+		}
+	}
+
+	return strings.Join(lines, "\n") + "\n"
+}
+
+func scriptComment(str string) []string {
+	return lo.Map(strings.Split(str, "\n"), func(line string, _ int) string {
+		return strings.TrimRight("# "+line, " ")
+	})
+}
+
+func shellCommand(args []string) string {
+	return strings.Join(lo.Map(args, func(arg string, _ int) string { return shellQuote(arg) }), " ")
+}
+
+var shellSafeRegex = regexp.MustCompile(`^[\w@%+=:,./-]+$`)
+
+// shellQuote quotes the given string for use as a single argument in a POSIX
+// shell, unless it doesn't need quoting
+func shellQuote(str string) string {
+	if shellSafeRegex.MatchString(str) {
+		return str
+	}
+
+	return "'" + strings.ReplaceAll(str, "'", `'\''`) + "'"
+}
+
+// git subcommands that never change anything
+var readOnlyGitCommands = []string{
+	"blame", "cat-file", "check-attr", "check-ignore", "count-objects", "describe",
+	"diff", "diff-files", "diff-index", "diff-tree", "for-each-ref", "grep", "log",
+	"ls-files", "ls-remote", "ls-tree", "merge-base", "name-rev", "rev-list",
+	"rev-parse", "shortlog", "show", "show-ref", "status", "var", "version",
+}
+
+// GitSubcommand splits the arguments of a git command into the subcommand
+// (e.g. 'log') and its arguments, skipping global options like '-c key=value'
+// or '-C <path>'. Returns false if it's not a git command; the subcommand is
+// empty if there is none.
+func GitSubcommand(args []string) (string, []string, bool) {
+	if len(args) == 0 || args[0] != "git" {
+		return "", nil, false
+	}
+
+	rest := args[1:]
+	for len(rest) > 0 && strings.HasPrefix(rest[0], "-") {
+		if (rest[0] == "-c" || rest[0] == "-C") && len(rest) > 1 {
+			rest = rest[1:]
+		}
+		rest = rest[1:]
+	}
+	if len(rest) == 0 {
+		return "", nil, true
+	}
+
+	return rest[0], rest[1:], true
+}
+
+// IsReadOnlyCommand returns true for commands that only read the state of the
+// repo, like the ones we use for loading the data we display
+func IsReadOnlyCommand(args []string) bool {
+	subcommand, subArgs, ok := GitSubcommand(args)
+	if !ok {
+		return false
+	}
+	if subcommand == "" {
+		return true
+	}
+
+	switch subcommand {
+	case "config":
+		return lo.SomeBy(subArgs, func(arg string) bool {
+			return lo.Contains([]string{"--get", "--get-all", "--get-regexp", "--list", "-l"}, arg)
+		})
+	case "stash", "worktree":
+		return len(subArgs) > 0 && (subArgs[0] == "list" || subArgs[0] == "show")
+	case "remote":
+		return len(subArgs) == 0 || subArgs[0] == "-v" || subArgs[0] == "get-url" || subArgs[0] == "show"
+	}
+
+	return lo.Contains(readOnlyGitCommands, subcommand)
+}
@@ -0,0 +1,113 @@
+package presentation
+
+import (
+	"testing"
+	"time"
+
+	"github.com/jesseduffield/lazygit/pkg/gui/types"
+	"github.com/jesseduffield/lazygit/pkg/utils"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetCommandLogScript(t *testing.T) {
+	sessionStart := time.Date(2024, 3, 5, 14, 30, 0, 0, time.UTC)
+
+	command := func(action string, args ...string) *types.CommandLogEntry {
+		return &types.CommandLogEntry{
+			Action:        action,
+			Command:       "(display string, not used)",
+			Args:          args,
+			IsCommandLine: true,
+			Finished:      true,
+		}
+	}
+
+	failedPush := command("Push", "git", "push", "origin", "main")
+	failedPush.ExitCode = 1
+
+	rebase := command("Squash", "git", "rebase", "--interactive", "--autostash", "abc123")
+	rebase.UsedDaemon = true
+
+	// oldest last, as in the model
+	entries := []*types.CommandLogEntry{
+		{Action: "Pull", Command: "git pull --no-edit", IsCommandLine: true},
+		rebase,
+		failedPush,
+		{Action: "Discard all changes in file", Command: "Deleting path 'tmp.txt'"},
+		command("Commit", "git", "commit", "-m", "it's done\n\nwith a body"),
+		command("", "git", "rev-list", "--count", "HEAD"),
+		command("Stage file", "git", "add", "--", "file b.txt"),
+		command("Stage file", "git", "-c", "core.quotepath=false", "status", "--porcelain"),
+		command("Stage file", "git", "add", "--", "file-a.txt"),
+	}
+
+	expected := `#!/bin/sh
+# Git commands that lazygit ran in /home/bill/my repo in the session started at 2024-03-05 14:30:00
+#
+# Review the commands before running them. Commands that lazygit drove itself
+# (e.g. interactive rebases, where lazygit edits the todo list) are commented out,
+# because they won't do the same when run from this script.
+
+set -e
+
+cd '/home/bill/my repo'
+
+# Stage file
+git add -- file-a.txt
+git add -- 'file b.txt'
+
+# Commit
+git commit -m 'it'\''s done
+
+with a body'
+
+# Discard all changes in file
+# Not a shell command: Deleting path 'tmp.txt'
+
+# Push
+# Failed with exit code 1:
+# git push origin main
+
+# Squash
+# Driven by lazygit, won't do the same when run from here:
+# git rebase --interactive --autostash abc123
+
+# Pull
+# Did not finish:
+# git pull --no-edit
+`
+
+	actual := GetCommandLogScript(
+		entries,
+		CommandLogScriptOpts{RepoPath: "/home/bill/my repo", SessionStart: sessionStart},
+		utils.NewDummyCommon().Tr,
+	)
+	assert.Equal(t, expected, actual)
+}
+
+func TestIsReadOnlyCommand(t *testing.T) {
+	scenarios := []struct {
+		args     []string
+		expected bool
+	}{
+		{[]string{"git", "status", "--untracked-files=yes", "--porcelain", "-z"}, true},
+		{[]string{"git", "-c", "log.showSignature=false", "log", "HEAD"}, true},
+		{[]string{"git", "-C", "/some/path", "rev-parse", "--git-dir"}, true},
+		{[]string{"git", "config", "--get", "remote.origin.url"}, true},
+		{[]string{"git", "config", "user.name", "Bill"}, false},
+		{[]string{"git", "stash", "list"}, true},
+		{[]string{"git", "stash", "push"}, false},
+		{[]string{"git", "worktree", "list", "--porcelain"}, true},
+		{[]string{"git", "worktree", "add", "../wt"}, false},
+		{[]string{"git", "remote"}, true},
+		{[]string{"git", "remote", "add", "upstream", "url"}, false},
+		{[]string{"git", "commit", "-m", "msg"}, false},
+		{[]string{"git", "reset", "--hard"}, false},
+		{[]string{"sh", "-c", "git log"}, false},
+		{[]string{}, false},
+	}
+
+	for _, s := range scenarios {
+		assert.Equal(t, s.expected, IsReadOnlyCommand(s.args), s.args)
+	}
+}
@@ -0,0 +1,42 @@
+package presentation
+
+import (
+	"testing"
+	"time"
+
+	"github.com/gookit/color"
+	"github.com/jesseduffield/lazygit/pkg/gui/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/xo/terminfo"
+)
+
+func TestGetCommandLogEntryListDisplayStrings(t *testing.T) {
+	now := time.Date(2024, 5, 10, 15, 0, 0, 0, time.Local)
+	today := time.Date(2024, 5, 10, 14, 30, 0, 0, time.Local)
+	earlier := time.Date(2024, 5, 1, 9, 0, 0, 0, time.Local)
+
+	entries := []*types.CommandLogEntry{
+		{Action: "Push", Command: "git push", IsCommandLine: true, Time: today},
+		{Action: "Commit", Command: "git commit", IsCommandLine: true, Time: today, Finished: true, Duration: 1234 * time.Millisecond},
+		{Action: "Pull", Command: "git pull", IsCommandLine: true, Time: earlier, Finished: true, ExitCode: 128, Duration: 42 * time.Millisecond},
+		{Action: "Delete file", Command: "Deleting path 'foo'", Time: earlier, Finished: true},
+	}
+
+	oldColorLevel := color.ForceSetColorLevel(terminfo.ColorLevelNone)
+	defer color.ForceSetColorLevel(oldColorLevel)
+
+	result := GetCommandLogEntryListDisplayStrings(entries, now, "2006-01-02", "15:04")
+	assert.Equal(t, [][]string{
+		{"…", "14:30", "Push", "git push", ""},
+		{"✓", "14:30", "Commit", "git commit", "1.23s"},
+		{"✗ 128", "2024-05-01", "Pull", "git pull", "42ms"},
+		{"", "2024-05-01", "Delete file", "Deleting path 'foo'", ""},
+	}, result)
+}
+
+func TestFormatCommandDuration(t *testing.T) {
+	assert.Equal(t, "42ms", FormatCommandDuration(42*time.Millisecond+300*time.Microsecond))
+	assert.Equal(t, "999ms", FormatCommandDuration(999*time.Millisecond))
+	assert.Equal(t, "1.23s", FormatCommandDuration(1234*time.Millisecond))
+	assert.Equal(t, "1m30s", FormatCommandDuration(90*time.Second+2*time.Millisecond))
+}
@@ -379,6 +379,8 @@ func displayCommit(
 		hashString = hashColor.Sprint("*")
 	}
 
+	signatureString := getSignatureStatusText(commit.SignatureStatus)
+
 	divergenceString := ""
 	if commit.Divergence != models.DivergenceNone {
 		divergenceString = hashColor.Sprint(lo.Ternary(commit.Divergence == models.DivergenceLeft, "↑", "↓"))
@@ -440,27 +442,47 @@ func displayCommit(
 		mark = fmt.Sprintf("%s ", willBeRebased)
 	}
 
+	shallowBoundaryString := ""
+	if commit.ShallowBoundary {
+		shallowBoundaryString = " " + style.FgYellow.Sprintf("<-- %s", common.Tr.ShallowCloneBoundary)
+	}
+
 	authorLength := common.UserConfig().Gui.CommitAuthorShortLength
 	if fullDescription {
 		authorLength = common.UserConfig().Gui.CommitAuthorLongLength
 	}
 	author := authors.AuthorWithLength(commit.AuthorName, authorLength)
 
-	cols := make([]string, 0, 7)
+	cols := make([]string, 0, 8)
 	cols = append(
 		cols,
 		divergenceString,
 		hashString,
+		signatureString,
 		bisectString,
 		descriptionString,
 		actionString,
 		author,
-		graphLine+mark+tagString+theme.DefaultTextColor.Sprint(name),
+		graphLine+mark+tagString+theme.DefaultTextColor.Sprint(name)+shallowBoundaryString,
 	)
 
 	return cols
 }
 
+func getSignatureStatusText(status models.SignatureStatus) string {
+	switch status {
+	case models.SignatureGood:
+		return style.FgGreen.Sprint("✓")
+	case models.SignatureGoodUnknownValidity, models.SignatureExpired, models.SignatureExpiredKey,
+		models.SignatureRevokedKey, models.SignatureCannotCheck:
+		return style.FgYellow.Sprint("?")
+	case models.SignatureBad:
+		return style.FgRed.Sprint("✗")
+	default:
+		return ""
+	}
+}
+
 func getBisectStatusColor(status BisectStatus) style.TextStyle {
 	switch status {
 	case BisectStatusNone:
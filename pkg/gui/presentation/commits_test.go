@@ -89,6 +89,44 @@ func TestGetCommitListDisplayStrings(t *testing.T) {
 		hash2 commit2
 						`),
 		},
+		{
+			testName: "commits with signature status",
+			commits: []*models.Commit{
+				{Name: "commit1", Hash: "hash1", SignatureStatus: models.SignatureGood},
+				{Name: "commit2", Hash: "hash2", SignatureStatus: models.SignatureGoodUnknownValidity},
+				{Name: "commit3", Hash: "hash3", SignatureStatus: models.SignatureBad},
+				{Name: "commit4", Hash: "hash4"},
+			},
+			startIdx:                  0,
+			endIdx:                    4,
+			showGraph:                 false,
+			bisectInfo:                git_commands.NewNullBisectInfo(),
+			cherryPickedCommitHashSet: set.New[string](),
+			now:                       time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC),
+			expected: formatExpected(`
+		hash1 ✓ commit1
+		hash2 ? commit2
+		hash3 ✗ commit3
+		hash4   commit4
+						`),
+		},
+		{
+			testName: "commit at the boundary of a shallow clone",
+			commits: []*models.Commit{
+				{Name: "commit1", Hash: "hash1", Parents: []string{"hash2"}},
+				{Name: "commit2", Hash: "hash2", ShallowBoundary: true},
+			},
+			startIdx:                  0,
+			endIdx:                    2,
+			showGraph:                 false,
+			bisectInfo:                git_commands.NewNullBisectInfo(),
+			cherryPickedCommitHashSet: set.New[string](),
+			now:                       time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC),
+			expected: formatExpected(`
+		hash1 commit1
+		hash2 commit2 <-- shallow clone boundary
+						`),
+		},
 		{
 			testName: "show local branch head, except the current branch, main branches, or merged branches",
 			commits: []*models.Commit{
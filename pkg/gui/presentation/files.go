@@ -18,27 +18,42 @@ const (
 	COLLAPSED_ARROW = "▶"
 )
 
+// startIdx and endIdx are the range of lines to render, or -1 for both to
+// render all of them
 func RenderFileTree(
 	tree filetree.IFileTree,
 	submoduleConfigs []*models.SubmoduleConfig,
 	showFileIcons bool,
 	showNumstat bool,
+	startIdx int,
+	endIdx int,
 ) []string {
 	collapsedPaths := tree.CollapsedPaths()
-	return renderAux(tree.GetRoot().Raw(), collapsedPaths, -1, -1, func(node *filetree.Node[models.File], treeDepth int, visualDepth int, isCollapsed bool) string {
+	lines := &lineRange{startIdx: startIdx, endIdx: endIdx}
+	return renderAux(tree.GetRoot().Raw(), collapsedPaths, -1, -1, lines, func(node *filetree.Node[models.File], treeDepth int, visualDepth int, isCollapsed bool) string {
 		fileNode := filetree.NewFileNode(node)
 
 		return getFileLine(isCollapsed, fileNode.GetHasUnstagedChanges(), fileNode.GetHasStagedChanges(), treeDepth, visualDepth, showNumstat, showFileIcons, submoduleConfigs, node)
 	})
 }
 
+// Returns the name of each line of the tree as RenderFileTree shows it, so that
+// we can search the tree without rendering all of it
+func FileTreeNames(tree filetree.IFileTree) []string {
+	lines := &lineRange{startIdx: -1, endIdx: -1}
+	return renderAux(tree.GetRoot().Raw(), tree.CollapsedPaths(), -1, -1, lines, func(node *filetree.Node[models.File], treeDepth int, _ int, _ bool) string {
+		return utils.EscapeSpecialChars(fileNameAtDepth(node, treeDepth))
+	})
+}
+
 func RenderCommitFileTree(
 	tree *filetree.CommitFileTreeViewModel,
 	patchBuilder *patch.PatchBuilder,
 	showFileIcons bool,
 ) []string {
 	collapsedPaths := tree.CollapsedPaths()
-	return renderAux(tree.GetRoot().Raw(), collapsedPaths, -1, -1, func(node *filetree.Node[models.CommitFile], treeDepth int, visualDepth int, isCollapsed bool) string {
+	lines := &lineRange{startIdx: -1, endIdx: -1}
+	return renderAux(tree.GetRoot().Raw(), collapsedPaths, -1, -1, lines, func(node *filetree.Node[models.CommitFile], treeDepth int, visualDepth int, isCollapsed bool) string {
 		status := commitFilePatchStatus(node, tree, patchBuilder)
 
 		return getCommitFileLine(isCollapsed, treeDepth, visualDepth, node, status, showFileIcons)
@@ -64,6 +79,26 @@ func commitFilePatchStatus(node *filetree.Node[models.CommitFile], tree *filetre
 	}
 }
 
+// The range of lines that we want to render. We have to walk the tree from the
+// top to know the depth of each node, but we only render the lines in the range
+// and stop walking once we're past it, so that the cost of rendering a huge
+// tree doesn't depend on its size.
+type lineRange struct {
+	// -1 for both to render all lines
+	startIdx int
+	endIdx   int
+	// the index of the line we're at
+	idx int
+}
+
+func (self *lineRange) includesCurrent() bool {
+	return self.startIdx == -1 || self.idx >= self.startIdx
+}
+
+func (self *lineRange) done() bool {
+	return self.endIdx != -1 && self.idx >= self.endIdx
+}
+
 func renderAux[T any](
 	node *filetree.Node[T],
 	collapsedPaths *filetree.CollapsedPaths,
@@ -74,9 +109,10 @@ func renderAux[T any](
 	// relies on tree depth.
 	treeDepth int,
 	visualDepth int,
+	lines *lineRange,
 	renderLine func(*filetree.Node[T], int, int, bool) string,
 ) []string {
-	if node == nil {
+	if node == nil || lines.done() {
 		return []string{}
 	}
 
@@ -86,21 +122,32 @@ func renderAux[T any](
 		if isRoot {
 			return []string{}
 		}
-		return []string{renderLine(node, treeDepth, visualDepth, false)}
+		arr := []string{}
+		if lines.includesCurrent() {
+			arr = append(arr, renderLine(node, treeDepth, visualDepth, false))
+		}
+		lines.idx++
+		return arr
 	}
 
 	arr := []string{}
 	if !isRoot {
-		isCollapsed := collapsedPaths.IsCollapsed(node.GetPath())
-		arr = append(arr, renderLine(node, treeDepth, visualDepth, isCollapsed))
+		if lines.includesCurrent() {
+			isCollapsed := collapsedPaths.IsCollapsed(node.GetPath())
+			arr = append(arr, renderLine(node, treeDepth, visualDepth, isCollapsed))
+		}
+		lines.idx++
 	}
 
 	if collapsedPaths.IsCollapsed(node.GetPath()) {
 		return arr
 	}
 
-	for _, child := range node.Children {
-		arr = append(arr, renderAux(child, collapsedPaths, treeDepth+1+node.CompressionLevel, visualDepth+1, renderLine)...)
+	for _, child := range node.GetChildren() {
+		if lines.done() {
+			break
+		}
+		arr = append(arr, renderAux(child, collapsedPaths, treeDepth+1+node.CompressionLevel, visualDepth+1, lines, renderLine)...)
 	}
 
 	return arr
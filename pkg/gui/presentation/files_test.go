@@ -89,12 +89,38 @@ M  file1
 			for _, path := range s.collapsedPaths {
 				viewModel.ToggleCollapsed(path)
 			}
-			result := RenderFileTree(viewModel, nil, false, s.showLineChanges)
+			result := RenderFileTree(viewModel, nil, false, s.showLineChanges, -1, -1)
 			assert.EqualValues(t, s.expected, result)
 		})
 	}
 }
 
+func TestRenderFileTreeRange(t *testing.T) {
+	files := []*models.File{
+		{Name: "dir1/file2", ShortStatus: "M ", HasUnstagedChanges: true},
+		{Name: "dir1/file3", ShortStatus: "M ", HasUnstagedChanges: true},
+		{Name: "dir2/dir2/file3", ShortStatus: " M", HasStagedChanges: true},
+		{Name: "dir2/file5", ShortStatus: "M ", HasUnstagedChanges: true},
+		{Name: "file1", ShortStatus: "M ", HasUnstagedChanges: true},
+	}
+
+	oldColorLevel := color.ForceSetColorLevel(terminfo.ColorLevelNone)
+	defer color.ForceSetColorLevel(oldColorLevel)
+
+	viewModel := filetree.NewFileTree(func() []*models.File { return files }, utils.NewDummyLog(), true)
+	viewModel.SetTree()
+
+	assert.EqualValues(t, []string{
+		"  M  file3",
+		"▼ dir2",
+		"  ▼ dir2",
+	}, RenderFileTree(viewModel, nil, false, false, 2, 5))
+
+	assert.EqualValues(t,
+		[]string{"dir1", "file2", "file3", "dir2", "dir2", "file3", "file5", "file1"},
+		FileTreeNames(viewModel))
+}
+
 func TestRenderCommitFileTree(t *testing.T) {
 	scenarios := []struct {
 		name           string
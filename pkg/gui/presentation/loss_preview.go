@@ -0,0 +1,70 @@
+package presentation
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/jesseduffield/lazygit/pkg/commands/models"
+	"github.com/jesseduffield/lazygit/pkg/gui/style"
+	"github.com/jesseduffield/lazygit/pkg/i18n"
+	"github.com/jesseduffield/lazygit/pkg/utils"
+	"github.com/samber/lo"
+)
+
+// The number of items per section that we show in a loss preview; it's shown
+// in a confirmation popup, so it needs to stay short
+const maxLossPreviewItems = 10
+
+// GetLossPreviewText describes what an operation would lose, for showing in its
+// confirmation popup. commitsHeading introduces the preview's commits, since
+// what happens to them depends on the operation.
+func GetLossPreviewText(preview *models.LossPreview, commitsHeading string, tr *i18n.TranslationSet) string {
+	sections := []string{}
+
+	if len(preview.Commits) > 0 {
+		sections = append(sections, lossPreviewSection(
+			commitsHeading,
+			lo.Map(preview.Commits, func(commit *models.Commit, _ int) string {
+				return style.FgYellow.Sprint(commit.ShortHash()) + " " + commit.Name
+			}),
+			tr,
+		))
+	}
+
+	if len(preview.ChangedFiles) > 0 {
+		sections = append(sections, lossPreviewSection(
+			tr.LossPreviewDiscardedChanges,
+			lo.Map(preview.ChangedFiles, func(line string, _ int) string {
+				status, path, _ := strings.Cut(line, "\t")
+				return style.FgRed.Sprint(status) + " " + path
+			}),
+			tr,
+		))
+	}
+
+	if len(preview.UntrackedFiles) > 0 {
+		sections = append(sections, lossPreviewSection(
+			tr.LossPreviewDeletedUntrackedFiles,
+			lo.Map(preview.UntrackedFiles, func(path string, _ int) string {
+				return style.FgRed.Sprint("?") + " " + path
+			}),
+			tr,
+		))
+	}
+
+	return strings.Join(sections, "\n\n")
+}
+
+func lossPreviewSection(heading string, items []string, tr *i18n.TranslationSet) string {
+	lines := []string{style.FgRed.Sprint(heading)}
+	for _, item := range lo.Slice(items, 0, maxLossPreviewItems) {
+		lines = append(lines, "  "+item)
+	}
+	if len(items) > maxLossPreviewItems {
+		lines = append(lines, "  "+utils.ResolvePlaceholderString(tr.LossPreviewMore, map[string]string{
+			"count": fmt.Sprint(len(items) - maxLossPreviewItems),
+		}))
+	}
+
+	return strings.Join(lines, "\n")
+}
@@ -0,0 +1,49 @@
+package presentation
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/gookit/color"
+	"github.com/jesseduffield/lazygit/pkg/commands/models"
+	"github.com/jesseduffield/lazygit/pkg/utils"
+	"github.com/stretchr/testify/assert"
+	"github.com/xo/terminfo"
+)
+
+func TestGetLossPreviewText(t *testing.T) {
+	oldColorLevel := color.ForceSetColorLevel(terminfo.ColorLevelNone)
+	defer color.ForceSetColorLevel(oldColorLevel)
+
+	untrackedFiles := []string{}
+	for i := range 12 {
+		untrackedFiles = append(untrackedFiles, fmt.Sprintf("file-%02d", i))
+	}
+
+	preview := &models.LossPreview{
+		Commits:        []*models.Commit{{Hash: "1234567890abcdef", Name: "wip"}},
+		ChangedFiles:   []string{"M\tfile.txt"},
+		UntrackedFiles: untrackedFiles,
+	}
+
+	expected := `Commits:
+  12345678 wip
+
+These uncommitted changes will be discarded:
+  M file.txt
+
+These untracked files will be deleted:
+  ? file-00
+  ? file-01
+  ? file-02
+  ? file-03
+  ? file-04
+  ? file-05
+  ? file-06
+  ? file-07
+  ? file-08
+  ? file-09
+  …and 2 more`
+
+	assert.Equal(t, expected, GetLossPreviewText(preview, "Commits:", utils.NewDummyCommon().Tr))
+}
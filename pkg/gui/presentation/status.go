@@ -2,6 +2,7 @@ package presentation
 
 import (
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/jesseduffield/lazygit/pkg/commands/models"
@@ -48,3 +49,20 @@ func FormatStatus(
 
 	return status
 }
+
+// Returns a marker like "(shallow clone)" to show after the status, or an empty
+// string for a regular clone
+func FormatCloneState(isShallow bool, isPartialClone bool, tr *i18n.TranslationSet) string {
+	states := []string{}
+	if isShallow {
+		states = append(states, tr.ShallowClone)
+	}
+	if isPartialClone {
+		states = append(states, tr.PartialClone)
+	}
+	if len(states) == 0 {
+		return ""
+	}
+
+	return style.FgYellow.Sprintf("(%s)", strings.Join(states, ", "))
+}
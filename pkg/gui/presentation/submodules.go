@@ -2,6 +2,7 @@ package presentation
 
 import (
 	"github.com/jesseduffield/lazygit/pkg/commands/models"
+	"github.com/jesseduffield/lazygit/pkg/gui/style"
 	"github.com/jesseduffield/lazygit/pkg/theme"
 	"github.com/samber/lo"
 )
@@ -23,5 +24,40 @@ func getSubmoduleDisplayStrings(s *models.SubmoduleConfig) []string {
 		name = indentation + "- " + s.Name
 	}
 
-	return []string{theme.DefaultTextColor.Sprint(name)}
+	branch := ""
+	if s.Branch != "" {
+		branch = style.FgCyan.Sprint(s.Branch)
+	}
+
+	return []string{
+		theme.DefaultTextColor.Sprint(name),
+		submoduleStatusMarkers(s.Status),
+		branch,
+	}
+}
+
+// Markers follow `git submodule status`: '-' for not initialized, '+' if the
+// checked-out commit differs from the recorded one and 'U' for conflicts. '*'
+// means there are uncommitted changes in the submodule.
+func submoduleStatusMarkers(status *models.SubmoduleStatus) string {
+	if status == nil {
+		return ""
+	}
+
+	if !status.IsInitialized {
+		return style.FgMagenta.Sprint("-")
+	}
+
+	if status.HasMergeConflicts {
+		return style.FgRed.Sprint("U")
+	}
+
+	markers := ""
+	if status.HasDrift() {
+		markers += style.FgYellow.Sprint("+")
+	}
+	if status.IsDirty {
+		markers += style.FgRed.Sprint("*")
+	}
+	return markers
 }
@@ -39,7 +39,7 @@ func getTagDisplayStrings(
 	if diffed {
 		textStyle = theme.DiffTerminalColor
 	}
-	res := make([]string, 0, 2)
+	res := make([]string, 0, 4)
 	if icons.IsIconEnabled() {
 		res = append(res, textStyle.Sprint(icons.IconForTag(t)))
 	}
@@ -49,6 +49,6 @@ func getTagDisplayStrings(
 	if itemOperationStr != "" {
 		descriptionStr = style.FgCyan.Sprint(itemOperationStr+" "+utils.Loader(time.Now(), userConfig.Gui.Spinner)) + " " + descriptionStr
 	}
-	res = append(res, textStyle.Sprint(t.Name), descriptionStr)
+	res = append(res, textStyle.Sprint(t.Name), getSignatureStatusText(t.SignatureStatus), descriptionStr)
 	return res
 }
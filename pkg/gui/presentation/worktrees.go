@@ -1,11 +1,15 @@
 package presentation
 
 import (
+	"fmt"
+	"strings"
+
 	"github.com/jesseduffield/lazygit/pkg/commands/models"
 	"github.com/jesseduffield/lazygit/pkg/gui/presentation/icons"
 	"github.com/jesseduffield/lazygit/pkg/gui/style"
 	"github.com/jesseduffield/lazygit/pkg/i18n"
 	"github.com/jesseduffield/lazygit/pkg/theme"
+	"github.com/jesseduffield/lazygit/pkg/utils"
 	"github.com/samber/lo"
 )
 
@@ -46,6 +50,48 @@ func GetWorktreeDisplayString(tr *i18n.TranslationSet, worktree *models.Worktree
 	if worktree.IsPathMissing && !icons.IsIconEnabled() {
 		name += " " + tr.MissingWorktree
 	}
+	if worktree.IsLocked {
+		name += " " + tr.LockedWorktree
+	}
 	res = append(res, textStyle.Sprint(name))
+	res = append(res, worktreeStatusString(tr, worktree.Status))
 	return res
 }
+
+func worktreeStatusString(tr *i18n.TranslationSet, status *models.WorktreeStatus) string {
+	if status == nil {
+		return ""
+	}
+
+	parts := []string{}
+
+	if status.WorkingTreeState.IsRebasing() {
+		parts = append(parts, style.FgMagenta.Sprintf("(%s)", tr.LowercaseRebasingStatus))
+	} else if status.WorkingTreeState.IsMerging() {
+		parts = append(parts, style.FgMagenta.Sprintf("(%s)", tr.LowercaseMergingStatus))
+	}
+
+	if status.HasUpstream {
+		if status.Behind == 0 && status.Ahead == 0 {
+			parts = append(parts, style.FgGreen.Sprint("✓"))
+		} else {
+			divergence := ""
+			if status.Behind > 0 {
+				divergence += fmt.Sprintf("↓%d", status.Behind)
+			}
+			if status.Ahead > 0 {
+				divergence += fmt.Sprintf("↑%d", status.Ahead)
+			}
+			parts = append(parts, style.FgYellow.Sprint(divergence))
+		}
+	}
+
+	if status.IsDirty() {
+		parts = append(parts, style.FgRed.Sprint(utils.ResolvePlaceholderString(
+			tr.WorktreeChangedFileCount,
+			map[string]string{"count": fmt.Sprint(status.DirtyFileCount)},
+		)))
+	}
+
+	return strings.Join(parts, " ")
+}
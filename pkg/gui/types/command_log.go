@@ -0,0 +1,56 @@
+package types
+
+import (
+	"strconv"
+	"time"
+)
+
+// An entry in the command log. Entries are persisted per repo, so that they
+// survive restarting lazygit.
+type CommandLogEntry struct {
+	// Identifies the entry while its command is running, so that the result
+	// can be attached to it even if the same command is running several times
+	// at once. Not persisted.
+	RunID int `json:"-"`
+	// The action that the command was run for, e.g. 'Stage file'. Empty if the
+	// command wasn't run as part of an action.
+	Action string `json:"action,omitempty"`
+	// The command as it would be typed on the command line, or a description of
+	// what we did if IsCommandLine is false
+	Command string `json:"command"`
+	// The arguments of the command, which is what we use for re-running it.
+	// Only known once the command has finished.
+	Args []string `json:"args,omitempty"`
+	// False for things we did without running a command (e.g. deleting a file
+	// with Go's standard library), which can't be re-run
+	IsCommandLine bool `json:"isCommandLine"`
+	// When the command was started
+	Time time.Time `json:"time"`
+	// False while the command is still running. Commands we logged but never
+	// got a result for (e.g. because lazygit was quit while they were running)
+	// stay unfinished.
+	Finished bool          `json:"finished"`
+	ExitCode int           `json:"exitCode"`
+	Duration time.Duration `json:"duration"`
+	// The combined stdout and stderr of the command, possibly truncated
+	Output string `json:"output,omitempty"`
+	// True if lazygit drove the command itself, e.g. by editing the todo list
+	// of an interactive rebase, so running it by hand won't do the same
+	UsedDaemon bool `json:"usedDaemon,omitempty"`
+}
+
+func (self *CommandLogEntry) ID() string {
+	return strconv.FormatInt(self.Time.UnixNano(), 10) + self.Command
+}
+
+func (self *CommandLogEntry) Description() string {
+	return self.Command
+}
+
+func (self *CommandLogEntry) Failed() bool {
+	return self.Finished && self.ExitCode != 0
+}
+
+func (self *CommandLogEntry) CanRerun() bool {
+	return self.IsCommandLine && len(self.Args) > 0
+}
@@ -1,6 +1,8 @@
 package types
 
 import (
+	"time"
+
 	"github.com/jesseduffield/gocui"
 	"github.com/jesseduffield/lazygit/pkg/commands"
 	"github.com/jesseduffield/lazygit/pkg/commands/git_commands"
@@ -302,6 +304,13 @@ type Model struct {
 	FilesTrie *patricia.Trie
 
 	Authors map[string]*models.Author
+
+	// The structured command log of this repo, newest first
+	CommandLogEntries []*CommandLogEntry
+
+	// The git settings that affect how fast `git status` is, as shown in the
+	// status dashboard. Nil until loaded.
+	StatusPerformance *git_commands.StatusPerformance
 }
 
 // if you add a new mutex here be sure to instantiate it. We're using pointers to
@@ -316,6 +325,7 @@ type Mutexes struct {
 	SubprocessMutex         *deadlock.Mutex
 	PopupMutex              *deadlock.Mutex
 	PtyMutex                *deadlock.Mutex
+	CommandLogMutex         *deadlock.Mutex
 }
 
 // A long-running operation associated with an item. For example, we'll show
@@ -350,6 +360,8 @@ type IStateAccessor interface {
 	SetShowExtrasWindow(bool)
 	GetRetainOriginalDir() bool
 	SetRetainOriginalDir(bool)
+	// when lazygit was started
+	GetSessionStartTime() time.Time
 	GetItemOperation(item HasUrn) ItemOperation
 	SetItemOperation(item HasUrn, operation ItemOperation)
 	ClearItemOperation(item HasUrn)
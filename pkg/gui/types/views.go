@@ -40,6 +40,7 @@ type Views struct {
 	Suggestions       *gocui.View
 	Tooltip           *gocui.View
 	Extras            *gocui.View
+	CommandLogEntries *gocui.View
 
 	// for playing the easter egg snake game
 	Snake *gocui.View
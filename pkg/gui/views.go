@@ -47,6 +47,7 @@ func (gui *Gui) orderedViewNameMappings() []viewNameMapping {
 		{viewPtr: &gui.Views.Main, name: "main"},
 
 		{viewPtr: &gui.Views.Extras, name: "extras"},
+		{viewPtr: &gui.Views.CommandLogEntries, name: "commandLogEntries"},
 
 		// bottom line
 		{viewPtr: &gui.Views.Options, name: "options"},
@@ -175,6 +176,8 @@ func (gui *Gui) createAllViews() error {
 	gui.Views.Extras.Wrap = true
 	gui.Views.Extras.AutoRenderHyperLinks = true
 
+	gui.Views.CommandLogEntries.Title = gui.c.Tr.CommandLogEntriesTitle
+
 	gui.Views.Snake.Title = gui.c.Tr.SnakeTitle
 	gui.Views.Snake.FgColor = gocui.ColorGreen
 
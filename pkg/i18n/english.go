@@ -37,6 +37,7 @@ type TranslationSet struct {
 	CredentialsPassphrase                 string
 	CredentialsPIN                        string
 	CredentialsToken                      string
+	CredentialsConfirmation               string
 	PassUnameWrong                        string
 	Commit                                string
 	CommitTooltip                         string
@@ -71,6 +72,29 @@ type TranslationSet struct {
 	Push                                  string
 	Pull                                  string
 	PushTooltip                           string
+	ViewPushOptions                       string
+	ViewPushOptionsTooltip                string
+	PushOptionsTitle                      string
+	ForcePushWithLease                    string
+	ForcePushWithLeaseExpecting           string
+	ForcePushWithLeaseExpectingTooltip    string
+	ForcePushWithLeaseRejected            string
+	ForcePushingDisabled                  string
+	CheckedOutBranchHasNoUpstream         string
+	UpstreamNotFetched                    string
+	PushWithPushOptions                   string
+	PushWithPushOptionsTooltip            string
+	NoPushOptionsConfigured               string
+	PushCommitToRemoteRef                 string
+	PushCommitToRemoteRefTooltip          string
+	PushCommitToRemoteRefPrompt           string
+	NoCommitSelectedInCommitsPanel        string
+	PushBranchesAtomically                string
+	PushBranchesAtomicallyTooltip         string
+	AtomicPushBranchCount                 string
+	SelectMultipleBranchesForAtomicPush   string
+	AtomicPushRequiresUpstreams           string
+	AtomicPushRequiresSameRemote          string
 	PullTooltip                           string
 	Scroll                                string
 	FileFilter                            string
@@ -222,9 +246,20 @@ type TranslationSet struct {
 	EditConfig                            string
 	ForcePush                             string
 	ForcePushPrompt                       string
+	ForcePushWithLeasePrompt              string
+	LossPreviewOverwrittenCommits         string
+	LossPreviewRemoteNotStoredLocally     string
 	ForcePushDisabled                     string
 	UpdatesRejected                       string
 	UpdatesRejectedAndForcePushDisabled   string
+	ProtectedBranchOperationForcePush     string
+	ProtectedBranchOperationReset         string
+	ProtectedBranchOperationRebase        string
+	ProtectedBranchOperationCommit        string
+	ProtectedBranchOperationDelete        string
+	ProtectedBranchBlocked                string
+	ProtectedBranchConfirmTitle           string
+	ProtectedBranchConfirmationMismatch   string
 	CheckForUpdate                        string
 	CheckingForUpdates                    string
 	UpdateAvailableTitle                  string
@@ -259,6 +294,41 @@ type TranslationSet struct {
 	MergeBranchTooltip                    string
 	ConfirmQuit                           string
 	SwitchRepo                            string
+	CloneRepository                       string
+	CloneRepositoryTooltip                string
+	CloneURLPrompt                        string
+	CloneDirectoryPrompt                  string
+	CloneOptionsTitle                     string
+	CloneRepositoryAction                 string
+	StatusPerformanceSettings             string
+	StatusPerformanceSettingsTooltip      string
+	LoadingStatusPerformanceStatus        string
+	FsmonitorSetting                      string
+	FsmonitorSettingTooltip               string
+	UntrackedCacheSetting                 string
+	UntrackedCacheSettingTooltip          string
+	SettingEnabled                        string
+	SettingDisabled                       string
+	FsmonitorDaemonRunning                string
+	FsmonitorDaemonNotRunning             string
+	FsmonitorUnavailable                  string
+	FsmonitorUsingHook                    string
+	FsmonitorNotSupported                 string
+	FsmonitorHookConfigured               string
+	CloneDepth                            string
+	CloneDepthPrompt                      string
+	CloneFullHistory                      string
+	CloneBranch                           string
+	CloneBranchPrompt                     string
+	CloneDefaultBranch                    string
+	CloneFilter                           string
+	CloneNoFilter                         string
+	CloneFilterBlobless                   string
+	CloneFilterTreeless                   string
+	CloneFilterNoneTooltip                string
+	CloneFilterBloblessTooltip            string
+	CloneFilterTreelessTooltip            string
+	CloningStatus                         string
 	AllBranchesLogGraph                   string
 	UnsupportedGitService                 string
 	CopyPullRequestURL                    string
@@ -372,6 +442,9 @@ type TranslationSet struct {
 	PullingStatus                         string
 	PushingStatus                         string
 	FetchingStatus                        string
+	FetchedNewUpstreamCommit              string
+	FetchedNewUpstreamCommits             string
+	FetchedForcePushedBranch              string
 	SquashingStatus                       string
 	FixingStatus                          string
 	DeletingStatus                        string
@@ -404,6 +477,7 @@ type TranslationSet struct {
 	DiscardFileChangesPrompt              string
 	DisabledForGPG                        string
 	CreateRepo                            string
+	CloneRepoURL                          string
 	BareRepo                              string
 	InitialBranch                         string
 	NoRecentRepositories                  string
@@ -422,6 +496,14 @@ type TranslationSet struct {
 	DiscardUntrackedFiles                 string
 	DiscardStagedChanges                  string
 	HardReset                             string
+	HardResetPrompt                       string
+	NukeWorkingTreePrompt                 string
+	LossPreviewLostCommits                string
+	LossPreviewDiscardedChanges           string
+	LossPreviewDeletedUntrackedFiles      string
+	LossPreviewMore                       string
+	LossPreviewFailed                     string
+	LossPreviewStatus                     string
 	BranchDeleteTooltip                   string
 	TagDeleteTooltip                      string
 	Delete                                string
@@ -540,11 +622,75 @@ type TranslationSet struct {
 	PushTagTooltip                        string
 	NewTag                                string
 	NewTagTooltip                         string
+	NewSignedTag                          string
+	NewSignedTagTooltip                   string
+	NewReleaseTag                         string
+	NewReleaseTagTooltip                  string
+	ReleaseTagBumpTitle                   string
+	BumpMajorVersion                      string
+	BumpMinorVersion                      string
+	BumpPatchVersion                      string
+	BumpPrereleaseVersion                 string
+	PushReleaseTagTitle                   string
+	DontPushTag                           string
+	ChangelogBreakingChanges              string
+	ChangelogFeatures                     string
+	ChangelogBugFixes                     string
+	ChangelogPerformance                  string
+	ChangelogRefactoring                  string
+	ChangelogDocumentation                string
+	ChangelogTests                        string
+	ChangelogBuild                        string
+	ChangelogChores                       string
+	ChangelogOtherChanges                 string
+	ChangelogDirectCommits                string
+	ChangelogContributors                 string
+	ChangelogTitle                        string
+	ChangelogTitleWithoutStart            string
+	GenerateChangelog                     string
+	GenerateChangelogTooltip              string
+	ChangelogFromRefTitle                 string
+	ChangelogGroupingTitle                string
+	ChangelogGroupByType                  string
+	ChangelogGroupByPullRequest           string
+	GeneratingChangelogStatus             string
+	ChangelogOutputTitle                  string
+	ChangelogShowInMainView               string
+	ChangelogWriteToFile                  string
+	ChangelogFilePathTitle                string
+	ChangelogCopiedToClipboard            string
+	ChangelogWrittenToFile                string
+	ChangelogMainTitle                    string
 	CreatingTag                           string
 	ForceTag                              string
 	ForceTagPrompt                        string
 	FetchRemoteTooltip                    string
 	FetchingRemoteStatus                  string
+	ViewFetchOptions                      string
+	ViewFetchOptionsTooltip               string
+	FetchOptionsTitle                     string
+	FetchWithConfiguredOptions            string
+	FetchWithOptionsTooltip               string
+	FetchAndPrune                         string
+	FetchAndPruneTooltip                  string
+	FetchAndPruneTags                     string
+	FetchAndPruneTagsTooltip              string
+	FetchAllTags                          string
+	FetchWithoutTags                      string
+	FetchWithDepth                        string
+	FetchWithDepthTooltip                 string
+	FetchDepthPrompt                      string
+	InvalidFetchDepth                     string
+	FetchRefspecs                         string
+	FetchRefspecsTooltip                  string
+	FetchRefspecsPrompt                   string
+	RemoteAlreadyUpToDate                 string
+	FetchReportTitle                      string
+	FetchedRefCreated                     string
+	FetchedRefUpdated                     string
+	FetchedRefForcedUpdate                string
+	FetchedRefPruned                      string
+	FetchedRefRejected                    string
 	CheckoutCommit                        string
 	CheckoutCommitTooltip                 string
 	NoBranchesFoundAtCommitTooltip        string
@@ -571,6 +717,40 @@ type TranslationSet struct {
 	Upstream                              string
 	UpstreamTooltip                       string
 	BranchUpstreamOptionsTitle            string
+	BranchesUpstreamOptionsTitle          string
+	PushSelectedBranches                  string
+	PushSelectedBranchesTooltip           string
+	FastForwardSelectedBranches           string
+	FastForwardBranchesTooltip            string
+	SetUpstreamOfSelectedBranches         string
+	SetUpstreamOfBranchesTooltip          string
+	SetUpstreamOfBranchesPrompt           string
+	CleanUpStaleBranches                  string
+	CleanUpStaleBranchesTooltip           string
+	FindingStaleBranchesStatus            string
+	StaleBranchCleanupTitle               string
+	StaleBranchesMerged                   string
+	StaleBranchesUpstreamGone             string
+	StaleBranchesOlderThan                string
+	StaleBranchAgeDisabled                string
+	AllStaleBranches                      string
+	NoStaleBranchesFound                  string
+	StaleBranchMerged                     string
+	StaleBranchUpstreamGone               string
+	StaleBranchOld                        string
+	DeleteStaleBranchesTitle              string
+	DeleteStaleBranchesPrompt             string
+	StaleBranchesForceDeleteWarning       string
+	DeleteStaleLocalAndRemoteTooltip      string
+	PruneRemoteTrackingBranches           string
+	PruneTrackingBranchesTooltip          string
+	PruneTrackingBranchesPrompt           string
+	NoRemoteTrackingBranchesToPrune       string
+	PruningStatus                         string
+	BranchHasNoUpstream                   string
+	RemoteNotFound                        string
+	BranchOperationsSucceeded             string
+	BranchOperationsFailedTitle           string
 	ViewBranchUpstreamOptions             string
 	ViewBranchUpstreamOptionsTooltip      string
 	UpstreamNotSetError                   string
@@ -598,6 +778,13 @@ type TranslationSet struct {
 	ExitFilterMode                        string
 	FilterPathOption                      string
 	FilterAuthorOption                    string
+	ShowAuthorStats                       string
+	ShowAuthorStatsTooltip                string
+	AuthorStatsTitle                      string
+	AuthorStatsCommitCount                string
+	AuthorStatsOneCommit                  string
+	AuthorStatsItemTooltip                string
+	LoadingAuthorStatsStatus              string
 	EnterFileName                         string
 	EnterAuthor                           string
 	FilteringMenuTitle                    string
@@ -654,6 +841,11 @@ type TranslationSet struct {
 	CopySubmoduleNameToClipboard          string
 	RemoveSubmodule                       string
 	RemoveSubmoduleTooltip                string
+	SubmoduleNotInitialized               string
+	SubmoduleHasMergeConflicts            string
+	SubmoduleInSync                       string
+	SubmoduleDrift                        string
+	SubmoduleDirty                        string
 	RemoveSubmodulePrompt                 string
 	ResettingSubmoduleStatus              string
 	NewSubmoduleName                      string
@@ -675,6 +867,20 @@ type TranslationSet struct {
 	BulkDeinitSubmodules                  string
 	ViewBulkSubmoduleOptions              string
 	BulkSubmoduleOptions                  string
+	ParallelInitSubmodules                string
+	ParallelUpdateSubmodules              string
+	ParallelSyncSubmodules                string
+	ParallelFetchSubmodules               string
+	ParallelSubmoduleJobs                 string
+	InitializingSubmodulesStatus          string
+	UpdatingSubmodulesStatus              string
+	SyncingSubmodulesStatus               string
+	FetchingSubmodulesStatus              string
+	SubmoduleJobsProgress                 string
+	SubmoduleJobsSucceeded                string
+	SubmoduleJobSkippedNotInitialized     string
+	SubmoduleJobsFailedTitle              string
+	SubmoduleJobsFailedPrompt             string
 	RunningCommand                        string
 	SubCommitsTitle                       string
 	SubmodulesTitle                       string
@@ -704,11 +910,49 @@ type TranslationSet struct {
 	CommandLog                               string
 	ToggleShowCommandLog                     string
 	FocusCommandLog                          string
+	FocusCommandLogEntries                   string
+	CommandLogEntriesTitle                   string
+	CommandLogNoOutput                       string
+	CommandLogEntryTitle                     string
+	CommandLogExitCode                       string
+	CommandLogDuration                       string
+	CommandLogStillRunning                   string
+	CommandLogNotACommand                    string
+	ToggleCommandOutput                      string
+	ToggleCommandOutputTooltip               string
+	CopyCommandToClipboard                   string
+	CommandCopiedToClipboard                 string
+	RerunCommand                             string
+	RerunCommandTooltip                      string
+	RerunCommandPrompt                       string
+	CannotRerunNonCommand                    string
+	CannotRerunUnfinishedCommand             string
+	CannotRerunCommandUsedDaemon             string
+	ExportCommandLogScript                   string
+	ExportCommandLogScriptTooltip            string
+	NoCommandsInThisSession                  string
+	CommandLogScriptTitle                    string
+	CommandLogScriptShowInMainView           string
+	CommandLogScriptWriteToFile              string
+	CommandLogScriptFilePathTitle            string
+	CommandLogScriptWrittenToFile            string
+	CommandLogScriptCopiedToClipboard        string
+	CommandLogScriptHeader                   string
+	CommandLogScriptWarning                  string
+	CommandLogScriptNotACommand              string
+	CommandLogScriptFailed                   string
+	CommandLogScriptUnfinished               string
+	CommandLogScriptUsedDaemon               string
 	CommandLogHeader                         string
 	RandomTip                                string
 	SelectParentCommitForMerge               string
 	ToggleWhitespaceInDiffView               string
 	ToggleWhitespaceInDiffViewTooltip        string
+	ClearCredentialCache                     string
+	ClearCredentialCacheTooltip              string
+	CredentialCacheDisabled                  string
+	NoCachedCredentials                      string
+	ClearedCachedCredentials                 string
 	IgnoreWhitespaceDiffViewSubTitle         string
 	IgnoreWhitespaceNotSupportedHere         string
 	IncreaseContextInDiffView                string
@@ -732,6 +976,7 @@ type TranslationSet struct {
 	LoadingFileSuggestions                   string
 	LoadingCommits                           string
 	MustSpecifyOriginError                   string
+	MustSpecifyBranchForLeaseError           string
 	GitOutput                                string
 	GitCommandFailed                         string
 	AbortTitle                               string
@@ -745,8 +990,23 @@ type TranslationSet struct {
 	SortAlphabetical                         string
 	SortByDate                               string
 	SortByRecency                            string
+	SortByVersion                            string
 	SortBasedOnReflog                        string
 	SortCommits                              string
+	FetchMoreHistory                         string
+	FetchMoreHistoryTooltip                  string
+	NotAShallowClone                         string
+	DeepenHistoryByCommits                   string
+	DeepenHistoryByCommitsTooltip            string
+	DeepenHistorySince                       string
+	DeepenHistorySinceTooltip                string
+	DeepenHistorySincePrompt                 string
+	UnshallowHistory                         string
+	UnshallowHistoryTooltip                  string
+	FetchingHistoryStatus                    string
+	ShallowCloneBoundary                     string
+	ShallowClone                             string
+	PartialClone                             string
 	CantChangeContextSizeError               string
 	OpenCommitInBrowser                      string
 	ViewBisectOptions                        string
@@ -810,6 +1070,32 @@ type TranslationSet struct {
 	NoWorktreesThisRepo                      string
 	MissingWorktree                          string
 	MainWorktree                             string
+	WorktreeChangedFileCount                 string
+	LockedWorktree                           string
+	LockReason                               string
+	LockWorktree                             string
+	LockWorktreeTooltip                      string
+	LockWorktreeReasonTitle                  string
+	UnlockWorktree                           string
+	UnlockWorktreeTooltip                    string
+	CantLockMainWorktree                     string
+	MoveWorktree                             string
+	MoveWorktreeTooltip                      string
+	MoveWorktreePathTitle                    string
+	MovingWorktree                           string
+	CantMoveMainWorktree                     string
+	CantMoveCurrentWorktree                  string
+	CantMoveMissingWorktree                  string
+	CantMoveLockedWorktree                   string
+	PruneWorktrees                           string
+	PruneWorktreesTooltip                    string
+	PruneWorktreesPrompt                     string
+	PruningWorktrees                         string
+	NoPrunableWorktrees                      string
+	RepairWorktree                           string
+	RepairWorktreeTooltip                    string
+	RepairWorktreeNewPathTitle               string
+	RepairingWorktree                        string
 	NewWorktree                              string
 	NewWorktreePath                          string
 	NewWorktreeBase                          string
@@ -902,6 +1188,11 @@ type Actions struct {
 	RenameBranch                      string
 	CreateBranch                      string
 	FastForwardBranch                 string
+	FastForwardBranches               string
+	PushBranches                      string
+	DeleteStaleBranches               string
+	PruneRemoteTrackingBranches       string
+	SetUpstreamOfBranches             string
 	CherryPick                        string
 	CheckoutFile                      string
 	DiscardOldFileChange              string
@@ -930,6 +1221,9 @@ type Actions struct {
 	CopyCommitTagsToClipboard         string
 	CopyPatchToClipboard              string
 	CustomCommand                     string
+	RerunCommand                      string
+	CopyCommandLogScriptToClipboard   string
+	WriteCommandLogScriptToFile       string
 	DiscardAllChangesInDirectory      string
 	DiscardUnstagedChangesInDirectory string
 	DiscardAllChangesInFile           string
@@ -948,6 +1242,8 @@ type Actions struct {
 	Commit                            string
 	EditFile                          string
 	Push                              string
+	PushCommitToRemoteRef             string
+	PushBranchesAtomically            string
 	Pull                              string
 	OpenFile                          string
 	StashAllChanges                   string
@@ -979,9 +1275,23 @@ type Actions struct {
 	BulkInitialiseSubmodules          string
 	BulkUpdateSubmodules              string
 	BulkDeinitialiseSubmodules        string
+	ParallelInitSubmodules            string
+	ParallelUpdateSubmodules          string
+	ParallelSyncSubmodules            string
+	ParallelFetchSubmodules           string
+	FetchRemote                       string
+	CloneRepository                   string
+	SetFsmonitor                      string
+	SetUntrackedCache                 string
+	DeepenHistory                     string
+	UnshallowHistory                  string
 	UpdateSubmodule                   string
 	CreateLightweightTag              string
 	CreateAnnotatedTag                string
+	CreateSignedTag                   string
+	CreateReleaseTag                  string
+	CopyChangelogToClipboard          string
+	WriteChangelogToFile              string
 	DeleteLocalTag                    string
 	DeleteRemoteTag                   string
 	PushTag                           string
@@ -1005,6 +1315,11 @@ type Actions struct {
 	BisectMark                        string
 	RemoveWorktree                    string
 	AddWorktree                       string
+	LockWorktree                      string
+	UnlockWorktree                    string
+	MoveWorktree                      string
+	PruneWorktrees                    string
+	RepairWorktree                    string
 }
 
 const englishIntroPopupMessage = `
@@ -1069,6 +1384,7 @@ func EnglishTranslationSet() *TranslationSet {
 		CredentialsPassphrase:                "Enter passphrase for SSH key",
 		CredentialsPIN:                       "Enter PIN for SSH key",
 		CredentialsToken:                     "Enter Token for SSH key",
+		CredentialsConfirmation:              "Confirm",
 		PassUnameWrong:                       "Password, passphrase and/or username wrong",
 		Commit:                               "Commit",
 		CommitTooltip:                        "Commit staged changes.",
@@ -1101,6 +1417,29 @@ func EnglishTranslationSet() *TranslationSet {
 		RefreshTooltip:                       "Refresh the git state (i.e. run `git status`, `git branch`, etc in background to update the contents of panels). This does not run `git fetch`.",
 		Push:                                 "Push",
 		PushTooltip:                          "Push the current branch to its upstream branch. If no upstream is configured, you will be prompted to configure an upstream branch.",
+		ViewPushOptions:                      "View push options",
+		ViewPushOptionsTooltip:               "Force push against the commit your upstream was at when you last fetched, push with push options, push a single commit to any remote ref, or push several branches atomically.",
+		PushOptionsTitle:                     "Push options",
+		ForcePushWithLease:                   "Force push with lease",
+		ForcePushWithLeaseExpecting:          "Force push, expecting {{.ref}} not to have moved",
+		ForcePushWithLeaseExpectingTooltip:   "Force push the checked-out branch, but only if its upstream branch on the remote is still at the commit it was at when you last fetched. Unlike a plain force-with-lease, this can't be fooled by a background fetch that happened in the meantime.",
+		ForcePushWithLeaseRejected:           "The remote branch has moved since you last fetched. Fetch and review the new commits before force pushing again.",
+		ForcePushingDisabled:                 "You have disabled force pushing",
+		CheckedOutBranchHasNoUpstream:        "The checked-out branch has no upstream",
+		UpstreamNotFetched:                   "{{.ref}} hasn't been fetched yet",
+		PushWithPushOptions:                  "Push with push options",
+		PushWithPushOptionsTooltip:           "Push the checked-out branch, passing the push options configured for its remote in git.pushOptions (e.g. merge_request.create for GitLab).",
+		NoPushOptionsConfigured:              "No push options are configured for remote '{{.remote}}' (see git.pushOptions)",
+		PushCommitToRemoteRef:                "Push selected commit to remote ref",
+		PushCommitToRemoteRefTooltip:         "Push the commit that is selected in the commits panel to any ref on a remote, e.g. a branch or refs/for/main. The remote only accepts this if it is a fast-forward or a new ref.",
+		PushCommitToRemoteRefPrompt:          "Push {{.commit}} to '<remote> <ref>'",
+		NoCommitSelectedInCommitsPanel:       "No commit is selected in the commits panel",
+		PushBranchesAtomically:               "Push selected branches atomically",
+		PushBranchesAtomicallyTooltip:        "Push all branches that are selected in the branches panel to their upstream branches in one atomic push: either all of them are updated on the remote, or none of them is.",
+		AtomicPushBranchCount:                "{{.count}} branches to {{.remote}}",
+		SelectMultipleBranchesForAtomicPush:  "Select two or more branches in the branches panel first",
+		AtomicPushRequiresUpstreams:          "All selected branches need an upstream branch",
+		AtomicPushRequiresSameRemote:         "All selected branches must track branches on the same remote",
 		Pull:                                 "Pull",
 		PullTooltip:                          "Pull changes from the remote for the current branch. If no upstream is configured, you will be prompted to configure an upstream branch.",
 		Scroll:                               "Scroll",
@@ -1254,9 +1593,20 @@ func EnglishTranslationSet() *TranslationSet {
 		EditConfig:                           "Edit config file",
 		ForcePush:                            "Force push",
 		ForcePushPrompt:                      "Your branch has diverged from the remote branch. Press {{.cancelKey}} to cancel, or {{.confirmKey}} to force push.",
+		ForcePushWithLeasePrompt:             "Are you sure you want to force push to {{.ref}}, expecting it at {{.hash}}?",
+		LossPreviewOverwrittenCommits:        "These commits on the remote branch will be overwritten (as of the last fetch):",
+		LossPreviewRemoteNotStoredLocally:    "The remote branch isn't stored locally, so lazygit can't tell which of its commits will be overwritten.",
 		ForcePushDisabled:                    "Your branch has diverged from the remote branch and you've disabled force pushing",
 		UpdatesRejected:                      "Updates were rejected. Please fetch and examine the remote changes before pushing again.",
 		UpdatesRejectedAndForcePushDisabled:  "Updates were rejected and you have disabled force pushing",
+		ProtectedBranchOperationForcePush:    "force push to",
+		ProtectedBranchOperationReset:        "reset",
+		ProtectedBranchOperationRebase:       "rewrite the commits of",
+		ProtectedBranchOperationCommit:       "commit to",
+		ProtectedBranchOperationDelete:       "delete",
+		ProtectedBranchBlocked:               "Can't {{operation}} '{{branch}}' because it is a protected branch (see the 'git.protectedBranches' config)",
+		ProtectedBranchConfirmTitle:          "Type '{{branch}}' to {{operation}} this protected branch",
+		ProtectedBranchConfirmationMismatch:  "You didn't type '{{branch}}', so nothing was done",
 		CheckForUpdate:                       "Check for update",
 		CheckingForUpdates:                   "Checking for updates...",
 		UpdateAvailableTitle:                 "Update available!",
@@ -1291,6 +1641,41 @@ func EnglishTranslationSet() *TranslationSet {
 		MergeBranchTooltip:                   "View options for merging the selected item into the current branch (regular merge, squash merge)",
 		ConfirmQuit:                          `Are you sure you want to quit?`,
 		SwitchRepo:                           `Switch to a recent repo`,
+		CloneRepository:                      "Clone repository",
+		CloneRepositoryTooltip:               "Clone a repository next to the current one and switch to it. You can choose the target directory and optionally limit the depth of the history, check out a specific branch or make a partial clone using a filter.",
+		CloneURLPrompt:                       "Repository URL",
+		CloneDirectoryPrompt:                 "Clone into directory",
+		CloneOptionsTitle:                    "Clone {{.url}}",
+		CloneRepositoryAction:                "Clone",
+		StatusPerformanceSettings:            "Status performance settings",
+		LoadingStatusPerformanceStatus:       "Checking status performance settings",
+		StatusPerformanceSettingsTooltip:     "Show and change the git settings that make `git status` faster in large repositories. Set git.logStatusTiming in your config to see in the command log how long each `git status` takes.",
+		FsmonitorSetting:                     "File system monitor (core.fsmonitor)",
+		FsmonitorSettingTooltip:              "Let a daemon watch the worktree, so that `git status` doesn't have to look at every file. Needs git 2.36 or later, and isn't supported on all platforms.",
+		UntrackedCacheSetting:                "Untracked cache (core.untrackedCache)",
+		UntrackedCacheSettingTooltip:         "Remember the contents of directories in the index, so that `git status` only has to look for untracked files in directories that changed.",
+		SettingEnabled:                       "enabled",
+		SettingDisabled:                      "disabled",
+		FsmonitorDaemonRunning:               "enabled (daemon running)",
+		FsmonitorDaemonNotRunning:            "enabled (daemon not running yet)",
+		FsmonitorUnavailable:                 "not available",
+		FsmonitorUsingHook:                   "using hook '{{hook}}'",
+		FsmonitorNotSupported:                "Git's built-in file system monitor isn't supported by your git version or on this platform",
+		FsmonitorHookConfigured:              "core.fsmonitor is set to the hook '{{hook}}'. Use git config to change it if you want to use the built-in daemon instead.",
+		CloneDepth:                           "Depth",
+		CloneDepthPrompt:                     "Number of commits to clone (leave empty for the full history)",
+		CloneFullHistory:                     "full history",
+		CloneBranch:                          "Branch",
+		CloneBranchPrompt:                    "Branch to check out (leave empty for the default branch)",
+		CloneDefaultBranch:                   "default branch",
+		CloneFilter:                          "Filter",
+		CloneNoFilter:                        "none",
+		CloneFilterBlobless:                  "Blobless clone",
+		CloneFilterTreeless:                  "Treeless clone",
+		CloneFilterNoneTooltip:               "Clone all objects.",
+		CloneFilterBloblessTooltip:           "Clone all commits and trees but fetch file contents on demand. Good for day-to-day development.",
+		CloneFilterTreelessTooltip:           "Clone only commits and fetch trees and file contents on demand. Good for builds that only need a single checkout.",
+		CloningStatus:                        "Cloning",
 		AllBranchesLogGraph:                  `Show/cycle all branch logs`,
 		UnsupportedGitService:                `Unsupported git service`,
 		CreatePullRequest:                    `Create pull request`,
@@ -1412,6 +1797,9 @@ func EnglishTranslationSet() *TranslationSet {
 		PullingStatus:                        "Pulling",
 		PushingStatus:                        "Pushing",
 		FetchingStatus:                       "Fetching",
+		FetchedNewUpstreamCommit:             "{{branch}} has a new commit",
+		FetchedNewUpstreamCommits:            "{{branch}} has {{count}} new commits",
+		FetchedForcePushedBranch:             "{{branch}} was force-pushed",
 		SquashingStatus:                      "Squashing",
 		FixingStatus:                         "Fixing up",
 		DeletingStatus:                       "Deleting",
@@ -1443,7 +1831,8 @@ func EnglishTranslationSet() *TranslationSet {
 		DiscardFileChangesTitle:              "Discard file changes",
 		DiscardFileChangesPrompt:             "Are you sure you want to remove changes to the selected file(s) from this commit?\n\nThis action will start a rebase, reverting these file changes. Be aware that if subsequent commits depend on these changes, you may need to resolve conflicts.\nNote: This will also reset any active custom patches.",
 		DisabledForGPG:                       "Feature not available for users using GPG",
-		CreateRepo:                           "Not in a git repository. Create a new git repository? (y/n): ",
+		CreateRepo:                           "Not in a git repository. Create a new git repository? (y/n, or c to clone one into this directory): ",
+		CloneRepoURL:                         "Repository URL: ",
 		BareRepo:                             "You've attempted to open Lazygit in a bare repo but Lazygit does not yet support bare repos. Open most recent repo? (y/n) ",
 		InitialBranch:                        "Branch name? (leave empty for git's default): ",
 		NoRecentRepositories:                 "Must open lazygit in a git repository. No valid recent repositories. Exiting.",
@@ -1462,6 +1851,14 @@ func EnglishTranslationSet() *TranslationSet {
 		DiscardUntrackedFiles:                "Discard untracked files",
 		DiscardStagedChanges:                 "Discard staged changes",
 		HardReset:                            "Hard reset",
+		HardResetPrompt:                      "Are you sure you want to hard reset to '{{ref}}'?",
+		NukeWorkingTreePrompt:                "Are you sure you want to discard all changes and delete all untracked files?",
+		LossPreviewLostCommits:               "These commits will no longer be reachable from any branch, tag or remote branch:",
+		LossPreviewDiscardedChanges:          "These uncommitted changes will be discarded:",
+		LossPreviewDeletedUntrackedFiles:     "These untracked files will be deleted:",
+		LossPreviewMore:                      "…and {{count}} more",
+		LossPreviewFailed:                    "Couldn't determine what will be lost: {{error}}",
+		LossPreviewStatus:                    "Checking what will be lost",
 		BranchDeleteTooltip:                  "View delete options for local/remote branch.",
 		TagDeleteTooltip:                     "View delete options for local/remote tag.",
 		Delete:                               "Delete",
@@ -1582,11 +1979,75 @@ func EnglishTranslationSet() *TranslationSet {
 		PushTagTooltip:                 "Push the selected tag to a remote. You'll be prompted to select a remote.",
 		NewTag:                         "New tag",
 		NewTagTooltip:                  "Create new tag from current commit. You'll be prompted to enter a tag name and optional description.",
+		NewSignedTag:                   "New signed tag",
+		NewSignedTagTooltip:            "Create new signed tag from current commit. You'll be prompted to enter a tag name and description. The tag is signed with your configured signing key (see gpg.format and user.signingKey).",
+		NewReleaseTag:                  "New release tag",
+		NewReleaseTagTooltip:           "Create a new release tag from the current commit, by bumping the version of the latest semantic version tag (e.g. v1.2.3). The tag description is prefilled with a changelog of the commits since that tag, grouped by conventional commit type. Afterwards you can choose to push the tag to a remote.",
+		ReleaseTagBumpTitle:            "Bump version (latest: {{.latestTag}})",
+		BumpMajorVersion:               "Major",
+		BumpMinorVersion:               "Minor",
+		BumpPatchVersion:               "Patch",
+		BumpPrereleaseVersion:          "Prerelease",
+		PushReleaseTagTitle:            "Push tag '{{.tagName}}' to:",
+		DontPushTag:                    "Don't push",
+		ChangelogBreakingChanges:       "Breaking changes",
+		ChangelogFeatures:              "Features",
+		ChangelogBugFixes:              "Bug fixes",
+		ChangelogPerformance:           "Performance",
+		ChangelogRefactoring:           "Refactoring",
+		ChangelogDocumentation:         "Documentation",
+		ChangelogTests:                 "Tests",
+		ChangelogBuild:                 "Build",
+		ChangelogChores:                "Chores",
+		ChangelogOtherChanges:          "Other changes",
+		ChangelogDirectCommits:         "Other commits",
+		ChangelogContributors:          "Contributors",
+		ChangelogTitle:                 "Changes from {{.from}} to {{.to}}",
+		ChangelogTitleWithoutStart:     "Changes up to {{.to}}",
+		GenerateChangelog:              "Generate changelog",
+		GenerateChangelogTooltip:       "Generate release notes for the commits between another ref (e.g. the previous release tag) and the selected item. Commits are grouped by conventional commit type or by the pull request that merged them, and contributors are listed with their number of commits. The result can be shown in the main view, copied to the clipboard or written to a file.",
+		ChangelogFromRefTitle:          "Changelog for '{{.to}}' starting from:",
+		ChangelogGroupingTitle:         "Group commits",
+		ChangelogGroupByType:           "By conventional commit type",
+		ChangelogGroupByPullRequest:    "By pull request",
+		GeneratingChangelogStatus:      "Generating changelog",
+		ChangelogOutputTitle:           "Changelog",
+		ChangelogShowInMainView:        "Show in main view",
+		ChangelogWriteToFile:           "Write to file",
+		ChangelogFilePathTitle:         "Write changelog to file:",
+		ChangelogCopiedToClipboard:     "Changelog copied to clipboard",
+		ChangelogWrittenToFile:         "Changelog written to '{{.path}}'",
+		ChangelogMainTitle:             "Changelog {{.from}}..{{.to}}",
 		CreatingTag:                    "Creating tag",
 		ForceTag:                       "Force Tag",
 		ForceTagPrompt:                 "The tag '{{.tagName}}' exists already. Press {{.cancelKey}} to cancel, or {{.confirmKey}} to overwrite.",
 		FetchRemoteTooltip:             "Fetch updates from the remote repository. This retrieves new commits and branches without merging them into your local branches.",
 		FetchingRemoteStatus:           "Fetching remote",
+		ViewFetchOptions:               "View fetch options",
+		ViewFetchOptionsTooltip:        "Fetch the remote with different options than the ones configured for it in git.remoteFetch, and show which branches and tags were created, updated or pruned.",
+		FetchOptionsTitle:              "Fetch {{.remote}}",
+		FetchWithConfiguredOptions:     "Fetch with configured options",
+		FetchWithOptionsTooltip:        "Fetch using the options configured for this remote in git.remoteFetch.",
+		FetchAndPrune:                  "Fetch and prune",
+		FetchAndPruneTooltip:           "Also delete remote-tracking branches that no longer exist on the remote.",
+		FetchAndPruneTags:              "Fetch and prune branches and tags",
+		FetchAndPruneTagsTooltip:       "Also delete remote-tracking branches and local tags that no longer exist on the remote.",
+		FetchAllTags:                   "Fetch all tags",
+		FetchWithoutTags:               "Fetch without tags",
+		FetchWithDepth:                 "Fetch limited history",
+		FetchWithDepthTooltip:          "Only fetch the given number of commits from the tip of each branch.",
+		FetchDepthPrompt:               "Number of commits to fetch",
+		InvalidFetchDepth:              "The number of commits must be a positive number",
+		FetchRefspecs:                  "Fetch refspecs",
+		FetchRefspecsTooltip:           "Fetch the given space-separated refspecs instead of the ones configured for the remote, e.g. 'main' or 'refs/heads/release/*:refs/remotes/origin/release/*'.",
+		FetchRefspecsPrompt:            "Refspecs to fetch",
+		RemoteAlreadyUpToDate:          "{{.remote}} is already up to date",
+		FetchReportTitle:               "Fetched {{.remote}}",
+		FetchedRefCreated:              "new",
+		FetchedRefUpdated:              "updated",
+		FetchedRefForcedUpdate:         "forced update",
+		FetchedRefPruned:               "pruned",
+		FetchedRefRejected:             "rejected",
 		CheckoutCommit:                 "Checkout commit",
 		CheckoutCommitTooltip:          "Checkout the selected commit as a detached HEAD.",
 		NoBranchesFoundAtCommitTooltip: "No branches found at selected commit.",
@@ -1608,6 +2069,40 @@ func EnglishTranslationSet() *TranslationSet {
 		KeybindingsLegend:                "Legend: `<c-b>` means ctrl+b, `<a-b>` means alt+b, `B` means shift+b",
 		RenameBranch:                     "Rename branch",
 		BranchUpstreamOptionsTitle:       "Upstream options",
+		BranchesUpstreamOptionsTitle:     "Upstream options for {{.count}} branches",
+		PushSelectedBranches:             "Push selected branches",
+		PushSelectedBranchesTooltip:      "Push each of the selected branches to its upstream branch, all at the same time.",
+		FastForwardSelectedBranches:      "Fast-forward selected branches",
+		FastForwardBranchesTooltip:       "Fast-forward each of the selected branches from its upstream branch, all at the same time.",
+		SetUpstreamOfSelectedBranches:    "Set upstream of selected branches",
+		SetUpstreamOfBranchesTooltip:     "Set the upstream of each of the selected branches to the branch with the same name on a remote. Branches that don't exist on the remote yet are pushed there.",
+		SetUpstreamOfBranchesPrompt:      "Remote to track for {{.count}} branches",
+		CleanUpStaleBranches:             "Clean up stale branches",
+		CleanUpStaleBranchesTooltip:      "Find local branches that have been merged into a main branch, whose upstream has been deleted, or which have not been committed to for a long time, and delete them in bulk. Also lets you prune remote-tracking branches that no longer exist on their remote.",
+		FindingStaleBranchesStatus:       "Finding stale branches",
+		StaleBranchCleanupTitle:          "Stale branches",
+		StaleBranchesMerged:              "Merged into a main branch",
+		StaleBranchesUpstreamGone:        "Upstream deleted on remote",
+		StaleBranchesOlderThan:           "No commits in the last {{.days}} days",
+		StaleBranchAgeDisabled:           "Disabled because git.staleBranchAgeDays is 0",
+		AllStaleBranches:                 "All of the above",
+		NoStaleBranchesFound:             "No stale branches found",
+		StaleBranchMerged:                "merged",
+		StaleBranchUpstreamGone:          "upstream gone",
+		StaleBranchOld:                   "last commit {{.days}} days ago",
+		DeleteStaleBranchesTitle:         "Delete {{.count}} stale branches",
+		DeleteStaleBranchesPrompt:        "The following commands will be run:\n\n{{.commands}}\n\nAre you sure?",
+		StaleBranchesForceDeleteWarning:  "Warning: {{.count}} of these branches are not merged into a main branch. They will be force-deleted (git branch -D), and any commits that only exist on them will be lost.",
+		DeleteStaleLocalAndRemoteTooltip: "Also delete the upstream branch on the remote, for those branches whose upstream still exists.",
+		PruneRemoteTrackingBranches:      "Prune remote-tracking branches",
+		PruneTrackingBranchesTooltip:     "Delete the remote-tracking branches whose branch no longer exists on the remote (git remote prune).",
+		PruneTrackingBranchesPrompt:      "The following remote-tracking branches no longer exist on their remote and will be deleted:\n\n{{.branches}}\n\nAre you sure?",
+		NoRemoteTrackingBranchesToPrune:  "No remote-tracking branches to prune",
+		PruningStatus:                    "Pruning",
+		BranchHasNoUpstream:              "Branch has no upstream",
+		RemoteNotFound:                   "Remote '{{.remote}}' not found",
+		BranchOperationsSucceeded:        "{{.action}}: all {{.count}} branches succeeded",
+		BranchOperationsFailedTitle:      "{{.action}}: {{.failed}} of {{.total}} branches failed",
 		ViewBranchUpstreamOptions:        "View upstream options",
 		ViewBranchUpstreamOptionsTooltip: "View options relating to the branch's upstream e.g. setting/unsetting the upstream and resetting to the upstream.",
 		UpstreamNotSetError:              "The selected branch has no upstream (or the upstream is not stored locally)",
@@ -1637,6 +2132,13 @@ func EnglishTranslationSet() *TranslationSet {
 		ExitFilterMode:                   "Stop filtering",
 		FilterPathOption:                 "Enter path to filter by",
 		FilterAuthorOption:               "Enter author to filter by",
+		ShowAuthorStats:                  "Show author statistics",
+		ShowAuthorStatsTooltip:           "Show per-author statistics for the loaded commits: number of commits, lines added and removed, date of the first and last commit, and the top-level directories touched. Select an author to filter the commits by them.",
+		AuthorStatsTitle:                 "Authors",
+		AuthorStatsCommitCount:           "{{.count}} commits",
+		AuthorStatsOneCommit:             "1 commit",
+		AuthorStatsItemTooltip:           "Filter commits by '{{.author}}'.\n\nDirectories touched: {{.directories}}",
+		LoadingAuthorStatsStatus:         "Loading author statistics",
 		EnterFileName:                    "Enter path:",
 		EnterAuthor:                      "Enter author:",
 		FilteringMenuTitle:               "Filtering",
@@ -1695,6 +2197,11 @@ func EnglishTranslationSet() *TranslationSet {
 		RemoveSubmodule:                          "Remove submodule",
 		RemoveSubmodulePrompt:                    "Are you sure you want to remove submodule '%s' and its corresponding directory? This is irreversible.",
 		RemoveSubmoduleTooltip:                   "Remove the selected submodule and its corresponding directory.",
+		SubmoduleNotInitialized:                  "not initialized",
+		SubmoduleHasMergeConflicts:               "merge conflicts",
+		SubmoduleInSync:                          "checked-out commit matches the recorded one",
+		SubmoduleDrift:                           "checked-out commit differs from the recorded one",
+		SubmoduleDirty:                           "uncommitted changes",
 		ResettingSubmoduleStatus:                 "Resetting submodule",
 		NewSubmoduleName:                         "New submodule name:",
 		NewSubmoduleUrl:                          "New submodule URL:",
@@ -1715,6 +2222,20 @@ func EnglishTranslationSet() *TranslationSet {
 		BulkDeinitSubmodules:                     "Bulk deinit submodules",
 		ViewBulkSubmoduleOptions:                 "View bulk submodule options",
 		BulkSubmoduleOptions:                     "Bulk submodule options",
+		ParallelInitSubmodules:                   "Init all submodules recursively (in parallel)",
+		ParallelUpdateSubmodules:                 "Update all submodules recursively (in parallel)",
+		ParallelSyncSubmodules:                   "Sync all submodule URLs recursively (in parallel)",
+		ParallelFetchSubmodules:                  "Fetch all submodules recursively (in parallel)",
+		ParallelSubmoduleJobs:                    "{{.jobs}} at a time",
+		InitializingSubmodulesStatus:             "Initializing submodules",
+		UpdatingSubmodulesStatus:                 "Updating submodules",
+		SyncingSubmodulesStatus:                  "Syncing submodules",
+		FetchingSubmodulesStatus:                 "Fetching submodules",
+		SubmoduleJobsProgress:                    "{{.done}} of {{.total}} submodules done, {{.failed}} failed",
+		SubmoduleJobsSucceeded:                   "All {{.count}} submodules succeeded",
+		SubmoduleJobSkippedNotInitialized:        "(skipped: not initialized)",
+		SubmoduleJobsFailedTitle:                 "{{.failed}} of {{.total}} submodules failed",
+		SubmoduleJobsFailedPrompt:                "{{.failures}}\n\nRetry the failed submodules?",
 		RunningCommand:                           "Running command",
 		SubCommitsTitle:                          "Sub-commits",
 		SubmodulesTitle:                          "Submodules",
@@ -1743,11 +2264,49 @@ func EnglishTranslationSet() *TranslationSet {
 		ErrWorktreeMovedOrRemoved:                "Cannot find worktree. It might have been moved or removed ¯\\_(ツ)_/¯",
 		ToggleShowCommandLog:                     "Toggle show/hide command log",
 		FocusCommandLog:                          "Focus command log",
+		FocusCommandLogEntries:                   "Browse commands",
+		CommandLogEntriesTitle:                   "Commands",
+		CommandLogNoOutput:                       "(no output)",
+		CommandLogEntryTitle:                     "Command",
+		CommandLogExitCode:                       "Exit code",
+		CommandLogDuration:                       "Duration",
+		CommandLogStillRunning:                   "Still running",
+		CommandLogNotACommand:                    "This was done by lazygit directly, without running a command.",
+		ToggleCommandOutput:                      "Expand/collapse output",
+		ToggleCommandOutputTooltip:               "Show the full output of the command below it.",
+		CopyCommandToClipboard:                   "Copy command to clipboard",
+		CommandCopiedToClipboard:                 "Command copied to clipboard",
+		RerunCommand:                             "Re-run command",
+		RerunCommandTooltip:                      "Run the command again in a subprocess. Note that environment variables that lazygit set for the original command are not set again.",
+		RerunCommandPrompt:                       "Are you sure you want to run this command again?\n\n{{command}}",
+		CannotRerunNonCommand:                    "Only commands can be re-run.",
+		CannotRerunUnfinishedCommand:             "Only commands that have finished can be re-run.",
+		CannotRerunCommandUsedDaemon:             "lazygit drove this command itself (e.g. by editing the todo list of an interactive rebase), so running it again won't do the same.",
+		ExportCommandLogScript:                   "Export session as shell script",
+		ExportCommandLogScriptTooltip:            "Export the commands that lazygit ran in this repo during this session as a shell script, with the actions they were run for as comments. Read-only commands such as the ones lazygit uses for loading its data are left out. Useful for reviewing what happened, or for replaying the same sequence outside of lazygit.",
+		NoCommandsInThisSession:                  "lazygit hasn't run any commands in this repo in this session.",
+		CommandLogScriptTitle:                    "Session shell script",
+		CommandLogScriptShowInMainView:           "Show in main view",
+		CommandLogScriptWriteToFile:              "Write to file",
+		CommandLogScriptFilePathTitle:            "Write shell script to file:",
+		CommandLogScriptWrittenToFile:            "Shell script written to '{{path}}'",
+		CommandLogScriptCopiedToClipboard:        "Shell script copied to clipboard",
+		CommandLogScriptHeader:                   "Git commands that lazygit ran in {{repo}} in the session started at {{time}}",
+		CommandLogScriptWarning:                  "Review the commands before running them. Commands that lazygit drove itself\n(e.g. interactive rebases, where lazygit edits the todo list) are commented out,\nbecause they won't do the same when run from this script.",
+		CommandLogScriptNotACommand:              "Not a shell command: {{description}}",
+		CommandLogScriptFailed:                   "Failed with exit code {{exitCode}}:",
+		CommandLogScriptUnfinished:               "Did not finish:",
+		CommandLogScriptUsedDaemon:               "Driven by lazygit, won't do the same when run from here:",
 		CommandLogHeader:                         "You can hide/focus this panel by pressing '%s'\n",
 		RandomTip:                                "Random tip",
 		SelectParentCommitForMerge:               "Select parent commit for merge",
 		ToggleWhitespaceInDiffView:               "Toggle whitespace",
 		ToggleWhitespaceInDiffViewTooltip:        "Toggle whether or not whitespace changes are shown in the diff view.",
+		ClearCredentialCache:                     "Clear cached credentials",
+		ClearCredentialCacheTooltip:              "Forget the credentials that were cached during this session, so that you are asked for them again the next time they are needed.",
+		CredentialCacheDisabled:                  "Credentials are only cached if git.cacheCredentials is enabled in your config.",
+		NoCachedCredentials:                      "No credentials are cached.",
+		ClearedCachedCredentials:                 "Cleared {{count}} cached credential(s)",
 		IgnoreWhitespaceDiffViewSubTitle:         "(ignoring whitespace)",
 		IgnoreWhitespaceNotSupportedHere:         "Ignoring whitespace is not supported in this view",
 		IncreaseContextInDiffView:                "Increase diff context size",
@@ -1770,6 +2329,7 @@ func EnglishTranslationSet() *TranslationSet {
 		LoadingFileSuggestions:                   "Loading file suggestions",
 		LoadingCommits:                           "Loading commits",
 		MustSpecifyOriginError:                   "Must specify a remote if specifying a branch",
+		MustSpecifyBranchForLeaseError:           "Must specify a remote branch if specifying the commit it is expected to be at",
 		GitOutput:                                "Git output:",
 		GitCommandFailed:                         "Git command failed. Check command log for details (open with %s)",
 		AbortTitle:                               "Abort %s",
@@ -1783,8 +2343,23 @@ func EnglishTranslationSet() *TranslationSet {
 		SortAlphabetical:                         "Alphabetical",
 		SortByDate:                               "Date",
 		SortByRecency:                            "Recency",
+		SortByVersion:                            "Version",
 		SortBasedOnReflog:                        "(based on reflog)",
 		SortCommits:                              "Commit sort order",
+		FetchMoreHistory:                         "Fetch more history",
+		FetchMoreHistoryTooltip:                  "This is a shallow clone, so the commit history stops at the commits marked as shallow clone boundary. Fetch more of the history from the remote.",
+		NotAShallowClone:                         "This repository is not a shallow clone.",
+		DeepenHistoryByCommits:                   "Deepen by number of commits",
+		DeepenHistoryByCommitsTooltip:            "Fetch the given number of additional commits beyond the current shallow boundary.",
+		DeepenHistorySince:                       "Deepen since date",
+		DeepenHistorySinceTooltip:                "Fetch all commits newer than the given date. The date can be anything git understands, e.g. 2024-01-31 or \"3 months ago\".",
+		DeepenHistorySincePrompt:                 "Fetch history since",
+		UnshallowHistory:                         "Fetch full history (unshallow)",
+		UnshallowHistoryTooltip:                  "Fetch the complete history, turning the shallow clone into a regular one. This may take a long time for large repositories.",
+		FetchingHistoryStatus:                    "Fetching history",
+		ShallowCloneBoundary:                     "shallow clone boundary",
+		ShallowClone:                             "shallow clone",
+		PartialClone:                             "partial clone",
 		CantChangeContextSizeError:               "Cannot change context while in patch building mode because we were too lazy to support it when releasing the feature. If you really want it, please let us know!",
 		OpenCommitInBrowser:                      "Open commit in browser",
 		ViewBisectOptions:                        "View bisect options",
@@ -1848,6 +2423,32 @@ func EnglishTranslationSet() *TranslationSet {
 		NoWorktreesThisRepo:                      "No worktrees",
 		MissingWorktree:                          "(missing)",
 		MainWorktree:                             "(main)",
+		WorktreeChangedFileCount:                 "{{.count}} changed",
+		LockedWorktree:                           "(locked)",
+		LockReason:                               "Lock reason",
+		LockWorktree:                             "Lock worktree",
+		LockWorktreeTooltip:                      "Lock the selected worktree so that it won't be pruned, moved or removed. Useful for worktrees on removable or network drives.",
+		LockWorktreeReasonTitle:                  "Reason for locking (optional)",
+		UnlockWorktree:                           "Unlock worktree",
+		UnlockWorktreeTooltip:                    "Unlock the selected worktree so that it can be pruned, moved or removed again.",
+		CantLockMainWorktree:                     "The main worktree cannot be locked",
+		MoveWorktree:                             "Move worktree",
+		MoveWorktreeTooltip:                      "Move the selected worktree to a new directory.",
+		MoveWorktreePathTitle:                    "New path for worktree '{{.worktreeName}}'",
+		MovingWorktree:                           "Moving worktree",
+		CantMoveMainWorktree:                     "The main worktree cannot be moved",
+		CantMoveCurrentWorktree:                  "You cannot move the current worktree. Switch to another worktree first.",
+		CantMoveMissingWorktree:                  "The worktree's directory doesn't exist. Use 'Repair worktree' to tell git where it went.",
+		CantMoveLockedWorktree:                   "The worktree is locked. Unlock it before moving it.",
+		PruneWorktrees:                           "Prune stale worktrees",
+		PruneWorktreesTooltip:                    "Remove the metadata of worktrees whose directory no longer exists. Locked worktrees are kept.",
+		PruneWorktreesPrompt:                     "Are you sure you want to prune these worktrees? Their directories no longer exist.\n\n{{.worktrees}}",
+		PruningWorktrees:                         "Pruning worktrees",
+		NoPrunableWorktrees:                      "There are no unlocked worktrees with a missing directory",
+		RepairWorktree:                           "Repair worktree",
+		RepairWorktreeTooltip:                    "Repair the links between the selected worktree and the repository. If the worktree's directory was moved without using lazygit or git, you will be asked for its new location.",
+		RepairWorktreeNewPathTitle:               "New location of worktree '{{.worktreeName}}'",
+		RepairingWorktree:                        "Repairing worktree",
 		NewWorktree:                              "New worktree",
 		NewWorktreePath:                          "New worktree path",
 		NewWorktreeBase:                          "New worktree base ref",
@@ -1916,6 +2517,10 @@ func EnglishTranslationSet() *TranslationSet {
 			SquashAllAboveFixupCommits:       "Squash all above fixup commits",
 			CreateLightweightTag:             "Create lightweight tag",
 			CreateAnnotatedTag:               "Create annotated tag",
+			CreateSignedTag:                  "Create signed tag",
+			CreateReleaseTag:                 "Create release tag",
+			CopyChangelogToClipboard:         "Copy changelog to clipboard",
+			WriteChangelogToFile:             "Write changelog to file",
 			CopyCommitMessageToClipboard:     "Copy commit message to clipboard",
 			CopyCommitMessageBodyToClipboard: "Copy commit message body to clipboard",
 			CopyCommitSubjectToClipboard:     "Copy commit subject to clipboard",
@@ -1929,6 +2534,9 @@ func EnglishTranslationSet() *TranslationSet {
 			MoveCommitUp:                     "Move commit up",
 			MoveCommitDown:                   "Move commit down",
 			CustomCommand:                    "Custom command",
+			RerunCommand:                     "Re-run command",
+			CopyCommandLogScriptToClipboard:  "Copy session shell script to clipboard",
+			WriteCommandLogScriptToFile:      "Write session shell script to file",
 
 			// TODO: remove
 			DiscardAllChangesInDirectory:      "Discard all changes in directory",
@@ -1950,6 +2558,8 @@ func EnglishTranslationSet() *TranslationSet {
 			Commit:                          "Commit",
 			EditFile:                        "Edit file",
 			Push:                            "Push",
+			PushCommitToRemoteRef:           "Push commit to remote ref",
+			PushBranchesAtomically:          "Push branches atomically",
 			Pull:                            "Pull",
 			OpenFile:                        "Open file",
 			StashAllChanges:                 "Stash all changes",
@@ -1981,6 +2591,16 @@ func EnglishTranslationSet() *TranslationSet {
 			BulkInitialiseSubmodules:        "Bulk initialise submodules",
 			BulkUpdateSubmodules:            "Bulk update submodules",
 			BulkDeinitialiseSubmodules:      "Bulk deinitialise submodules",
+			ParallelInitSubmodules:          "Initialise submodules in parallel",
+			ParallelUpdateSubmodules:        "Update submodules in parallel",
+			ParallelSyncSubmodules:          "Sync submodules in parallel",
+			ParallelFetchSubmodules:         "Fetch submodules in parallel",
+			FetchRemote:                     "Fetch remote",
+			CloneRepository:                 "Clone repository",
+			SetFsmonitor:                    "Set core.fsmonitor",
+			SetUntrackedCache:               "Set core.untrackedCache",
+			DeepenHistory:                   "Deepen history",
+			UnshallowHistory:                "Fetch full history",
 			UpdateSubmodule:                 "Update submodule",
 			DeleteLocalTag:                  "Delete local tag",
 			DeleteRemoteTag:                 "Delete remote tag",
@@ -1993,6 +2613,11 @@ func EnglishTranslationSet() *TranslationSet {
 			MixedReset:                      "Mixed reset",
 			HardReset:                       "Hard reset",
 			FastForwardBranch:               "Fast forward branch",
+			FastForwardBranches:             "Fast-forward branches",
+			PushBranches:                    "Push branches",
+			DeleteStaleBranches:             "Delete stale branches",
+			PruneRemoteTrackingBranches:     "Prune remote-tracking branches",
+			SetUpstreamOfBranches:           "Set upstream of branches",
 			Undo:                            "Undo",
 			Redo:                            "Redo",
 			CopyPullRequestURL:              "Copy pull request URL",
@@ -2006,6 +2631,11 @@ func EnglishTranslationSet() *TranslationSet {
 			BisectMark:                      "Bisect mark",
 			RemoveWorktree:                  "Remove worktree",
 			AddWorktree:                     "Add worktree",
+			LockWorktree:                    "Lock worktree",
+			UnlockWorktree:                  "Unlock worktree",
+			MoveWorktree:                    "Move worktree",
+			PruneWorktrees:                  "Prune worktrees",
+			RepairWorktree:                  "Repair worktree",
 		},
 		Bisect: Bisect{
 			Mark:                        "Mark current commit (%s) as %s",
@@ -136,6 +136,10 @@ func (self *Views) CommitDescription() *ViewDriver {
 	return self.regularView("commitDescription")
 }
 
+func (self *Views) CommandLogEntries() *ViewDriver {
+	return self.regularView("commandLogEntries")
+}
+
 func (self *Views) Suggestions() *ViewDriver {
 	return self.regularView("suggestions")
 }
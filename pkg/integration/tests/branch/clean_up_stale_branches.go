@@ -0,0 +1,101 @@
+package branch
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var CleanUpStaleBranches = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Delete branches that are merged, whose upstream is gone, or that are old, and prune remote-tracking branches",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig:  func(config *config.AppConfig) {},
+	SetupRepo: func(shell *Shell) {
+		shell.EmptyCommit("one")
+		shell.CloneIntoRemote("origin")
+		shell.SetBranchUpstream("master", "origin/master")
+
+		shell.NewBranch("merged")
+		shell.PushBranchAndSetUpstream("origin", "merged")
+
+		shell.NewBranch("gone")
+		shell.EmptyCommit("gone")
+		shell.PushBranchAndSetUpstream("origin", "gone")
+		shell.RunCommand([]string{"git", "push", "origin", "--delete", "gone"})
+
+		shell.Checkout("master")
+		shell.NewBranch("old")
+		shell.EmptyCommitWithDate("old", "2020-01-01 00:00:00")
+
+		shell.Checkout("master")
+		shell.NewBranch("active")
+		shell.EmptyCommit("active")
+
+		shell.Checkout("master")
+		shell.NewBranch("deleted-on-remote")
+		shell.PushBranch("origin", "deleted-on-remote")
+		shell.Checkout("master")
+		shell.RunCommand([]string{"git", "branch", "-D", "deleted-on-remote"})
+		shell.RunCommand([]string{"git", "-C", "../origin", "branch", "-D", "deleted-on-remote"})
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Branches().Focus().
+			Press(keys.Branches.CleanUpStaleBranches)
+
+		t.ExpectPopup().Menu().
+			Title(Equals("Stale branches")).
+			TopLines(
+				Contains("Merged into a main branch").Contains("1"),
+				Contains("Upstream deleted on remote").Contains("1"),
+				Contains("No commits in the last 90 days").Contains("1"),
+				Contains("All of the above").Contains("3"),
+				Contains("Prune remote-tracking branches"),
+			).
+			Select(Contains("All of the above")).
+			Confirm()
+
+		t.ExpectPopup().Menu().
+			Title(Equals("Delete 3 stale branches")).
+			Select(Contains("Delete local and remote branches")).
+			Confirm()
+
+		t.ExpectPopup().Confirmation().
+			Title(Equals("Delete 3 stale branches")).
+			Content(
+				Contains("2 of these branches are not merged into a main branch. They will be force-deleted").
+					Contains("git push origin --delete merged").
+					Contains("git branch -D merged").
+					Contains("git branch -D gone").
+					Contains("upstream gone").
+					Contains("git branch -D old").
+					DoesNotContain("git branch -D active"),
+			).
+			Confirm()
+
+		t.Views().Branches().
+			Lines(
+				Contains("master"),
+				Contains("active"),
+			).
+			Press(keys.Branches.CleanUpStaleBranches)
+
+		t.ExpectPopup().Menu().
+			Title(Equals("Stale branches")).
+			Select(Contains("Prune remote-tracking branches")).
+			Confirm()
+
+		t.ExpectPopup().Confirmation().
+			Title(Equals("Prune remote-tracking branches")).
+			Content(Contains("origin/deleted-on-remote")).
+			Confirm()
+
+		t.Views().Remotes().Focus().
+			Lines(Contains("origin")).
+			PressEnter()
+
+		t.Views().RemoteBranches().IsFocused().
+			Lines(
+				Contains("master"),
+			)
+	},
+})
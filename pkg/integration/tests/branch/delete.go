@@ -82,7 +82,10 @@ var Delete = NewIntegrationTest(NewIntegrationTestArgs{
 				t.ExpectPopup().
 					Confirmation().
 					Title(Equals("Force delete branch")).
-					Content(Equals("'branch-four' is not fully merged. Are you sure you want to delete it?")).
+					Content(MatchesRegexp(`^'branch-four' is not fully merged. Are you sure you want to delete it\?
+
+These commits will no longer be reachable from any branch, tag or remote branch:
+  [0-9a-f]+ on branch-four 02$`)).
 					Confirm()
 			}).
 			Lines(
@@ -195,7 +198,8 @@ var Delete = NewIntegrationTest(NewIntegrationTestArgs{
 					Confirmation().
 					Title(Equals("Delete local and remote branch")).
 					Content(Contains("Are you sure you want to delete both 'branch-six' from your machine, and 'branch-six' from 'origin'?").
-						Contains("'branch-six' is not fully merged. Are you sure you want to delete it?")).
+						Contains("'branch-six' is not fully merged. Are you sure you want to delete it?").
+						Contains("These commits will no longer be reachable from any branch, tag or remote branch:")).
 					Confirm()
 			}).
 			Lines(
@@ -216,7 +220,12 @@ var Delete = NewIntegrationTest(NewIntegrationTestArgs{
 				t.ExpectPopup().
 					Confirmation().
 					Title(Equals("Delete local and remote branch")).
-					Content(Equals("Are you sure you want to delete both 'branch-five' from your machine, and 'branch-five' from 'origin'?").
+					// branch-five counts as merged because its upstream contains
+					// it, but its commits are lost once we delete the upstream too
+					Content(MatchesRegexp(`^Are you sure you want to delete both 'branch-five' from your machine, and 'branch-five' from 'origin'\?
+
+These commits will no longer be reachable from any branch, tag or remote branch:
+  [0-9a-f]+ on branch-five 01$`).
 						DoesNotContain("not fully merged")).
 					Confirm()
 			}).
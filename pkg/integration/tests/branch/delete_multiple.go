@@ -86,7 +86,10 @@ var DeleteMultiple = NewIntegrationTest(NewIntegrationTestArgs{
 				t.ExpectPopup().
 					Confirmation().
 					Title(Equals("Force delete branch")).
-					Content(Equals("Some of the selected branches are not fully merged. Are you sure you want to delete them?")).
+					Content(MatchesRegexp(`^Some of the selected branches are not fully merged. Are you sure you want to delete them\?
+
+These commits will no longer be reachable from any branch, tag or remote branch:
+  [0-9a-f]+ on branch-04 02$`)).
 					Confirm()
 			}).
 			Lines(
@@ -165,7 +168,8 @@ var DeleteMultiple = NewIntegrationTest(NewIntegrationTestArgs{
 					Confirmation().
 					Title(Equals("Delete local and remote branch")).
 					Content(Contains("Are you sure you want to delete both the selected branches from your machine, and their remote branches from their respective remotes?").
-						Contains("Some of the selected branches are not fully merged. Are you sure you want to delete them?")).
+						Contains("Some of the selected branches are not fully merged. Are you sure you want to delete them?").
+						Contains("These commits will no longer be reachable from any branch, tag or remote branch:")).
 					Confirm()
 			}).
 			Lines(
@@ -0,0 +1,69 @@
+package branch
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var DeleteProtectedBranch = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Deleting a protected branch is blocked, also when it's one of several selected branches",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig: func(config *config.AppConfig) {
+		config.GetUserConfig().Git.ProtectedBranches.Patterns = []string{"release/*"}
+	},
+	SetupRepo: func(shell *Shell) {
+		shell.
+			EmptyCommit("blah").
+			NewBranch("release/1.0").
+			NewBranch("feature").
+			Checkout("master")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Branches().
+			Focus().
+			Lines(
+				Contains("master").IsSelected(),
+				Contains("feature"),
+				Contains("release/1.0"),
+			).
+			NavigateToLine(Contains("release/1.0")).
+			Press(keys.Universal.Remove).
+			Tap(func() {
+				t.ExpectPopup().
+					Menu().
+					Title(Equals("Delete branch 'release/1.0'?")).
+					Select(Contains("Delete local branch")).
+					Confirm()
+
+				t.ExpectPopup().Alert().
+					Title(Equals("Error")).
+					Content(Equals("Can't delete 'release/1.0' because it is a protected branch (see the 'git.protectedBranches' config)")).
+					Confirm()
+			}).
+			Lines(
+				Contains("master"),
+				Contains("feature"),
+				Contains("release/1.0").IsSelected(),
+			).
+			Press(keys.Universal.RangeSelectUp).
+			Press(keys.Universal.Remove).
+			Tap(func() {
+				t.ExpectPopup().
+					Menu().
+					Title(Equals("Delete selected branches?")).
+					Select(Contains("Delete local branches")).
+					Confirm()
+
+				t.ExpectPopup().Alert().
+					Title(Equals("Error")).
+					Content(Contains("Can't delete 'release/1.0'")).
+					Confirm()
+			}).
+			Lines(
+				Contains("master"),
+				Contains("feature").IsSelected(),
+				Contains("release/1.0").IsSelected(),
+			)
+	},
+})
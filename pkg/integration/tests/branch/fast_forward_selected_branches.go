@@ -0,0 +1,52 @@
+package branch
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var FastForwardSelectedBranches = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Fast-forward several selected branches at once",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig:  func(config *config.AppConfig) {},
+	SetupRepo: func(shell *Shell) {
+		shell.EmptyCommit("one")
+		shell.NewBranch("branch-a")
+		shell.NewBranch("branch-b")
+		shell.Checkout("master")
+
+		shell.CloneIntoRemote("origin")
+		shell.SetBranchUpstream("branch-a", "origin/branch-a")
+		shell.SetBranchUpstream("branch-b", "origin/branch-b")
+
+		// put a new commit on each remote branch, without having it locally
+		for _, branch := range []string{"branch-b", "branch-a"} {
+			shell.Checkout(branch)
+			shell.EmptyCommit("new on " + branch)
+			shell.RunCommand([]string{"git", "push", "origin", branch})
+			shell.HardReset("HEAD^")
+		}
+		shell.Checkout("master")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Branches().Focus().
+			Lines(
+				Contains("master").IsSelected(),
+				Contains("branch-a ↓1"),
+				Contains("branch-b ↓1"),
+			).
+			SelectNextItem().
+			Press(keys.Universal.RangeSelectDown).
+			Press(keys.Branches.FastForward)
+
+		t.ExpectToast(Equals("Fast-forward branches: all 2 branches succeeded"))
+
+		t.Views().Branches().
+			Lines(
+				Contains("master"),
+				Contains("branch-a ✓"),
+				Contains("branch-b ✓"),
+			)
+	},
+})
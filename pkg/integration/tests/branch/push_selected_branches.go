@@ -0,0 +1,98 @@
+package branch
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var PushSelectedBranches = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Push several selected branches at once, then set the upstream of the ones that failed",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig:  func(config *config.AppConfig) {},
+	SetupRepo: func(shell *Shell) {
+		shell.EmptyCommit("one")
+		shell.NewBranch("branch-a")
+		shell.NewBranch("branch-b")
+		shell.Checkout("master")
+
+		shell.CloneIntoRemote("origin")
+		shell.SetBranchUpstream("branch-a", "origin/branch-a")
+		shell.SetBranchUpstream("branch-b", "origin/branch-b")
+
+		shell.NewBranch("branch-c")
+		shell.Checkout("branch-b")
+		shell.EmptyCommit("b")
+		shell.Checkout("branch-a")
+		shell.EmptyCommit("a")
+		shell.Checkout("master")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Branches().Focus().
+			Lines(
+				Contains("master").IsSelected(),
+				Contains("branch-a ↑1"),
+				Contains("branch-b ↑1"),
+				Contains("branch-c"),
+			).
+			SelectNextItem().
+			Press(keys.Universal.RangeSelectDown).
+			Press(keys.Universal.RangeSelectDown).
+			Press(keys.Branches.SetUpstream)
+
+		t.ExpectPopup().Menu().
+			Title(Equals("Upstream options for 3 branches")).
+			Select(Contains("Push selected branches")).
+			Confirm()
+
+		t.ExpectPopup().Alert().
+			Title(Equals("Push branches: 1 of 3 branches failed")).
+			Content(
+				Contains("✓ branch-a").
+					Contains("✓ branch-b").
+					Contains("✗ branch-c: Branch has no upstream"),
+			).
+			Confirm()
+
+		t.Views().Branches().
+			Lines(
+				Contains("master"),
+				Contains("branch-a ✓").IsSelected(),
+				Contains("branch-b ✓").IsSelected(),
+				Contains("branch-c").IsSelected(),
+			).
+			Press(keys.Branches.SetUpstream)
+
+		t.ExpectPopup().Menu().
+			Title(Equals("Upstream options for 3 branches")).
+			Select(Contains("Set upstream of selected branches")).
+			Confirm()
+
+		t.ExpectPopup().Prompt().
+			Title(Equals("Remote to track for 3 branches")).
+			InitialText(Equals("origin")).
+			Confirm()
+
+		t.ExpectToast(Equals("Set upstream of branches: all 3 branches succeeded"))
+
+		t.Views().Branches().
+			Lines(
+				Contains("master"),
+				Contains("branch-a ✓"),
+				Contains("branch-b ✓"),
+				Contains("branch-c ✓"),
+			)
+
+		t.Views().Remotes().Focus().
+			Lines(Contains("origin")).
+			PressEnter()
+
+		t.Views().RemoteBranches().IsFocused().
+			Lines(
+				Contains("branch-a"),
+				Contains("branch-b"),
+				Contains("branch-c"),
+				Contains("master"),
+			)
+	},
+})
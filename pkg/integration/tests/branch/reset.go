@@ -40,6 +40,14 @@ var Reset = NewIntegrationTest(NewIntegrationTestArgs{
 			Select(Contains("Hard reset")).
 			Confirm()
 
+		t.ExpectPopup().Confirmation().
+			Title(Equals("Hard reset")).
+			Content(MatchesRegexp(`^Are you sure you want to hard reset to 'other-branch'\?
+
+These commits will no longer be reachable from any branch, tag or remote branch:
+  [0-9a-f]+ current-branch commit$`)).
+			Confirm()
+
 		// assert that we now have the expected commits in the commit panel
 		t.Views().Commits().
 			Lines(
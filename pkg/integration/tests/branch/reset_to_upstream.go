@@ -96,6 +96,14 @@ var ResetToUpstream = NewIntegrationTest(NewIntegrationTestArgs{
 					Title(Equals("Reset to origin/hard-branch")).
 					Select(Contains("Hard reset")).
 					Confirm()
+
+				t.ExpectPopup().Confirmation().
+					Title(Equals("Hard reset")).
+					Content(Equals("Are you sure you want to hard reset to 'origin/hard-branch'?\n\n" +
+						"These uncommitted changes will be discarded:\n" +
+						"  A file-1\n" +
+						"  A file-2")).
+					Confirm()
 			})
 		t.Views().Commits().Lines(Contains("hard commit"))
 		t.Views().Files().IsEmpty()
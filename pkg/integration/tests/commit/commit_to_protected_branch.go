@@ -0,0 +1,83 @@
+package commit
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var CommitToProtectedBranch = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Committing to a protected branch requires typing its name",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig: func(config *config.AppConfig) {
+		config.GetUserConfig().Git.ProtectedBranches.Patterns = []string{"master", "release/*"}
+	},
+	SetupRepo: func(shell *Shell) {
+		shell.EmptyCommit("initial commit")
+		shell.CreateFileAndAdd("myfile", "myfile content")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Files().
+			IsFocused().
+			Press(keys.Files.CommitChanges)
+
+		t.ExpectPopup().Prompt().
+			Title(Equals("Type 'master' to commit to this protected branch")).
+			Type("mastr").
+			Confirm()
+
+		t.ExpectPopup().Alert().
+			Title(Equals("Error")).
+			Content(Equals("You didn't type 'master', so nothing was done")).
+			Confirm()
+
+		t.Views().Files().
+			IsFocused().
+			Press(keys.Files.CommitChanges)
+
+		t.ExpectPopup().Prompt().
+			Title(Equals("Type 'master' to commit to this protected branch")).
+			Type("master").
+			Confirm()
+
+		t.ExpectPopup().CommitMessagePanel().Type("my commit message").Confirm()
+
+		t.Views().Files().
+			IsEmpty()
+
+		t.Views().Commits().
+			Lines(
+				Contains("my commit message"),
+				Contains("initial commit"),
+			)
+
+		// Branches that don't match any pattern aren't protected
+		t.Views().Branches().
+			Focus().
+			Press(keys.Universal.New)
+
+		t.ExpectPopup().Prompt().
+			Title(Contains("New branch name")).
+			Type("feature").
+			Confirm()
+
+		t.Shell().CreateFileAndAdd("otherfile", "otherfile content")
+
+		t.Views().Files().
+			Focus().
+			Press(keys.Universal.Refresh).
+			Lines(
+				Contains("A  otherfile"),
+			).
+			Press(keys.Files.CommitChanges)
+
+		t.ExpectPopup().CommitMessagePanel().Type("feature commit").Confirm()
+
+		t.Views().Commits().
+			Lines(
+				Contains("feature commit"),
+				Contains("my commit message"),
+				Contains("initial commit"),
+			)
+	},
+})
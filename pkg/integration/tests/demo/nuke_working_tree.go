@@ -39,6 +39,12 @@ var NukeWorkingTree = NewIntegrationTest(NewIntegrationTestArgs{
 					Title(Equals("")).
 					Select(Contains("Nuke working tree")).
 					Confirm()
+
+				t.ExpectPopup().Confirmation().
+					Title(Equals("Nuke working tree")).
+					Content(Contains("These untracked files will be deleted:")).
+					Wait(1000).
+					Confirm()
 			})
 	},
 })
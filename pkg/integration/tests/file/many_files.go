@@ -0,0 +1,62 @@
+package file
+
+import (
+	"fmt"
+
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var ManyFiles = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Show a working tree with more files than we load in one go, collapse a directory across a refresh and search for a file that's scrolled out of view",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig:  func(config *config.AppConfig) {},
+	SetupRepo: func(shell *Shell) {
+		shell.CreateDir("big")
+		for i := 0; i < 1200; i++ {
+			shell.CreateFile(fmt.Sprintf("big/file%04d", i), "content")
+		}
+		shell.CreateDir("small")
+		shell.CreateFile("small/file", "content")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Files().
+			IsFocused().
+			TopLines(
+				Contains("▼ big").IsSelected(),
+				Contains("??").Contains("file0000"),
+				Contains("??").Contains("file0001"),
+			).
+			LineCount(EqualsInt(1203)).
+			// collapse the directory
+			PressEnter().
+			Lines(
+				Contains("▶ big").IsSelected(),
+				Contains("▼ small"),
+				Contains("??").Contains("file"),
+			)
+
+		t.Shell().CreateFile("big/file1200", "content")
+
+		t.Views().Files().
+			Press(keys.Files.RefreshFiles).
+			Lines(
+				Contains("▶ big").IsSelected(),
+				Contains("▼ small"),
+				Contains("??").Contains("file"),
+			).
+			// expand it again, which creates the nodes of its files
+			PressEnter().
+			LineCount(EqualsInt(1204)).
+			Press(keys.Universal.StartSearch).
+			Tap(func() {
+				t.ExpectSearch().
+					Type("file1200").
+					Confirm()
+
+				t.Views().Search().IsVisible().Content(Contains("matches for 'file1200' (1 of 1)"))
+			}).
+			SelectedLine(Contains("??").Contains("file1200"))
+	},
+})
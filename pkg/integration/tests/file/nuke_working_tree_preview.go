@@ -0,0 +1,61 @@
+package file
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var NukeWorkingTreePreview = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Nuking the working tree shows which changes and untracked files will be lost before doing it",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig:  func(config *config.AppConfig) {},
+	SetupRepo: func(shell *Shell) {
+		shell.CreateFileAndAdd("file-a", "content")
+		shell.Commit("first commit")
+
+		shell.UpdateFile("file-a", "changed content")
+		shell.CreateFileAndAdd("file-b", "content")
+		shell.CreateFile("dir/file-c", "content")
+		shell.CreateFile("file-d", "content")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Files().
+			IsFocused().
+			Press(keys.Files.ViewResetOptions)
+
+		t.ExpectPopup().Menu().
+			Title(Equals("")).
+			Select(Contains("Nuke working tree")).
+			Confirm()
+
+		t.ExpectPopup().Confirmation().
+			Title(Equals("Nuke working tree")).
+			Content(Equals(
+				"Are you sure you want to discard all changes and delete all untracked files?\n\n" +
+					"These uncommitted changes will be discarded:\n" +
+					"  M file-a\n" +
+					"  A file-b\n\n" +
+					"These untracked files will be deleted:\n" +
+					"  ? dir/\n" +
+					"  ? file-d",
+			)).
+			Confirm()
+
+		t.Views().Files().
+			IsEmpty()
+
+		// nothing left to lose, so there's nothing to confirm
+		t.Views().Files().
+			Press(keys.Files.ViewResetOptions)
+
+		t.ExpectPopup().Menu().
+			Title(Equals("")).
+			Select(Contains("Hard reset")).
+			Confirm()
+
+		t.Views().Files().
+			IsFocused().
+			IsEmpty()
+	},
+})
@@ -0,0 +1,49 @@
+package filter_by_author
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var AuthorStats = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Show per-author statistics and filter by the selected author",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig: func(config *config.AppConfig) {
+		config.GetAppState().GitLogShowGraph = "never"
+	},
+	SetupRepo: func(shell *Shell) {
+		commonSetup(shell)
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Commits().
+			Focus().
+			Press(keys.Universal.FilteringMenu)
+
+		t.ExpectPopup().Menu().
+			Title(Equals("Filtering")).
+			Select(Contains("Show author statistics")).
+			Confirm()
+
+		t.ExpectPopup().Menu().
+			Title(Equals("Authors")).
+			Lines(
+				Contains("Paul Oberstein").Contains("8 commits").Contains("+0").Contains("-0"),
+				Contains("Yang Wen-li").Contains("3 commits"),
+				Contains("Siegfried Kircheis").Contains("1 commit"),
+				Contains("Cancel"),
+			).
+			Select(Contains("Yang Wen-li")).
+			Confirm()
+
+		t.Views().Commits().
+			IsFocused().
+			Lines(
+				Contains("commit 2"),
+				Contains("commit 1"),
+				Contains("commit 0"),
+			)
+
+		t.Views().Information().Content(Contains("Filtering by 'Yang Wen-li <yang.wen-li@email.com>'"))
+	},
+})
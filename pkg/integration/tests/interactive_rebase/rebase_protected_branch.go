@@ -0,0 +1,56 @@
+package interactive_rebase
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var RebaseProtectedBranch = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Starting an interactive rebase of a protected branch requires typing its name, but changing the todos afterwards doesn't",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig: func(config *config.AppConfig) {
+		config.GetUserConfig().Git.ProtectedBranches.Patterns = []string{"master"}
+	},
+	SetupRepo: func(shell *Shell) {
+		shell.EmptyCommit("commit to edit")
+		shell.EmptyCommit("commit to squash")
+		shell.EmptyCommit("last commit")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Commits().
+			Focus().
+			Lines(
+				Contains("last commit"),
+				Contains("commit to squash"),
+				Contains("commit to edit"),
+			).
+			NavigateToLine(Contains("commit to edit")).
+			Press(keys.Universal.Edit).
+			Tap(func() {
+				t.ExpectPopup().Prompt().
+					Title(Equals("Type 'master' to rewrite the commits of this protected branch")).
+					Type("master").
+					Confirm()
+			}).
+			Lines(
+				MatchesRegexp("pick.*last commit"),
+				MatchesRegexp("pick.*commit to squash"),
+				MatchesRegexp("YOU ARE HERE.*commit to edit").IsSelected(),
+			).
+			SelectPreviousItem().
+			Press(keys.Commits.SquashDown).
+			Lines(
+				MatchesRegexp("pick.*last commit"),
+				MatchesRegexp("squash.*commit to squash").IsSelected(),
+				MatchesRegexp("YOU ARE HERE.*commit to edit"),
+			).
+			Tap(func() {
+				t.Common().ContinueRebase()
+			}).
+			Lines(
+				Contains("last commit"),
+				Contains("commit to edit"),
+			)
+	},
+})
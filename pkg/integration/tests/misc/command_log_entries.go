@@ -0,0 +1,90 @@
+package misc
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var CommandLogEntries = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Browse the structured command log, expand an entry's output, filter the entries and re-run a command",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig:  func(config *config.AppConfig) {},
+	SetupRepo: func(shell *Shell) {
+		shell.CreateFileAndAdd("file-a", "content")
+		shell.Commit("first commit")
+		shell.CreateFile("file-b", "content")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Files().
+			IsFocused().
+			Lines(
+				Equals("?? file-b").IsSelected(),
+			).
+			PressPrimaryAction().
+			Lines(
+				Equals("A  file-b").IsSelected(),
+			)
+
+		t.Views().Files().
+			Press(keys.Files.CommitChanges)
+
+		t.ExpectPopup().CommitMessagePanel().
+			Type("second commit").
+			Confirm()
+
+		t.GlobalPress(keys.Universal.ExtrasMenu)
+
+		t.ExpectPopup().Menu().
+			Title(Equals("Command log")).
+			Select(Contains("Browse commands")).
+			Confirm()
+
+		t.Views().CommandLogEntries().
+			IsFocused().
+			Lines(
+				Contains("✓").Contains("Commit").Contains(`git commit -m "second commit"`).IsSelected(),
+				Contains("✓").Contains("Stage file").Contains("git add -- file-b"),
+			)
+
+		t.Views().Main().
+			Content(Contains("Commit")).
+			Content(Contains("Exit code: 0"))
+
+		t.Views().CommandLogEntries().
+			PressEnter().
+			Lines(
+				Contains("Commit").IsSelected(),
+				Contains("second commit"),
+				Contains("1 file changed"),
+				Contains("create mode 100644 file-b"),
+				Contains("Stage file"),
+			).
+			PressEnter().
+			Lines(
+				Contains("Commit").IsSelected(),
+				Contains("Stage file"),
+			).
+			FilterOrSearch("git add").
+			Lines(
+				Contains("Stage file").IsSelected(),
+			)
+
+		// change the file behind lazygit's back, so that there is something to
+		// stage when re-running the command
+		t.Shell().UpdateFile("file-b", "changed content")
+
+		t.Views().CommandLogEntries().
+			Press(keys.CommandLog.RerunCommand)
+
+		t.ExpectPopup().Confirmation().
+			Title(Equals("Re-run command")).
+			Content(Contains("git add -- file-b")).
+			Confirm()
+
+		t.Views().Files().
+			Lines(
+				Equals("M  file-b"),
+			)
+	},
+})
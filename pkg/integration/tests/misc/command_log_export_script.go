@@ -0,0 +1,70 @@
+package misc
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var CommandLogExportScript = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Export the commands that were run in the session as a shell script",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig:  func(config *config.AppConfig) {},
+	SetupRepo: func(shell *Shell) {
+		shell.CreateFileAndAdd("file-a", "content")
+		shell.Commit("first commit")
+		shell.CreateFile("file-b", "content")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Files().
+			IsFocused().
+			PressPrimaryAction().
+			Press(keys.Files.CommitChanges)
+
+		t.ExpectPopup().CommitMessagePanel().
+			Type("second commit").
+			Confirm()
+
+		t.GlobalPress(keys.Universal.ExtrasMenu)
+
+		t.ExpectPopup().Menu().
+			Title(Equals("Command log")).
+			Select(Contains("Export session as shell script")).
+			Confirm()
+
+		t.ExpectPopup().Menu().
+			Title(Equals("Session shell script")).
+			Select(Contains("Show in main view")).
+			Confirm()
+
+		t.Views().Main().
+			Content(Contains("#!/bin/sh")).
+			Content(Contains("# Stage file\ngit add -- file-b\n\n# Commit\ngit commit -m 'second commit'")).
+			Content(DoesNotContain("git status"))
+
+		t.Views().Files().
+			Focus()
+
+		t.GlobalPress(keys.Universal.ExtrasMenu)
+
+		t.ExpectPopup().Menu().
+			Title(Equals("Command log")).
+			Select(Contains("Export session as shell script")).
+			Confirm()
+
+		t.ExpectPopup().Menu().
+			Title(Equals("Session shell script")).
+			Select(Contains("Write to file")).
+			Confirm()
+
+		t.ExpectPopup().Prompt().
+			Title(Equals("Write shell script to file:")).
+			Clear().
+			Type("session.sh").
+			Confirm()
+
+		t.ExpectToast(Equals("Shell script written to 'session.sh'"))
+
+		t.FileSystem().FileContent("session.sh", Contains("git add -- file-b"))
+	},
+})
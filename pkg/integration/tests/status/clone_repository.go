@@ -0,0 +1,67 @@
+package status
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var CloneRepository = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Clone a repository suggested from the current repo's remotes, pick a branch and switch to the clone",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig:  func(config *config.AppConfig) {},
+	SetupRepo: func(shell *Shell) {
+		shell.CreateNCommits(2)
+		shell.NewBranch("feature")
+		shell.EmptyCommit("feature commit")
+		shell.Checkout("master")
+		shell.CloneIntoRemote("origin")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Status().
+			Focus().
+			Press(keys.Status.CloneRepository)
+
+		t.ExpectPopup().Prompt().
+			Title(Equals("Repository URL")).
+			SuggestionLines(Contains("../origin")).
+			ConfirmFirstSuggestion()
+
+		t.ExpectPopup().Prompt().
+			Title(Equals("Clone into directory")).
+			InitialText(Contains("/origin")).
+			Clear().
+			Type("cloned").
+			Confirm()
+
+		t.ExpectPopup().Menu().
+			Title(Equals("Clone ../origin")).
+			Select(Contains("Branch").Contains("default branch")).
+			Confirm()
+
+		t.ExpectPopup().Prompt().
+			Title(Equals("Branch to check out (leave empty for the default branch)")).
+			Type("feature").
+			Confirm()
+
+		t.ExpectPopup().Menu().
+			Title(Equals("Clone ../origin")).
+			TopLines(
+				Contains("Clone").Contains("/cloned"),
+				Contains("Depth").Contains("full history"),
+				Contains("Branch").Contains("feature"),
+				Contains("Filter").Contains("none"),
+			).
+			Select(Contains("Clone")).
+			Confirm()
+
+		t.Views().Status().Content(Contains("cloned → feature"))
+
+		t.Views().Commits().
+			Lines(
+				Contains("feature commit"),
+				Contains("commit 02"),
+				Contains("commit 01"),
+			)
+	},
+})
@@ -0,0 +1,45 @@
+package status
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var PerformanceSettings = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Show the status performance settings in the dashboard and toggle the untracked cache",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig:  func(config *config.AppConfig) {},
+	SetupRepo: func(shell *Shell) {
+		shell.CreateNCommits(1)
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Status().
+			Focus()
+
+		t.Views().Main().
+			Content(Contains("Untracked cache (core.untrackedCache): disabled"))
+
+		t.Views().Status().
+			Press(keys.Status.PerformanceSettings)
+
+		t.ExpectPopup().Menu().
+			Title(Equals("Status performance settings")).
+			Select(Contains("Untracked cache")).
+			Confirm()
+
+		t.Views().Main().
+			Content(Contains("Untracked cache (core.untrackedCache): enabled"))
+
+		t.Views().Status().
+			Press(keys.Status.PerformanceSettings)
+
+		t.ExpectPopup().Menu().
+			Title(Equals("Status performance settings")).
+			Select(Contains("Untracked cache")).
+			Confirm()
+
+		t.Views().Main().
+			Content(Contains("Untracked cache (core.untrackedCache): disabled"))
+	},
+})
@@ -0,0 +1,43 @@
+package submodule
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var ParallelFetch = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Fetch all submodules in parallel, skipping the one that isn't initialized",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig:  func(cfg *config.AppConfig) {},
+	SetupRepo: func(shell *Shell) {
+		shell.EmptyCommit("first commit")
+		shell.CloneIntoSubmodule("sub1", "sub1_path")
+		shell.RunCommand([]string{"git", "submodule", "add", "--name", "sub2", "../other_repo", "sub2_path"})
+		shell.GitAddAll()
+		shell.Commit("add submodules")
+
+		shell.RunCommand([]string{"git", "submodule", "deinit", "--force", "sub2_path"})
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Submodules().Focus().
+			Lines(
+				Contains("sub1").IsSelected(),
+				Contains("sub2 -"),
+			).
+			Press(keys.Submodules.BulkMenu)
+
+		t.ExpectPopup().Menu().
+			Title(Equals("Bulk submodule options")).
+			Select(Contains("Fetch all submodules recursively (in parallel)")).
+			Confirm()
+
+		t.ExpectToast(Equals("All 1 submodules succeeded"))
+
+		t.Views().Main().Content(
+			Contains("2 of 2 submodules done, 0 failed").
+				Contains("✓ sub1_path").
+				Contains("- sub2_path (skipped: not initialized)"),
+		)
+	},
+})
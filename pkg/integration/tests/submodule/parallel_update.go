@@ -0,0 +1,61 @@
+package submodule
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var ParallelUpdate = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Update all submodules in parallel, then retry the one that failed",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig:  func(cfg *config.AppConfig) {},
+	SetupRepo: func(shell *Shell) {
+		shell.EmptyCommit("first commit")
+		shell.CloneIntoSubmodule("sub1", "sub1_path")
+		shell.RunCommand([]string{"git", "submodule", "add", "--name", "sub2", "../other_repo", "sub2_path"})
+		shell.GitAddAll()
+		shell.Commit("add submodules")
+
+		shell.RunCommand([]string{"git", "submodule", "deinit", "--all", "--force"})
+		shell.DeleteFile(".git/modules/sub2")
+		// point sub2 at a repo that doesn't exist so that updating it fails
+		shell.RunCommand([]string{"git", "config", "submodule.sub2.url", "../missing_repo"})
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Submodules().Focus().
+			Lines(
+				Equals("sub1 -").IsSelected(),
+				Equals("sub2 -"),
+			).
+			Press(keys.Submodules.BulkMenu)
+
+		t.ExpectPopup().Menu().
+			Title(Equals("Bulk submodule options")).
+			Select(Contains("Update all submodules recursively (in parallel)")).
+			Confirm()
+
+		t.Views().Main().Content(
+			Contains("2 of 2 submodules done, 1 failed").
+				Contains("✓ sub1_path").
+				Contains("✗ sub2_path"),
+		)
+
+		t.Shell().RunCommand([]string{"git", "config", "submodule.sub2.url", "../other_repo"})
+
+		t.ExpectPopup().Confirmation().
+			Title(Equals("1 of 2 submodules failed")).
+			Content(Contains("- sub2_path:")).
+			Confirm()
+
+		t.ExpectToast(Equals("All 1 submodules succeeded"))
+
+		t.Views().Submodules().
+			Lines(
+				Equals("sub1").IsSelected(),
+				Equals("sub2"),
+			)
+
+		t.Views().Main().Content(Contains("✓ sub2_path"))
+	},
+})
@@ -33,7 +33,8 @@ var RemoveNested = NewIntegrationTest(NewIntegrationTestArgs{
 					Confirm()
 			}).
 			Lines(
-				Equals("outerSubName").IsSelected(),
+				// removing the nested submodule leaves the outer one dirty
+				Equals("outerSubName *").IsSelected(),
 			).
 			Press(keys.Universal.GoInto)
 
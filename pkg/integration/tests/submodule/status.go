@@ -0,0 +1,41 @@
+package submodule
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var Status = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Show markers for a submodule whose checked-out commit differs from the recorded one and which has uncommitted changes, and show the commits in between even though git status ignores the submodule",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig:  func(cfg *config.AppConfig) {},
+	SetupRepo: func(shell *Shell) {
+		shell.EmptyCommit("first commit")
+		shell.CloneIntoSubmodule("my_submodule_name", "my_submodule_path")
+		shell.RunCommand([]string{"git", "config", "--file", ".gitmodules", "submodule.my_submodule_name.branch", "main"})
+		shell.RunCommand([]string{"git", "config", "--file", ".gitmodules", "submodule.my_submodule_name.ignore", "all"})
+		shell.GitAddAll()
+		shell.Commit("add submodule")
+
+		shell.RunCommand([]string{"git", "-C", "my_submodule_path", "commit", "--allow-empty", "-m", "unrecorded commit"})
+		shell.CreateFile("my_submodule_path/dirty_file", "")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Files().IsEmpty()
+
+		t.Views().Submodules().Focus().
+			Lines(
+				Contains("my_submodule_name").Contains("+*").Contains("main").IsSelected(),
+			)
+
+		t.Views().Main().
+			Content(
+				Contains("Branch: main").
+					Contains("Status: checked-out commit differs from the recorded one, uncommitted changes").
+					Contains("Recorded commit:").
+					Contains("Checked-out commit:").
+					Contains("> ").Contains("(HEAD -> master) unrecorded commit"),
+			)
+	},
+})
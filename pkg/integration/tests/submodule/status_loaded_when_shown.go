@@ -0,0 +1,43 @@
+package submodule
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var StatusLoadedWhenShown = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Submodule statuses are not reloaded by file refreshes while the submodules panel is hidden, but are when it's shown",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig:  func(cfg *config.AppConfig) {},
+	SetupRepo: func(shell *Shell) {
+		shell.EmptyCommit("first commit")
+		shell.CloneIntoSubmodule("my_submodule_name", "my_submodule_path")
+		shell.GitAddAll()
+		shell.Commit("add submodule")
+		shell.CreateFile("other_file", "")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Submodules().
+			Lines(
+				Contains("my_submodule_name").DoesNotContain("*"),
+			)
+
+		t.Shell().CreateFile("my_submodule_path/dirty_file", "")
+
+		t.Views().Files().
+			Focus().
+			NavigateToLine(Contains("other_file")).
+			PressPrimaryAction().
+			Lines(
+				Contains("my_submodule_path"),
+				Contains("A  other_file").IsSelected(),
+			)
+
+		t.Views().Submodules().
+			Focus().
+			Lines(
+				Contains("my_submodule_name").Contains("*").IsSelected(),
+			)
+	},
+})
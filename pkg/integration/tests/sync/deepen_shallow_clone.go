@@ -0,0 +1,84 @@
+package sync
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var DeepenShallowClone = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Mark the boundary of a shallow clone, deepen its history and finally unshallow it",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig:  func(config *config.AppConfig) {},
+	SetupRepo: func(shell *Shell) {
+		shell.CreateNCommits(5)
+		shell.CloneIntoRemote("origin")
+		// turn the repo into a shallow clone containing only the last two commits
+		shell.RunCommand([]string{"git", "fetch", "--depth=2", "origin"})
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Status().Content(Contains("(shallow clone)"))
+
+		t.Views().Commits().
+			Focus().
+			Lines(
+				Contains("commit 05").IsSelected(),
+				Contains("commit 04 <-- shallow clone boundary"),
+			).
+			Press(keys.Commits.OpenLogMenu)
+
+		t.ExpectPopup().Menu().
+			Title(Equals("Commit Log Options")).
+			Select(Contains("Fetch more history")).
+			Confirm()
+
+		t.ExpectPopup().Menu().
+			Title(Equals("Fetch more history")).
+			Select(Contains("Deepen by number of commits")).
+			Confirm()
+
+		t.ExpectPopup().Prompt().
+			Title(Equals("Number of commits to fetch")).
+			Type("1").
+			Confirm()
+
+		t.Views().Commits().
+			Lines(
+				Contains("commit 05").IsSelected(),
+				Contains("commit 04"),
+				Contains("commit 03 <-- shallow clone boundary"),
+			).
+			Press(keys.Commits.OpenLogMenu)
+
+		t.ExpectPopup().Menu().
+			Title(Equals("Commit Log Options")).
+			Select(Contains("Fetch more history")).
+			Confirm()
+
+		t.ExpectPopup().Menu().
+			Title(Equals("Fetch more history")).
+			Select(Contains("Fetch full history (unshallow)")).
+			Confirm()
+
+		t.Views().Commits().
+			Lines(
+				Contains("commit 05").IsSelected(),
+				Contains("commit 04"),
+				Contains("commit 03"),
+				Contains("commit 02"),
+				Contains("commit 01"),
+			)
+
+		t.Views().Status().Content(DoesNotContain("shallow clone"))
+
+		t.Views().Commits().
+			Press(keys.Commits.OpenLogMenu)
+
+		t.ExpectPopup().Menu().
+			Title(Equals("Commit Log Options")).
+			Select(Contains("Fetch more history")).
+			Confirm()
+
+		t.ExpectToast(Equals("Disabled: This repository is not a shallow clone."))
+	},
+})
@@ -0,0 +1,36 @@
+package sync
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var FetchRemoteWithConfiguredOptions = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Fetch a remote from the remotes panel using the options configured for it",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig: func(cfg *config.AppConfig) {
+		cfg.GetUserConfig().Git.RemoteFetch = map[string]config.RemoteFetchConfig{
+			"origin": {Prune: true},
+		}
+	},
+	SetupRepo: func(shell *Shell) {
+		createRemoteWithChangesToFetch(shell)
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Remotes().
+			Focus().
+			Lines(
+				Contains("origin").IsSelected(),
+			).
+			Press(keys.Branches.FetchRemote).
+			PressEnter()
+
+		t.Views().RemoteBranches().
+			IsFocused().
+			Lines(
+				Contains("feature"),
+				Contains("master"),
+			)
+	},
+})
@@ -0,0 +1,61 @@
+package sync
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var FetchRemoteWithOptions = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Fetch a remote from the fetch options menu and see which refs changed",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	// Reporting the fetched refs needs `git fetch --porcelain`
+	GitVersion:  AtLeast("2.41.0"),
+	SetupConfig: func(config *config.AppConfig) {},
+	SetupRepo: func(shell *Shell) {
+		createRemoteWithChangesToFetch(shell)
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Remotes().
+			Focus().
+			Lines(
+				Contains("origin").IsSelected(),
+			).
+			Press(keys.Branches.ViewFetchOptions)
+
+		t.ExpectPopup().Menu().
+			Title(Equals("Fetch origin")).
+			Select(Contains("--prune").DoesNotContain("--prune-tags")).
+			Confirm()
+
+		t.ExpectPopup().Alert().
+			Title(Equals("Fetched origin")).
+			Content(
+				Contains("new").Contains("origin/feature").
+					Contains("forced update").Contains("origin/master").
+					Contains("pruned").Contains("origin/gone"),
+			).
+			Confirm()
+
+		t.Views().Remotes().
+			IsFocused().
+			Press(keys.Branches.ViewFetchOptions)
+
+		t.ExpectPopup().Menu().
+			Title(Equals("Fetch origin")).
+			Select(Contains("Fetch with configured options")).
+			Confirm()
+
+		t.ExpectToast(Equals("origin is already up to date"))
+
+		t.Views().Remotes().
+			PressEnter()
+
+		t.Views().RemoteBranches().
+			IsFocused().
+			Lines(
+				Contains("feature"),
+				Contains("master"),
+			)
+	},
+})
@@ -34,7 +34,10 @@ var ForcePushMultipleMatching = NewIntegrationTest(NewIntegrationTestArgs{
 
 		t.ExpectPopup().Confirmation().
 			Title(Equals("Force push")).
-			Content(Equals("Your branch has diverged from the remote branch. Press <esc> to cancel, or <enter> to force push.")).
+			Content(MatchesRegexp(`^Your branch has diverged from the remote branch. Press <esc> to cancel, or <enter> to force push.
+
+These commits on the remote branch will be overwritten \(as of the last fetch\):
+  [0-9a-f]+ two$`)).
 			Confirm()
 
 		t.Views().Commits().
@@ -38,7 +38,8 @@ var ForcePushRemoteBranchNotStoredLocally = NewIntegrationTest(NewIntegrationTes
 
 		t.ExpectPopup().Confirmation().
 			Title(Equals("Force push")).
-			Content(Equals("Your branch has diverged from the remote branch. Press <esc> to cancel, or <enter> to force push.")).
+			Content(Equals("Your branch has diverged from the remote branch. Press <esc> to cancel, or <enter> to force push.\n\n" +
+				"The remote branch isn't stored locally, so lazygit can't tell which of its commits will be overwritten.")).
 			Confirm()
 
 		// Make a new local commit
@@ -0,0 +1,37 @@
+package sync
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var ForcePushToProtectedBranch = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Force pushing to a protected branch is blocked",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig: func(config *config.AppConfig) {
+		config.GetUserConfig().Git.ProtectedBranches.Patterns = []string{"master"}
+	},
+	SetupRepo: func(shell *Shell) {
+		shell.EmptyCommit("one")
+		shell.EmptyCommit("two")
+
+		shell.CloneIntoRemote("origin")
+		shell.SetBranchUpstream("master", "origin/master")
+
+		// remove the 'two' commit so that we'd need to force push
+		shell.HardReset("HEAD^")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Status().Content(Equals("↓1 repo → master"))
+
+		t.Views().Files().IsFocused().Press(keys.Universal.Push)
+
+		t.ExpectPopup().Alert().
+			Title(Equals("Error")).
+			Content(Equals("Can't force push to 'master' because it is a protected branch (see the 'git.protectedBranches' config)")).
+			Confirm()
+
+		t.Views().Status().Content(Equals("↓1 repo → master"))
+	},
+})
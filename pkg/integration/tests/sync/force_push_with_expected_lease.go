@@ -0,0 +1,91 @@
+package sync
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var ForcePushWithExpectedLease = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Force push from the push options menu, refusing to overwrite a remote branch that moved while the confirmation was shown",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig:  func(config *config.AppConfig) {},
+	SetupRepo: func(shell *Shell) {
+		shell.EmptyCommit("one")
+		shell.EmptyCommit("two")
+
+		shell.CloneIntoRemote("origin")
+		shell.SetBranchUpstream("master", "origin/master")
+
+		// a commit that someone else is going to push to the remote
+		shell.NewBranch("other")
+		shell.EmptyCommit("three")
+		shell.Checkout("master")
+
+		shell.HardReset("HEAD^")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Status().Content(Equals("↓1 repo → master"))
+
+		t.Views().Files().IsFocused().Press(keys.Universal.ViewPushOptions)
+
+		t.ExpectPopup().Menu().
+			Title(Equals("Push options")).
+			Select(Contains("Force push, expecting origin/master not to have moved")).
+			Confirm()
+
+		t.ExpectPopup().Confirmation().
+			Title(Equals("Force push")).
+			Content(MatchesRegexp(`^Are you sure you want to force push to origin/master, expecting it at [0-9a-f]+\?
+
+These commits on the remote branch will be overwritten \(as of the last fetch\):
+  [0-9a-f]+ two$`))
+
+		// the remote branch moves, and a fetch updates our remote-tracking
+		// branch, after we've seen where it was
+		t.Shell().
+			RunCommand([]string{"git", "push", "origin", "other:master"}).
+			RunCommand([]string{"git", "fetch", "origin"})
+
+		t.ExpectPopup().Confirmation().
+			Title(Equals("Force push")).
+			Content(Contains("two")).
+			Confirm()
+
+		t.ExpectPopup().Alert().
+			Title(Equals("Error")).
+			Content(Equals("The remote branch has moved since you last fetched. Fetch and review the new commits before force pushing again.")).
+			Confirm()
+
+		t.Views().Status().Content(Equals("↓2 repo → master"))
+
+		t.Views().Files().IsFocused().Press(keys.Universal.ViewPushOptions)
+
+		t.ExpectPopup().Menu().
+			Title(Equals("Push options")).
+			Select(Contains("Force push, expecting origin/master not to have moved")).
+			Confirm()
+
+		t.ExpectPopup().Confirmation().
+			Title(Equals("Force push")).
+			Content(MatchesRegexp(`^Are you sure you want to force push to origin/master, expecting it at [0-9a-f]+\?
+
+These commits on the remote branch will be overwritten \(as of the last fetch\):
+  [0-9a-f]+ three
+  [0-9a-f]+ two$`)).
+			Confirm()
+
+		t.Views().Status().Content(Equals("✓ repo → master"))
+
+		t.Views().Remotes().Focus().
+			Lines(Contains("origin")).
+			PressEnter()
+
+		t.Views().RemoteBranches().IsFocused().
+			Lines(Contains("master")).
+			PressEnter()
+
+		t.Views().SubCommits().IsFocused().
+			Lines(Contains("one"))
+	},
+})
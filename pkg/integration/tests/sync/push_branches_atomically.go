@@ -0,0 +1,65 @@
+package sync
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var PushBranchesAtomically = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Push several selected branches to their upstreams in one atomic push",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig:  func(config *config.AppConfig) {},
+	SetupRepo: func(shell *Shell) {
+		shell.EmptyCommit("one")
+		shell.NewBranch("branch-a")
+		shell.NewBranch("branch-b")
+		shell.Checkout("master")
+
+		shell.CloneIntoRemote("origin")
+		shell.SetBranchUpstream("master", "origin/master")
+		shell.SetBranchUpstream("branch-a", "origin/branch-a")
+		shell.SetBranchUpstream("branch-b", "origin/branch-b")
+
+		shell.Checkout("branch-a")
+		shell.EmptyCommit("a")
+		shell.Checkout("branch-b")
+		shell.EmptyCommit("b")
+		shell.Checkout("master")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Branches().Focus().
+			Lines(
+				Contains("master").IsSelected(),
+				Contains("branch-b ↑1"),
+				Contains("branch-a ↑1"),
+			).
+			Press(keys.Universal.ViewPushOptions).
+			Tap(func() {
+				t.ExpectPopup().Menu().
+					Title(Equals("Push options")).
+					Select(Contains("Push selected branches atomically")).
+					Tooltip(Contains("Disabled: Select two or more branches in the branches panel first")).
+					Confirm().
+					Tap(func() {
+						t.ExpectToast(Equals("Disabled: Select two or more branches in the branches panel first"))
+					}).
+					Cancel()
+			}).
+			SelectNextItem().
+			Press(keys.Universal.RangeSelectDown).
+			Press(keys.Universal.ViewPushOptions)
+
+		t.ExpectPopup().Menu().
+			Title(Equals("Push options")).
+			Select(Contains("Push selected branches atomically").Contains("2 branches to origin")).
+			Confirm()
+
+		t.Views().Branches().
+			Lines(
+				Contains("master"),
+				Contains("branch-b ✓"),
+				Contains("branch-a ✓"),
+			)
+	},
+})
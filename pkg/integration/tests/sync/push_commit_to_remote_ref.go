@@ -0,0 +1,64 @@
+package sync
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var PushCommitToRemoteRef = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Push the selected commit to a new branch on the remote",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig:  func(config *config.AppConfig) {},
+	SetupRepo: func(shell *Shell) {
+		shell.EmptyCommit("one")
+
+		shell.CloneIntoRemote("origin")
+		shell.SetBranchUpstream("master", "origin/master")
+
+		shell.EmptyCommit("two")
+		shell.EmptyCommit("three")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Commits().Focus().
+			Lines(
+				Contains("three").IsSelected(),
+				Contains("two"),
+				Contains("one"),
+			).
+			SelectNextItem().
+			Press(keys.Universal.ViewPushOptions)
+
+		t.ExpectPopup().Menu().
+			Title(Equals("Push options")).
+			Select(Contains("Push selected commit to remote ref")).
+			Confirm()
+
+		t.ExpectPopup().Prompt().
+			Title(Contains("to '<remote> <ref>'")).
+			InitialText(Equals("origin master")).
+			Clear().
+			Type("origin feature").
+			Confirm()
+
+		t.Views().Remotes().Focus().
+			Lines(Contains("origin")).
+			PressEnter()
+
+		t.Views().RemoteBranches().IsFocused().
+			Lines(
+				Contains("feature"),
+				Contains("master"),
+			).
+			PressEnter()
+
+		t.Views().SubCommits().IsFocused().
+			Lines(
+				Contains("two"),
+				Contains("one"),
+			)
+
+		// the checked-out branch itself wasn't pushed
+		t.Views().Status().Content(Equals("↑2 repo → master"))
+	},
+})
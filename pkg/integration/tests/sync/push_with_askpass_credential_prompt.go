@@ -0,0 +1,46 @@
+package sync
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var PushWithAskpassCredentialPrompt = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Push a commit where credentials are requested through GIT_ASKPASS",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig:  func(config *config.AppConfig) {},
+	SetupRepo: func(shell *Shell) {
+		shell.EmptyCommit("one")
+
+		shell.CloneIntoRemote("origin")
+
+		shell.SetBranchUpstream("master", "origin/master")
+
+		shell.EmptyCommit("two")
+
+		shell.CopyHelpFile("pre-push-askpass", ".git/hooks/pre-push")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Status().Content(Equals("↑1 repo → master"))
+
+		t.Views().Files().
+			IsFocused().
+			Press(keys.Universal.Push)
+
+		// the prompt that git asked with is shown as the title
+		t.ExpectPopup().Prompt().
+			Title(Equals("Username for 'https://github.com':")).
+			Type("username").
+			Confirm()
+
+		t.ExpectPopup().Prompt().
+			Title(Equals("Password for 'https://username@github.com':")).
+			Type("password").
+			Confirm()
+
+		t.Views().Status().Content(Equals("✓ repo → master"))
+
+		assertSuccessfullyPushed(t)
+	},
+})
@@ -0,0 +1,100 @@
+package sync
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var PushWithCachedCredentials = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Push several times with credential caching enabled, so that we're only asked for credentials once until the cache is cleared",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig: func(cfg *config.AppConfig) {
+		cfg.GetUserConfig().Git.CacheCredentials = true
+	},
+	SetupRepo: func(shell *Shell) {
+		shell.EmptyCommit("one")
+
+		shell.CloneIntoRemote("origin")
+
+		shell.SetBranchUpstream("master", "origin/master")
+
+		shell.EmptyCommit("two")
+
+		shell.CopyHelpFile("pre-push", ".git/hooks/pre-push")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Status().Content(Equals("↑1 repo → master"))
+
+		t.Views().Files().
+			IsFocused().
+			Press(keys.Universal.Push)
+
+		// nothing is cached if authentication fails
+		t.ExpectPopup().Prompt().
+			Title(Equals("Username")).
+			Type("username").
+			Confirm()
+
+		t.ExpectPopup().Prompt().
+			Title(Equals("Password")).
+			Type("incorrect password").
+			Confirm()
+
+		t.ExpectPopup().Alert().
+			Title(Equals("Error")).
+			Content(Contains("incorrect username/password")).
+			Confirm()
+
+		t.Views().Files().
+			IsFocused().
+			Press(keys.Universal.Push)
+
+		t.ExpectPopup().Prompt().
+			Title(Equals("Username")).
+			Type("username").
+			Confirm()
+
+		t.ExpectPopup().Prompt().
+			Title(Equals("Password")).
+			Type("password").
+			Confirm()
+
+		t.Views().Status().Content(Equals("✓ repo → master"))
+
+		// the second push is answered from the cache
+		t.Shell().EmptyCommit("three")
+		t.GlobalPress(keys.Universal.Refresh)
+		t.Views().Status().Content(Equals("↑1 repo → master"))
+
+		t.Views().Files().
+			IsFocused().
+			Press(keys.Universal.Push)
+
+		t.Views().Status().Content(Equals("✓ repo → master"))
+
+		t.GlobalPress(keys.Universal.ClearCredentialCache)
+		t.ExpectToast(Equals("Cleared 2 cached credential(s)"))
+
+		// after clearing the cache we're asked again
+		t.Shell().EmptyCommit("four")
+		t.GlobalPress(keys.Universal.Refresh)
+		t.Views().Status().Content(Equals("↑1 repo → master"))
+
+		t.Views().Files().
+			IsFocused().
+			Press(keys.Universal.Push)
+
+		t.ExpectPopup().Prompt().
+			Title(Equals("Username")).
+			Type("username").
+			Confirm()
+
+		t.ExpectPopup().Prompt().
+			Title(Equals("Password")).
+			Type("password").
+			Confirm()
+
+		t.Views().Status().Content(Equals("✓ repo → master"))
+	},
+})
@@ -0,0 +1,50 @@
+package sync
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var PushWithCustomCredentialPromptPattern = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Push a commit where credentials are requested with prompts that are only recognised through the credentialPrompts config",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig: func(cfg *config.AppConfig) {
+		cfg.GetUserConfig().Git.CredentialPrompts = []config.CredentialPromptConfig{
+			{Pattern: `Benutzername für '.+':`, Type: "username"},
+			{Pattern: `Passwort für '.+':`, Type: "password"},
+		}
+	},
+	SetupRepo: func(shell *Shell) {
+		shell.EmptyCommit("one")
+
+		shell.CloneIntoRemote("origin")
+
+		shell.SetBranchUpstream("master", "origin/master")
+
+		shell.EmptyCommit("two")
+
+		shell.CopyHelpFile("pre-push-localized", ".git/hooks/pre-push")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Status().Content(Equals("↑1 repo → master"))
+
+		t.Views().Files().
+			IsFocused().
+			Press(keys.Universal.Push)
+
+		t.ExpectPopup().Prompt().
+			Title(Equals("Username")).
+			Type("username").
+			Confirm()
+
+		t.ExpectPopup().Prompt().
+			Title(Equals("Password")).
+			Type("password").
+			Confirm()
+
+		t.Views().Status().Content(Equals("✓ repo → master"))
+
+		assertSuccessfullyPushed(t)
+	},
+})
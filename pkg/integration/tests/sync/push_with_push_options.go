@@ -0,0 +1,38 @@
+package sync
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var PushWithPushOptions = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Push with the push options configured for the upstream's remote",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig: func(config *config.AppConfig) {
+		config.GetUserConfig().Git.PushOptions = map[string][]string{
+			"origin": {"ci.skip", "merge_request.create"},
+		}
+	},
+	SetupRepo: func(shell *Shell) {
+		shell.EmptyCommit("one")
+
+		shell.CloneIntoRemote("origin")
+		shell.RunCommand([]string{"git", "-C", "../origin", "config", "receive.advertisePushOptions", "true"})
+		shell.SetBranchUpstream("master", "origin/master")
+
+		shell.EmptyCommit("two")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Status().Content(Equals("↑1 repo → master"))
+
+		t.Views().Files().IsFocused().Press(keys.Universal.ViewPushOptions)
+
+		t.ExpectPopup().Menu().
+			Title(Equals("Push options")).
+			Select(Contains("Push with push options").Contains("ci.skip merge_request.create")).
+			Confirm()
+
+		assertSuccessfullyPushed(t)
+	},
+})
@@ -47,3 +47,18 @@ func assertSuccessfullyPushed(t *TestDriver) {
 			Contains("one"),
 		)
 }
+
+// Creates a remote on which, compared to our remote-tracking branches, a
+// branch was added, a branch was deleted and master was rewound
+func createRemoteWithChangesToFetch(shell *Shell) {
+	shell.EmptyCommit("one")
+	shell.EmptyCommit("two")
+	shell.NewBranch("gone")
+	shell.Checkout("master")
+	shell.CloneIntoRemote("origin")
+	shell.RunCommand([]string{"git", "branch", "-D", "gone"})
+
+	shell.RemoveRemoteBranch("origin", "gone")
+	shell.RunCommand([]string{"git", "-C", "../origin", "branch", "feature", "master"})
+	shell.RunCommand([]string{"git", "-C", "../origin", "update-ref", "refs/heads/master", "master^"})
+}
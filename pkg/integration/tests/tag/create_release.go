@@ -0,0 +1,63 @@
+package tag
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var CreateRelease = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Create a release tag by bumping the latest version, with a changelog in the annotation",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig:  func(config *config.AppConfig) {},
+	SetupRepo: func(shell *Shell) {
+		// Tags are sorted by date, so make sure the new tag ends up on top even
+		// if it is created in the same second
+		shell.EmptyCommitWithDate("initial commit", "2020-01-01T00:00:00")
+		shell.CreateLightweightTag("v1.2.3", "HEAD")
+		shell.EmptyCommit("feat: add widget")
+		shell.EmptyCommit("fix(ui): crash on resize")
+		shell.EmptyCommit("Update readme")
+		shell.CloneIntoRemote("origin")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Tags().
+			Focus().
+			Lines(
+				Contains("v1.2.3").IsSelected(),
+			).
+			Press(keys.Branches.CreateReleaseTag).
+			Tap(func() {
+				t.ExpectPopup().Menu().
+					Title(Equals("Bump version (latest: v1.2.3)")).
+					Lines(
+						Contains("Patch").Contains("v1.2.4"),
+						Contains("Minor").Contains("v1.3.0"),
+						Contains("Major").Contains("v2.0.0"),
+						Contains("Prerelease").Contains("v1.2.4-rc.1"),
+						Contains("Cancel"),
+					).
+					Select(Contains("Minor")).
+					Confirm()
+
+				t.ExpectPopup().CommitMessagePanel().
+					Title(Equals("Tag name")).
+					InitialText(Equals("v1.3.0")).
+					SwitchToDescription().
+					Content(Equals("Features:\n- add widget\n\nBug fixes:\n- ui: crash on resize\n\nOther changes:\n- Update readme")).
+					SwitchToSummary().
+					Confirm()
+
+				t.ExpectPopup().Menu().
+					Title(Equals("Push tag 'v1.3.0' to:")).
+					Select(Contains("origin")).
+					Confirm()
+			}).
+			Lines(
+				Contains("v1.3.0").Contains("Features:").IsSelected(),
+				Contains("v1.2.3"),
+			)
+
+		t.Git().RemoteTagExists("origin", "v1.3.0")
+	},
+})
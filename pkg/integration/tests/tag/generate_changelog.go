@@ -0,0 +1,56 @@
+package tag
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var GenerateChangelog = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Generate a changelog between the previous release tag and the selected tag",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig:  func(config *config.AppConfig) {},
+	SetupRepo: func(shell *Shell) {
+		shell.SetConfig("user.name", "Jane")
+		shell.EmptyCommit("initial commit")
+		shell.CreateLightweightTag("v1.0.0", "HEAD")
+		shell.EmptyCommit("feat: add widget")
+		shell.EmptyCommit("fix: crash on resize")
+		shell.CreateLightweightTag("v1.1.0", "HEAD")
+		shell.EmptyCommit("chore: unreleased")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Tags().
+			Focus().
+			NavigateToLine(Contains("v1.1.0")).
+			Press(keys.Commits.GenerateChangelog)
+
+		t.ExpectPopup().Prompt().
+			Title(Equals("Changelog for 'v1.1.0' starting from:")).
+			InitialText(Equals("v1.0.0")).
+			Confirm()
+
+		t.ExpectPopup().Menu().
+			Title(Equals("Group commits")).
+			Select(Contains("By conventional commit type")).
+			Confirm()
+
+		t.ExpectPopup().Menu().
+			Title(Equals("Changelog")).
+			Select(Contains("Show in main view")).
+			Confirm()
+
+		t.Views().Main().
+			Title(Equals("Changelog v1.0.0..v1.1.0")).
+			Content(
+				Contains("# Changes from v1.0.0 to v1.1.0").
+					Contains("## Features").
+					Contains("- add widget").
+					Contains("## Bug fixes").
+					Contains("- crash on resize").
+					Contains("## Contributors").
+					Contains("- Jane (2)").
+					DoesNotContain("unreleased"),
+			)
+	},
+})
@@ -33,6 +33,14 @@ var Reset = NewIntegrationTest(NewIntegrationTestArgs{
 			Select(Contains("Hard reset")).
 			Confirm()
 
+		t.ExpectPopup().Confirmation().
+			Title(Equals("Hard reset")).
+			Content(MatchesRegexp(`^Are you sure you want to hard reset to 'tag'\?
+
+These commits will no longer be reachable from any branch, tag or remote branch:
+  [0-9a-f]+ two$`)).
+			Confirm()
+
 		t.Views().Commits().Lines(
 			Contains("one"),
 		)
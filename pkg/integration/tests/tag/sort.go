@@ -0,0 +1,47 @@
+package tag
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var Sort = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Sort tags alphabetically and by version",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig:  func(config *config.AppConfig) {},
+	SetupRepo: func(shell *Shell) {
+		shell.EmptyCommit("one")
+		shell.CreateLightweightTag("v1.2.0", "HEAD")
+		shell.EmptyCommit("two")
+		shell.CreateLightweightTag("v1.10.0", "HEAD")
+		shell.EmptyCommit("three")
+		shell.CreateLightweightTag("v1.9.0", "HEAD")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Tags().
+			Focus().
+			Press(keys.Branches.SortOrder).
+			Tap(func() {
+				t.ExpectPopup().Menu().Title(Equals("Sort order")).
+					Select(Contains("Alphabetical")).
+					Confirm()
+			}).
+			Lines(
+				Contains("v1.10.0").IsSelected(),
+				Contains("v1.2.0"),
+				Contains("v1.9.0"),
+			).
+			Press(keys.Branches.SortOrder).
+			Tap(func() {
+				t.ExpectPopup().Menu().Title(Equals("Sort order")).
+					Select(Contains("Version")).
+					Confirm()
+			}).
+			Lines(
+				Contains("v1.10.0").IsSelected(),
+				Contains("v1.9.0"),
+				Contains("v1.2.0"),
+			)
+	},
+})
@@ -40,13 +40,16 @@ var tests = []*components.IntegrationTest{
 	bisect.Skip,
 	branch.CheckoutAutostash,
 	branch.CheckoutByName,
+	branch.CleanUpStaleBranches,
 	branch.CreateTag,
 	branch.Delete,
 	branch.DeleteMultiple,
+	branch.DeleteProtectedBranch,
 	branch.DeleteRemoteBranchWithCredentialPrompt,
 	branch.DeleteRemoteBranchWithDifferentName,
 	branch.DeleteWhileFiltering,
 	branch.DetachedHead,
+	branch.FastForwardSelectedBranches,
 	branch.NewBranchAutostash,
 	branch.NewBranchFromRemoteTrackingDifferentName,
 	branch.NewBranchFromRemoteTrackingSameName,
@@ -54,6 +57,7 @@ var tests = []*components.IntegrationTest{
 	branch.OpenPullRequestNoUpstream,
 	branch.OpenPullRequestSelectRemoteAndTargetBranch,
 	branch.OpenWithCliArg,
+	branch.PushSelectedBranches,
 	branch.Rebase,
 	branch.RebaseAbortOnConflict,
 	branch.RebaseAndDrop,
@@ -92,6 +96,7 @@ var tests = []*components.IntegrationTest{
 	commit.Commit,
 	commit.CommitMultiline,
 	commit.CommitSwitchToEditor,
+	commit.CommitToProtectedBranch,
 	commit.CommitWipWithPrefix,
 	commit.CommitWithFallthroughPrefix,
 	commit.CommitWithGlobalPrefix,
@@ -186,6 +191,8 @@ var tests = []*components.IntegrationTest{
 	file.DiscardVariousChanges,
 	file.DiscardVariousChangesRangeSelect,
 	file.Gitignore,
+	file.ManyFiles,
+	file.NukeWorkingTreePreview,
 	file.RememberCommitMessageAfterFail,
 	file.RenameSimilarityThresholdChange,
 	file.StageChildrenRangeSelect,
@@ -205,6 +212,7 @@ var tests = []*components.IntegrationTest{
 	filter_and_search.NestedFilter,
 	filter_and_search.NestedFilterTransient,
 	filter_and_search.NewSearch,
+	filter_by_author.AuthorStats,
 	filter_by_author.SelectAuthor,
 	filter_by_author.TypeAuthor,
 	filter_by_path.CliArg,
@@ -246,6 +254,7 @@ var tests = []*components.IntegrationTest{
 	interactive_rebase.QuickStartKeepSelection,
 	interactive_rebase.QuickStartKeepSelectionRange,
 	interactive_rebase.Rebase,
+	interactive_rebase.RebaseProtectedBranch,
 	interactive_rebase.RewordCommitWithEditorAndFail,
 	interactive_rebase.RewordFirstCommit,
 	interactive_rebase.RewordLastCommit,
@@ -261,6 +270,8 @@ var tests = []*components.IntegrationTest{
 	interactive_rebase.SwapInRebaseWithConflictAndEdit,
 	interactive_rebase.SwapWithConflict,
 	interactive_rebase.ViewFilesOfTodoEntries,
+	misc.CommandLogEntries,
+	misc.CommandLogExportScript,
 	misc.ConfirmOnQuit,
 	misc.CopyToClipboard,
 	misc.DisabledKeybindings,
@@ -328,21 +339,32 @@ var tests = []*components.IntegrationTest{
 	status.ClickRepoNameToOpenReposMenu,
 	status.ClickToFocus,
 	status.ClickWorkingTreeStateToOpenRebaseOptionsMenu,
+	status.CloneRepository,
 	status.LogCmd,
+	status.PerformanceSettings,
 	status.ShowDivergenceFromBaseBranch,
 	submodule.Add,
 	submodule.Enter,
 	submodule.EnterNested,
+	submodule.ParallelFetch,
+	submodule.ParallelUpdate,
 	submodule.Remove,
 	submodule.RemoveNested,
 	submodule.Reset,
+	submodule.Status,
+	submodule.StatusLoadedWhenShown,
+	sync.DeepenShallowClone,
 	sync.FetchPrune,
+	sync.FetchRemoteWithConfiguredOptions,
+	sync.FetchRemoteWithOptions,
 	sync.FetchWhenSortedByDate,
 	sync.ForcePush,
 	sync.ForcePushMultipleMatching,
 	sync.ForcePushMultipleUpstream,
 	sync.ForcePushRemoteBranchNotStoredLocally,
+	sync.ForcePushToProtectedBranch,
 	sync.ForcePushTriangular,
+	sync.ForcePushWithExpectedLease,
 	sync.Pull,
 	sync.PullAndSetUpstream,
 	sync.PullMerge,
@@ -354,21 +376,30 @@ var tests = []*components.IntegrationTest{
 	sync.Push,
 	sync.PushAndAutoSetUpstream,
 	sync.PushAndSetUpstream,
+	sync.PushBranchesAtomically,
+	sync.PushCommitToRemoteRef,
 	sync.PushFollowTags,
 	sync.PushNoFollowTags,
 	sync.PushTag,
+	sync.PushWithAskpassCredentialPrompt,
+	sync.PushWithCachedCredentials,
 	sync.PushWithCredentialPrompt,
+	sync.PushWithCustomCredentialPromptPattern,
+	sync.PushWithPushOptions,
 	sync.RenameBranchAndPull,
 	tag.Checkout,
 	tag.CheckoutWhenBranchWithSameNameExists,
 	tag.CopyToClipboard,
+	tag.CreateRelease,
 	tag.CreateWhileCommitting,
 	tag.CrudAnnotated,
 	tag.CrudLightweight,
 	tag.DeleteLocalAndRemote,
 	tag.ForceTagAnnotated,
 	tag.ForceTagLightweight,
+	tag.GenerateChangelog,
 	tag.Reset,
+	tag.Sort,
 	ui.Accordion,
 	ui.DisableSwitchTabWithPanelJumpKeys,
 	ui.DoublePopup,
@@ -382,6 +413,7 @@ var tests = []*components.IntegrationTest{
 	undo.UndoCheckoutAndDrop,
 	undo.UndoCommit,
 	undo.UndoDrop,
+	undo.UndoOnProtectedBranch,
 	worktree.AddFromBranch,
 	worktree.AddFromBranchDetached,
 	worktree.AddFromCommit,
@@ -398,8 +430,13 @@ var tests = []*components.IntegrationTest{
 	worktree.FastForwardWorktreeBranch,
 	worktree.FastForwardWorktreeBranchShouldNotPolluteCurrentWorktree,
 	worktree.ForceRemoveWorktree,
+	worktree.LockAndUnlock,
+	worktree.MoveWorktree,
+	worktree.PruneWorktrees,
 	worktree.RemoveWorktreeFromBranch,
+	worktree.RepairWorktree,
 	worktree.ResetWindowTabs,
 	worktree.SymlinkIntoRepoSubdir,
 	worktree.WorktreeInRepo,
+	worktree.WorktreeStatus,
 }
@@ -0,0 +1,53 @@
+package undo
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var UndoOnProtectedBranch = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Undoing a rebase hard resets the checked-out branch, so it is blocked on a protected branch",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig: func(config *config.AppConfig) {
+		config.GetUserConfig().Git.ProtectedBranches.Patterns = []string{"master"}
+		config.GetUserConfig().Git.ProtectedBranches.Rebase = "allow"
+		config.GetUserConfig().Git.ProtectedBranches.Reset = "block"
+	},
+	SetupRepo: func(shell *Shell) {
+		shell.EmptyCommit("one")
+		shell.EmptyCommit("two")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Commits().Focus().
+			Lines(
+				Contains("two").IsSelected(),
+				Contains("one"),
+			).
+			Press(keys.Universal.Remove).
+			Tap(func() {
+				t.ExpectPopup().Confirmation().
+					Title(Equals("Drop commit")).
+					Content(Equals("Are you sure you want to drop the selected commit(s)?")).
+					Confirm()
+			}).
+			Lines(
+				Contains("one").IsSelected(),
+			).
+			Press(keys.Universal.Undo).
+			Tap(func() {
+				t.ExpectPopup().Confirmation().
+					Title(Equals("Undo")).
+					Content(MatchesRegexp(`Are you sure you want to hard reset to '.*'\?`)).
+					Confirm()
+
+				t.ExpectPopup().Alert().
+					Title(Equals("Error")).
+					Content(Equals("Can't reset 'master' because it is a protected branch (see the 'git.protectedBranches' config)")).
+					Confirm()
+			}).
+			Lines(
+				Contains("one").IsSelected(),
+			)
+	},
+})
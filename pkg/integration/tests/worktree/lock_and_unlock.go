@@ -0,0 +1,73 @@
+package worktree
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var LockAndUnlock = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Lock a worktree with a reason, check that it can't be moved, and unlock it again",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig:  func(config *config.AppConfig) {},
+	SetupRepo: func(shell *Shell) {
+		shell.NewBranch("mybranch")
+		shell.CreateFileAndAdd("README.md", "hello world")
+		shell.Commit("initial commit")
+		shell.AddWorktree("mybranch", "../linked-worktree", "newbranch")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Worktrees().
+			Focus().
+			Lines(
+				Contains("repo (main)").IsSelected(),
+				Contains("linked-worktree"),
+			).
+			Press(keys.Worktrees.ViewWorktreeOptions).
+			Tap(func() {
+				t.ExpectPopup().Menu().
+					Title(Equals("Worktree")).
+					Select(Contains("Lock worktree")).
+					Tooltip(Contains("Disabled: The main worktree cannot be locked")).
+					Confirm().
+					Tap(func() {
+						t.ExpectToast(Equals("Disabled: The main worktree cannot be locked"))
+					}).
+					Cancel()
+			}).
+			NavigateToLine(Contains("linked-worktree")).
+			Press(keys.Worktrees.ViewWorktreeOptions).
+			Tap(func() {
+				t.ExpectPopup().Menu().
+					Title(Equals("Worktree")).
+					Select(Contains("Lock worktree")).
+					Confirm()
+
+				t.ExpectPopup().Prompt().
+					Title(Equals("Reason for locking (optional)")).
+					Type("on a usb stick").
+					Confirm()
+			}).
+			Lines(
+				Contains("repo (main)"),
+				Contains("linked-worktree (locked)").IsSelected(),
+			)
+
+		t.Views().Main().Content(Contains("Lock reason:").Contains("on a usb stick"))
+
+		t.Views().Worktrees().
+			Press(keys.Worktrees.ViewWorktreeOptions).
+			Tap(func() {
+				t.ExpectPopup().Menu().
+					Title(Equals("Worktree")).
+					Select(Contains("Move worktree")).
+					Tooltip(Contains("Disabled: The worktree is locked. Unlock it before moving it.")).
+					Select(Contains("Unlock worktree")).
+					Confirm()
+			}).
+			Lines(
+				Contains("repo (main)"),
+				Contains("linked-worktree").DoesNotContain("(locked)").IsSelected(),
+			)
+	},
+})
@@ -0,0 +1,59 @@
+package worktree
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var MoveWorktree = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Move a linked worktree to a new directory",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig:  func(config *config.AppConfig) {},
+	SetupRepo: func(shell *Shell) {
+		shell.NewBranch("mybranch")
+		shell.CreateFileAndAdd("README.md", "hello world")
+		shell.Commit("initial commit")
+		shell.AddWorktree("mybranch", "../linked-worktree", "newbranch")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Worktrees().
+			Focus().
+			Lines(
+				Contains("repo (main)").IsSelected(),
+				Contains("linked-worktree"),
+			).
+			Press(keys.Worktrees.ViewWorktreeOptions).
+			Tap(func() {
+				t.ExpectPopup().Menu().
+					Title(Equals("Worktree")).
+					Select(Contains("Move worktree")).
+					Tooltip(Contains("Disabled: The main worktree cannot be moved")).
+					Confirm().
+					Tap(func() {
+						t.ExpectToast(Equals("Disabled: The main worktree cannot be moved"))
+					}).
+					Cancel()
+			}).
+			NavigateToLine(Contains("linked-worktree")).
+			Press(keys.Worktrees.ViewWorktreeOptions).
+			Tap(func() {
+				t.ExpectPopup().Menu().
+					Title(Equals("Worktree")).
+					Select(Contains("Move worktree")).
+					Confirm()
+
+				t.ExpectPopup().Prompt().
+					Title(Equals("New path for worktree 'linked-worktree'")).
+					Clear().
+					Type("../moved-worktree").
+					Confirm()
+			}).
+			Lines(
+				Contains("repo (main)"),
+				Contains("moved-worktree").IsSelected(),
+			)
+
+		t.Views().Main().Content(Contains("moved-worktree").DoesNotContain("(missing)"))
+	},
+})
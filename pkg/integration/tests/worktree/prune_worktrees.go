@@ -0,0 +1,59 @@
+package worktree
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var PruneWorktrees = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Prune worktrees whose directory was deleted, keeping locked ones",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig:  func(config *config.AppConfig) {},
+	SetupRepo: func(shell *Shell) {
+		shell.NewBranch("mybranch")
+		shell.CreateFileAndAdd("README.md", "hello world")
+		shell.Commit("initial commit")
+		shell.AddWorktree("mybranch", "../deleted-worktree", "deleted")
+		shell.AddWorktree("mybranch", "../locked-worktree", "locked")
+		shell.RunCommand([]string{"git", "worktree", "lock", "../locked-worktree"})
+		shell.RunCommand([]string{"rm", "-rf", "../deleted-worktree", "../locked-worktree"})
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Worktrees().
+			Focus().
+			Lines(
+				Contains("repo (main)").IsSelected(),
+				Contains("deleted-worktree (missing)"),
+				Contains("locked-worktree (missing) (locked)"),
+			).
+			Press(keys.Worktrees.ViewWorktreeOptions).
+			Tap(func() {
+				t.ExpectPopup().Menu().
+					Title(Equals("Worktree")).
+					Select(Contains("Prune stale worktrees")).
+					Confirm()
+
+				t.ExpectPopup().Confirmation().
+					Title(Equals("Prune stale worktrees")).
+					Content(Contains("- deleted-worktree").DoesNotContain("locked-worktree")).
+					Confirm()
+			}).
+			Lines(
+				Contains("repo (main)").IsSelected(),
+				Contains("locked-worktree (missing) (locked)"),
+			).
+			Press(keys.Worktrees.ViewWorktreeOptions).
+			Tap(func() {
+				t.ExpectPopup().Menu().
+					Title(Equals("Worktree")).
+					Select(Contains("Prune stale worktrees")).
+					Tooltip(Contains("Disabled: There are no unlocked worktrees with a missing directory")).
+					Confirm().
+					Tap(func() {
+						t.ExpectToast(Equals("Disabled: There are no unlocked worktrees with a missing directory"))
+					}).
+					Cancel()
+			})
+	},
+})
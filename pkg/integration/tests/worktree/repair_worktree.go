@@ -0,0 +1,45 @@
+package worktree
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var RepairWorktree = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Repair a worktree whose directory was moved without git",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig:  func(config *config.AppConfig) {},
+	SetupRepo: func(shell *Shell) {
+		shell.NewBranch("mybranch")
+		shell.CreateFileAndAdd("README.md", "hello world")
+		shell.Commit("initial commit")
+		shell.AddWorktree("mybranch", "../linked-worktree", "newbranch")
+		shell.RunCommand([]string{"mv", "../linked-worktree", "../moved-worktree"})
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Worktrees().
+			Focus().
+			Lines(
+				Contains("repo (main)").IsSelected(),
+				Contains("linked-worktree (missing)"),
+			).
+			NavigateToLine(Contains("linked-worktree")).
+			Press(keys.Worktrees.ViewWorktreeOptions).
+			Tap(func() {
+				t.ExpectPopup().Menu().
+					Title(Equals("Worktree")).
+					Select(Contains("Repair worktree")).
+					Confirm()
+
+				t.ExpectPopup().Prompt().
+					Title(Equals("New location of worktree 'linked-worktree'")).
+					Type("../moved-worktree").
+					Confirm()
+			}).
+			Lines(
+				Contains("repo (main)"),
+				Contains("moved-worktree").DoesNotContain("(missing)"),
+			)
+	},
+})
@@ -0,0 +1,35 @@
+package worktree
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/config"
+	. "github.com/jesseduffield/lazygit/pkg/integration/components"
+)
+
+var WorktreeStatus = NewIntegrationTest(NewIntegrationTestArgs{
+	Description:  "Show the changed file count and upstream divergence of each worktree",
+	ExtraCmdArgs: []string{},
+	Skip:         false,
+	SetupConfig: func(config *config.AppConfig) {
+		config.GetUserConfig().Gui.ShowWorktreeStatus = true
+	},
+	SetupRepo: func(shell *Shell) {
+		shell.NewBranch("mybranch")
+		shell.CreateFileAndAdd("README.md", "hello world")
+		shell.Commit("initial commit")
+		shell.CloneIntoRemote("origin")
+		shell.SetBranchUpstream("mybranch", "origin/mybranch")
+		shell.EmptyCommit("unpushed commit")
+		shell.AddWorktree("mybranch", "../linked-worktree", "newbranch")
+		shell.CreateFile("../linked-worktree/file1", "content")
+		shell.CreateFile("../linked-worktree/file2", "content")
+		shell.CreateFile("file3", "content")
+	},
+	Run: func(t *TestDriver, keys config.KeybindingConfig) {
+		t.Views().Worktrees().
+			Focus().
+			Lines(
+				Contains("repo (main)").Contains("↑1").Contains("1 changed"),
+				Contains("linked-worktree").Contains("2 changed").DoesNotContain("↑"),
+			)
+	},
+})
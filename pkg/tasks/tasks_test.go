@@ -268,3 +268,51 @@ func TestNewCmdTaskRefresh(t *testing.T) {
 		}
 	}
 }
+
+func TestNewTaskLastCreatedTaskWins(t *testing.T) {
+	for i := 0; i < 100; i++ {
+		manager := NewViewBufferManager(
+			utils.NewDummyLog(),
+			bytes.NewBuffer(nil),
+			func() {},
+			func() {},
+			func() {},
+			func() {},
+			func() gocui.Task { return gocui.NewFakeTask() },
+		)
+
+		ran := make(chan string, 2)
+		newTaskFn := func(name string) func(TaskOpts) error {
+			return func(opts TaskOpts) error {
+				ran <- name
+				<-opts.Stop
+				return nil
+			}
+		}
+
+		_ = manager.NewTask(newTaskFn("first"), "first")
+		_ = manager.NewTask(newTaskFn("second"), "second")
+
+		timeout := time.After(time.Second)
+	loop:
+		for {
+			select {
+			case name := <-ran:
+				if name == "second" {
+					break loop
+				}
+			case <-timeout:
+				t.Fatalf("the task created last never ran")
+			}
+		}
+
+		manager.taskIDMutex.Lock()
+		key := manager.GetTaskKey()
+		manager.taskIDMutex.Unlock()
+		if key != "second" {
+			t.Fatalf("expected task key to be 'second', got '%s'", key)
+		}
+
+		manager.Close()
+	}
+}
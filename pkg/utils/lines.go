@@ -103,6 +103,24 @@ func ScanLinesAndTruncateWhenLongerThanBuffer(maxBufferSize int) func(data []byt
 	}
 }
 
+// ScanNul is a split function for bufio.Scanner.Split() that splits on NUL
+// characters rather than newlines, for reading the output of commands like
+// `git status -z` as it comes in.
+func ScanNul(data []byte, atEOF bool) (int, []byte, error) {
+	if atEOF && len(data) == 0 {
+		return 0, nil, nil
+	}
+	if i := bytes.IndexByte(data, 0); i >= 0 {
+		return i + 1, data[0:i], nil
+	}
+	if atEOF {
+		return len(data), data, nil
+	}
+
+	// Request more data.
+	return 0, nil, nil
+}
+
 // Wrap lines to a given width, and return:
 // - the wrapped lines
 // - the line indices of the wrapped lines, indexed by the original line indices
@@ -166,6 +166,47 @@ func TestScanLinesAndTruncateWhenLongerThanBuffer(t *testing.T) {
 	}
 }
 
+func TestScanNul(t *testing.T) {
+	type scenario struct {
+		input         string
+		expectedLines []string
+	}
+
+	scenarios := []scenario{
+		{
+			"",
+			[]string{},
+		},
+		{
+			"\x00",
+			[]string{""},
+		},
+		{
+			"abc",
+			[]string{"abc"},
+		},
+		{
+			"abc\x00def\x00",
+			[]string{"abc", "def"},
+		},
+		{
+			"abc\ndef\x00\x00ghi",
+			[]string{"abc\ndef", "", "ghi"},
+		},
+	}
+
+	for _, s := range scenarios {
+		scanner := bufio.NewScanner(strings.NewReader(s.input))
+		scanner.Split(ScanNul)
+		result := []string{}
+		for scanner.Scan() {
+			result = append(result, scanner.Text())
+		}
+		assert.NoError(t, scanner.Err())
+		assert.EqualValues(t, s.expectedLines, result)
+	}
+}
+
 func TestWrapViewLinesToWidth(t *testing.T) {
 	tests := []struct {
 		name                         string
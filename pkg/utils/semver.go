@@ -0,0 +1,190 @@
+package utils
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// SemVer is a semantic version as found in release tags, e.g. "v1.2.3-rc.1".
+// Build metadata (the "+..." suffix) is dropped when parsing.
+type SemVer struct {
+	// Anything before the version number, typically "v" or ""
+	Prefix     string
+	Major      int
+	Minor      int
+	Patch      int
+	Prerelease string
+}
+
+var semVerRegex = regexp.MustCompile(`^(.*?)(\d+)\.(\d+)\.(\d+)(?:-([0-9A-Za-z.-]+))?(?:\+[0-9A-Za-z.-]+)?$`)
+
+// ParseSemVer parses a tag name like "v1.2.3" or "release-1.2.3-beta.2". The
+// second return value is false if the string doesn't end in a semantic version.
+func ParseSemVer(str string) (SemVer, bool) {
+	matches := semVerRegex.FindStringSubmatch(str)
+	if matches == nil {
+		return SemVer{}, false
+	}
+
+	major, _ := strconv.Atoi(matches[2])
+	minor, _ := strconv.Atoi(matches[3])
+	patch, _ := strconv.Atoi(matches[4])
+
+	return SemVer{
+		Prefix:     matches[1],
+		Major:      major,
+		Minor:      minor,
+		Patch:      patch,
+		Prerelease: matches[5],
+	}, true
+}
+
+func (self SemVer) String() string {
+	result := fmt.Sprintf("%s%d.%d.%d", self.Prefix, self.Major, self.Minor, self.Patch)
+	if self.Prerelease != "" {
+		result += "-" + self.Prerelease
+	}
+	return result
+}
+
+func (self SemVer) IsPrerelease() bool {
+	return self.Prerelease != ""
+}
+
+// Compare returns -1, 0 or 1 depending on whether self is lower than, equal to
+// or higher than other, following the precedence rules of semver.org (the
+// prefix is ignored)
+func (self SemVer) Compare(other SemVer) int {
+	for _, pair := range [][2]int{
+		{self.Major, other.Major},
+		{self.Minor, other.Minor},
+		{self.Patch, other.Patch},
+	} {
+		if pair[0] != pair[1] {
+			return compareInts(pair[0], pair[1])
+		}
+	}
+
+	// A version without a prerelease is higher than one with
+	if self.Prerelease == "" || other.Prerelease == "" {
+		if self.Prerelease == other.Prerelease {
+			return 0
+		}
+		if self.Prerelease == "" {
+			return 1
+		}
+		return -1
+	}
+
+	return comparePrereleases(self.Prerelease, other.Prerelease)
+}
+
+func comparePrereleases(a string, b string) int {
+	aFields := strings.Split(a, ".")
+	bFields := strings.Split(b, ".")
+	for i := 0; i < len(aFields) && i < len(bFields); i++ {
+		aNum, aErr := strconv.Atoi(aFields[i])
+		bNum, bErr := strconv.Atoi(bFields[i])
+		switch {
+		case aErr == nil && bErr == nil:
+			if aNum != bNum {
+				return compareInts(aNum, bNum)
+			}
+		case aErr == nil:
+			// numeric identifiers have lower precedence than alphanumeric ones
+			return -1
+		case bErr == nil:
+			return 1
+		default:
+			if cmp := strings.Compare(aFields[i], bFields[i]); cmp != 0 {
+				return cmp
+			}
+		}
+	}
+
+	return compareInts(len(aFields), len(bFields))
+}
+
+func compareInts(a int, b int) int {
+	if a < b {
+		return -1
+	}
+	if a > b {
+		return 1
+	}
+	return 0
+}
+
+// BumpMajor returns the next major version. As with `npm version`, a
+// prerelease of a major version (e.g. 2.0.0-rc.1) bumps to that version.
+func (self SemVer) BumpMajor() SemVer {
+	if self.IsPrerelease() && self.Minor == 0 && self.Patch == 0 {
+		return SemVer{Prefix: self.Prefix, Major: self.Major}
+	}
+	return SemVer{Prefix: self.Prefix, Major: self.Major + 1}
+}
+
+// BumpMinor returns the next minor version; a prerelease of a minor version
+// bumps to that version
+func (self SemVer) BumpMinor() SemVer {
+	if self.IsPrerelease() && self.Patch == 0 {
+		return SemVer{Prefix: self.Prefix, Major: self.Major, Minor: self.Minor}
+	}
+	return SemVer{Prefix: self.Prefix, Major: self.Major, Minor: self.Minor + 1}
+}
+
+// BumpPatch returns the next patch version; a prerelease bumps to the version
+// it is a prerelease of
+func (self SemVer) BumpPatch() SemVer {
+	if self.IsPrerelease() {
+		return SemVer{Prefix: self.Prefix, Major: self.Major, Minor: self.Minor, Patch: self.Patch}
+	}
+	return SemVer{Prefix: self.Prefix, Major: self.Major, Minor: self.Minor, Patch: self.Patch + 1}
+}
+
+// BumpPrerelease increments the trailing number of the prerelease (e.g.
+// rc.1 -> rc.2). For a release version it returns the first prerelease of the
+// next patch version, using the given identifier (e.g. 1.2.3 -> 1.2.4-rc.1).
+func (self SemVer) BumpPrerelease(identifier string) SemVer {
+	if !self.IsPrerelease() {
+		next := self.BumpPatch()
+		next.Prerelease = identifier + ".1"
+		return next
+	}
+
+	fields := strings.Split(self.Prerelease, ".")
+	last := fields[len(fields)-1]
+	if n, err := strconv.Atoi(last); err == nil {
+		fields[len(fields)-1] = strconv.Itoa(n + 1)
+	} else {
+		fields = append(fields, "1")
+	}
+
+	next := self
+	next.Prerelease = strings.Join(fields, ".")
+	return next
+}
+
+// LatestSemVer returns the highest semantic version among the given strings,
+// along with the string it was parsed from. The last return value is false if
+// none of them is a semantic version.
+func LatestSemVer(strs []string) (SemVer, string, bool) {
+	var latest SemVer
+	latestStr := ""
+	found := false
+	for _, str := range strs {
+		version, ok := ParseSemVer(str)
+		if !ok {
+			continue
+		}
+		if !found || version.Compare(latest) > 0 {
+			latest = version
+			latestStr = str
+			found = true
+		}
+	}
+
+	return latest, latestStr, found
+}
@@ -0,0 +1,92 @@
+package utils
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseSemVer(t *testing.T) {
+	scenarios := []struct {
+		input      string
+		expected   SemVer
+		expectedOk bool
+	}{
+		{"v1.2.3", SemVer{Prefix: "v", Major: 1, Minor: 2, Patch: 3}, true},
+		{"1.2.3", SemVer{Major: 1, Minor: 2, Patch: 3}, true},
+		{"v10.20.30-rc.1", SemVer{Prefix: "v", Major: 10, Minor: 20, Patch: 30, Prerelease: "rc.1"}, true},
+		{"release-1.0.0+build.5", SemVer{Prefix: "release-", Major: 1}, true},
+		{"v1.2", SemVer{}, false},
+		{"latest", SemVer{}, false},
+	}
+
+	for _, s := range scenarios {
+		t.Run(s.input, func(t *testing.T) {
+			actual, ok := ParseSemVer(s.input)
+			assert.Equal(t, s.expectedOk, ok)
+			assert.Equal(t, s.expected, actual)
+		})
+	}
+}
+
+func TestSemVerCompare(t *testing.T) {
+	scenarios := []struct {
+		a        string
+		b        string
+		expected int
+	}{
+		{"1.2.3", "1.2.3", 0},
+		{"1.2.3", "1.2.4", -1},
+		{"1.10.0", "1.9.0", 1},
+		{"2.0.0", "2.0.0-rc.1", 1},
+		{"2.0.0-rc.1", "2.0.0-rc.2", -1},
+		{"2.0.0-rc.10", "2.0.0-rc.9", 1},
+		{"2.0.0-alpha", "2.0.0-alpha.1", -1},
+		{"2.0.0-1", "2.0.0-alpha", -1},
+		{"v1.0.0", "1.0.0", 0},
+	}
+
+	for _, s := range scenarios {
+		t.Run(s.a+" vs "+s.b, func(t *testing.T) {
+			a, _ := ParseSemVer(s.a)
+			b, _ := ParseSemVer(s.b)
+			assert.Equal(t, s.expected, a.Compare(b))
+		})
+	}
+}
+
+func TestSemVerBump(t *testing.T) {
+	scenarios := []struct {
+		input              string
+		expectedMajor      string
+		expectedMinor      string
+		expectedPatch      string
+		expectedPrerelease string
+	}{
+		{"v1.2.3", "v2.0.0", "v1.3.0", "v1.2.4", "v1.2.4-rc.1"},
+		{"v1.2.3-rc.1", "v2.0.0", "v1.3.0", "v1.2.3", "v1.2.3-rc.2"},
+		{"v1.3.0-beta", "v2.0.0", "v1.3.0", "v1.3.0", "v1.3.0-beta.1"},
+		{"v2.0.0-rc.9", "v2.0.0", "v2.0.0", "v2.0.0", "v2.0.0-rc.10"},
+	}
+
+	for _, s := range scenarios {
+		t.Run(s.input, func(t *testing.T) {
+			version, ok := ParseSemVer(s.input)
+			assert.True(t, ok)
+			assert.Equal(t, s.expectedMajor, version.BumpMajor().String())
+			assert.Equal(t, s.expectedMinor, version.BumpMinor().String())
+			assert.Equal(t, s.expectedPatch, version.BumpPatch().String())
+			assert.Equal(t, s.expectedPrerelease, version.BumpPrerelease("rc").String())
+		})
+	}
+}
+
+func TestLatestSemVer(t *testing.T) {
+	version, str, ok := LatestSemVer([]string{"latest", "v1.2.0", "v1.10.0-rc.1", "v1.9.5", "v1.10.0-rc.2"})
+	assert.True(t, ok)
+	assert.Equal(t, "v1.10.0-rc.2", str)
+	assert.Equal(t, SemVer{Prefix: "v", Major: 1, Minor: 10, Prerelease: "rc.2"}, version)
+
+	_, _, ok = LatestSemVer([]string{"latest", "stable"})
+	assert.False(t, ok)
+}